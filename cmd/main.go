@@ -52,6 +52,19 @@ func main() {
 		return
 	}
 
+	keys, err := controllers.NewKeyManager(cfg, logger)
+	if err != nil {
+		log.Fatal("Failed to load JWT signing keys:", err)
+		return
+	}
+	go keys.Run(ctx)
+
+	passwords, err := controllers.NewPasswordManager(cfg, logger)
+	if err != nil {
+		log.Fatal("Failed to load password policy:", err)
+		return
+	}
+
 	httpRequestsTotal := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "http_requests_total",
@@ -60,14 +73,20 @@ func main() {
 		[]string{"path", "method", "status"},
 	)
 	prometheus.MustRegister(httpRequestsTotal)
+	prometheus.MustRegister(controllers.LoginFailuresTotal, controllers.LoginLockoutsTotal, controllers.LoginLockedTotal)
 
 	deps := &controllers.Dependens{
-		DB:     db,
-		Config: cfg,
-		Redis:  rdb,
-		Logger: logger,
+		DB:        db,
+		Config:    cfg,
+		Redis:     rdb,
+		Keys:      keys,
+		Passwords: passwords,
+		Logger:    logger,
 	}
 
+	go controllers.NewLeaveController(deps).Run(ctx)
+	go controllers.NewSickLeaveController(deps).Run(ctx)
+
 	grpcServer := grpc.NewServer()
 	grpcMyServer := grpc_api.NewServer(deps)
 	pb.RegisterEmployeeServiceServer(grpcServer, grpcMyServer)
@@ -86,7 +105,15 @@ func main() {
 		}
 	}()
 
-	gwMux := runtime.NewServeMux()
+	// Idempotency-Key is not a permanent HTTP header, so the gateway has to be
+	// told to pass it to the gRPC server.
+	gwMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+		if http.CanonicalHeaderKey(key) == "Idempotency-Key" {
+			return "idempotency-key", true
+		}
+
+		return runtime.DefaultHeaderMatcher(key)
+	}))
 
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	if err = pb.RegisterEmployeeServiceHandlerFromEndpoint(
@@ -110,10 +137,12 @@ func main() {
 		})
 	})
 
+	server := api.NewServer(deps)
+
 	r.Handle("/metrics", promhttp.Handler())
+	r.Get("/.well-known/jwks.json", server.JWKS)
 	r.Mount("/api/v1", gwMux) // gRPC-Gateway
 
-	server := api.NewServer(deps)
 	r.Mount("/rest/v1", api.HandlerFromMux(server, chi.NewRouter()))
 
 	s := &http.Server{
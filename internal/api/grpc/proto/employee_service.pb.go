@@ -12,6 +12,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -141,23 +142,28 @@ type Employee struct {
 	Phone          *string                `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
 	PersonalNumber *string                `protobuf:"bytes,6,opt,name=personal_number,json=personalNumber,proto3,oneof" json:"personal_number,omitempty"`
 	Email          *string                `protobuf:"bytes,7,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Password       *string                `protobuf:"bytes,8,opt,name=password,proto3,oneof" json:"password,omitempty"`
-	Role           string                 `protobuf:"bytes,9,opt,name=role,proto3" json:"role,omitempty"` // "admin", "hr", "manager", "employee"
-	IsActive       bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	DepartmentId   *uint64                `protobuf:"varint,11,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
-	Position       *string                `protobuf:"bytes,12,opt,name=position,proto3,oneof" json:"position,omitempty"`
-	ManagerId      *uint64                `protobuf:"varint,13,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
-	HireDate       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=hire_date,json=hireDate,proto3,oneof" json:"hire_date,omitempty"`
-	FireDate       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=fire_date,json=fireDate,proto3,oneof" json:"fire_date,omitempty"`
-	Birthday       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
-	Address        *string                `protobuf:"bytes,17,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	VacationDays   uint64                 `protobuf:"varint,18,opt,name=vacation_days,json=vacationDays,proto3" json:"vacation_days,omitempty"`
-	SickDays       uint64                 `protobuf:"varint,19,opt,name=sick_days,json=sickDays,proto3" json:"sick_days,omitempty"`
-	Status         string                 `protobuf:"bytes,20,opt,name=status,proto3" json:"status,omitempty"` // "active", "fired", "suspended"
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Set only on creation, UpdateEmployee rejects it: a new password is set through a password reset.
+	Password     *string                `protobuf:"bytes,8,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Role         string                 `protobuf:"bytes,9,opt,name=role,proto3" json:"role,omitempty"` // "admin", "hr", "manager", "employee"
+	IsActive     bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DepartmentId *uint64                `protobuf:"varint,11,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	Position     *string                `protobuf:"bytes,12,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	ManagerId    *uint64                `protobuf:"varint,13,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	HireDate     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=hire_date,json=hireDate,proto3,oneof" json:"hire_date,omitempty"`
+	FireDate     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=fire_date,json=fireDate,proto3,oneof" json:"fire_date,omitempty"`
+	Birthday     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
+	Address      *string                `protobuf:"bytes,17,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	// Whole days of the vacation balance, derived from the leave ledger and ignored on writes.
+	VacationDays uint64 `protobuf:"varint,18,opt,name=vacation_days,json=vacationDays,proto3" json:"vacation_days,omitempty"`
+	// Days of sick leave in the current year, derived from the sick leaves and ignored on writes.
+	SickDays  uint64                 `protobuf:"varint,19,opt,name=sick_days,json=sickDays,proto3" json:"sick_days,omitempty"`
+	Status    string                 `protobuf:"bytes,20,opt,name=status,proto3" json:"status,omitempty"` // "active", "fired", "suspended", "sick" while a sick leave is open
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Increased by every change, passed back as expected_version of updates and deletes.
+	Version       uint64 `protobuf:"varint,23,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Employee) Reset() {
@@ -344,18 +350,33 @@ func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Employee) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Department represents a department entity.
 type Department struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	ParentId      *uint64                `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
-	HeadId        *uint64                `protobuf:"varint,5,opt,name=head_id,json=headId,proto3,oneof" json:"head_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ParentId    *uint64                `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	HeadId      *uint64                `protobuf:"varint,5,opt,name=head_id,json=headId,proto3,oneof" json:"head_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Increased by every change, passed back as expected_version of updates and deletes.
+	Version uint64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// Working-day calendar, the one of the nearest parent department is used without it.
+	CalendarId *uint64 `protobuf:"varint,9,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	// Least number of employees at work, vacations leaving fewer are refused.
+	MinOnDuty *uint64 `protobuf:"varint,10,opt,name=min_on_duty,json=minOnDuty,proto3,oneof" json:"min_on_duty,omitempty"`
+	// Least share of the staff at work in percent, the larger of both minimums applies.
+	MinOnDutyPercent *uint64 `protobuf:"varint,11,opt,name=min_on_duty_percent,json=minOnDutyPercent,proto3,oneof" json:"min_on_duty_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Department) Reset() {
@@ -437,15 +458,46 @@ func (x *Department) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Department) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Department) GetCalendarId() uint64 {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return 0
+}
+
+func (x *Department) GetMinOnDuty() uint64 {
+	if x != nil && x.MinOnDuty != nil {
+		return *x.MinOnDuty
+	}
+	return 0
+}
+
+func (x *Department) GetMinOnDutyPercent() uint64 {
+	if x != nil && x.MinOnDutyPercent != nil {
+		return *x.MinOnDutyPercent
+	}
+	return 0
+}
+
 // DepartmentForm represents the input for creating/updating a department.
 type DepartmentForm struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	ParentId      *uint64                `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
-	HeadId        *uint64                `protobuf:"varint,4,opt,name=head_id,json=headId,proto3,oneof" json:"head_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ParentId         *uint64                `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	HeadId           *uint64                `protobuf:"varint,4,opt,name=head_id,json=headId,proto3,oneof" json:"head_id,omitempty"`
+	CalendarId       *uint64                `protobuf:"varint,5,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	MinOnDuty        *uint64                `protobuf:"varint,6,opt,name=min_on_duty,json=minOnDuty,proto3,oneof" json:"min_on_duty,omitempty"`
+	MinOnDutyPercent *uint64                `protobuf:"varint,7,opt,name=min_on_duty_percent,json=minOnDutyPercent,proto3,oneof" json:"min_on_duty_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *DepartmentForm) Reset() {
@@ -506,6 +558,27 @@ func (x *DepartmentForm) GetHeadId() uint64 {
 	return 0
 }
 
+func (x *DepartmentForm) GetCalendarId() uint64 {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return 0
+}
+
+func (x *DepartmentForm) GetMinOnDuty() uint64 {
+	if x != nil && x.MinOnDuty != nil {
+		return *x.MinOnDuty
+	}
+	return 0
+}
+
+func (x *DepartmentForm) GetMinOnDutyPercent() uint64 {
+	if x != nil && x.MinOnDutyPercent != nil {
+		return *x.MinOnDutyPercent
+	}
+	return 0
+}
+
 // LoginRequest represents the input for authentication.
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -559,11 +632,13 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
-// LoginResponse contains the JWT tokens.
+// LoginResponse contains the JWT tokens, or the mfa_token to exchange through
+// VerifyMFA when the account has two-factor authentication enabled.
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,3,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -612,28 +687,35 @@ func (x *LoginResponse) GetRefreshToken() string {
 	return ""
 }
 
-// VacationRequest represents a request to add vacation days.
-type VacationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          uint64                 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
 }
 
-func (x *VacationRequest) Reset() {
-	*x = VacationRequest{}
+// OIDCLoginResponse contains the IdP authorization URL to redirect the user to.
+type OIDCLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OIDCLoginResponse) Reset() {
+	*x = OIDCLoginResponse{}
 	mi := &file_employee_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VacationRequest) String() string {
+func (x *OIDCLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VacationRequest) ProtoMessage() {}
+func (*OIDCLoginResponse) ProtoMessage() {}
 
-func (x *VacationRequest) ProtoReflect() protoreflect.Message {
+func (x *OIDCLoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,42 +727,41 @@ func (x *VacationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VacationRequest.ProtoReflect.Descriptor instead.
-func (*VacationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OIDCLoginResponse.ProtoReflect.Descriptor instead.
+func (*OIDCLoginResponse) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *VacationRequest) GetDays() uint64 {
+func (x *OIDCLoginResponse) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.Days
+		return x.AuthorizationUrl
 	}
-	return 0
+	return ""
 }
 
-// GetEmployeesRequest contains filters for querying employees.
-type GetEmployeesRequest struct {
+// OIDCCallbackRequest contains the parameters the IdP redirects back with.
+type OIDCCallbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *string                `protobuf:"bytes,1,opt,name=role,proto3,oneof" json:"role,omitempty"`
-	DepartmentId  *uint64                `protobuf:"varint,2,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
-	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeesRequest) Reset() {
-	*x = GetEmployeesRequest{}
+func (x *OIDCCallbackRequest) Reset() {
+	*x = OIDCCallbackRequest{}
 	mi := &file_employee_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeesRequest) String() string {
+func (x *OIDCCallbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeesRequest) ProtoMessage() {}
+func (*OIDCCallbackRequest) ProtoMessage() {}
 
-func (x *GetEmployeesRequest) ProtoReflect() protoreflect.Message {
+func (x *OIDCCallbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,54 +773,47 @@ func (x *GetEmployeesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeesRequest.ProtoReflect.Descriptor instead.
-func (*GetEmployeesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OIDCCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OIDCCallbackRequest) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetEmployeesRequest) GetRole() string {
-	if x != nil && x.Role != nil {
-		return *x.Role
+func (x *OIDCCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-func (x *GetEmployeesRequest) GetDepartmentId() uint64 {
-	if x != nil && x.DepartmentId != nil {
-		return *x.DepartmentId
-	}
-	return 0
-}
-
-func (x *GetEmployeesRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *OIDCCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
 	}
 	return ""
 }
 
-// GetEmployeesResponse contains a list of employees.
-type GetEmployeesResponse struct {
+// MFACodeRequest contains a TOTP code or a recovery code.
+type MFACodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeesResponse) Reset() {
-	*x = GetEmployeesResponse{}
+func (x *MFACodeRequest) Reset() {
+	*x = MFACodeRequest{}
 	mi := &file_employee_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeesResponse) String() string {
+func (x *MFACodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeesResponse) ProtoMessage() {}
+func (*MFACodeRequest) ProtoMessage() {}
 
-func (x *GetEmployeesResponse) ProtoReflect() protoreflect.Message {
+func (x *MFACodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,40 +825,41 @@ func (x *GetEmployeesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeesResponse.ProtoReflect.Descriptor instead.
-func (*GetEmployeesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MFACodeRequest.ProtoReflect.Descriptor instead.
+func (*MFACodeRequest) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetEmployeesResponse) GetEmployees() []*Employee {
+func (x *MFACodeRequest) GetCode() string {
 	if x != nil {
-		return x.Employees
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-// GetDepartmentsResponse contains a list of departments.
-type GetDepartmentsResponse struct {
+// MFAVerifyRequest contains the mfa token returned by login and the second factor.
+type MFAVerifyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDepartmentsResponse) Reset() {
-	*x = GetDepartmentsResponse{}
+func (x *MFAVerifyRequest) Reset() {
+	*x = MFAVerifyRequest{}
 	mi := &file_employee_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDepartmentsResponse) String() string {
+func (x *MFAVerifyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDepartmentsResponse) ProtoMessage() {}
+func (*MFAVerifyRequest) ProtoMessage() {}
 
-func (x *GetDepartmentsResponse) ProtoReflect() protoreflect.Message {
+func (x *MFAVerifyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,40 +871,48 @@ func (x *GetDepartmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDepartmentsResponse.ProtoReflect.Descriptor instead.
-func (*GetDepartmentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MFAVerifyRequest.ProtoReflect.Descriptor instead.
+func (*MFAVerifyRequest) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetDepartmentsResponse) GetDepartments() []*Department {
+func (x *MFAVerifyRequest) GetMfaToken() string {
 	if x != nil {
-		return x.Departments
+		return x.MfaToken
 	}
-	return nil
+	return ""
 }
 
-// GetEmployeeByIDRequest contains the ID for retrieving an employee.
-type GetEmployeeByIDRequest struct {
+func (x *MFAVerifyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// MFAEnrollment contains the pending TOTP secret and its otpauth URI for QR codes.
+type MFAEnrollment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeByIDRequest) Reset() {
-	*x = GetEmployeeByIDRequest{}
+func (x *MFAEnrollment) Reset() {
+	*x = MFAEnrollment{}
 	mi := &file_employee_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeByIDRequest) String() string {
+func (x *MFAEnrollment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeByIDRequest) ProtoMessage() {}
+func (*MFAEnrollment) ProtoMessage() {}
 
-func (x *GetEmployeeByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *MFAEnrollment) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -841,41 +924,49 @@ func (x *GetEmployeeByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetEmployeeByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MFAEnrollment.ProtoReflect.Descriptor instead.
+func (*MFAEnrollment) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetEmployeeByIDRequest) GetId() uint64 {
+func (x *MFAEnrollment) GetSecret() string {
 	if x != nil {
-		return x.Id
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-// UpdateEmployeeRequest contains the ID and employee data for updating.
-type UpdateEmployeeRequest struct {
+func (x *MFAEnrollment) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+// MFAEnabled contains the recovery codes shown once and a new token pair.
+type MFAEnabled struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Employee      *Employee              `protobuf:"bytes,2,opt,name=employee,proto3" json:"employee,omitempty"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeRequest) Reset() {
-	*x = UpdateEmployeeRequest{}
+func (x *MFAEnabled) Reset() {
+	*x = MFAEnabled{}
 	mi := &file_employee_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeRequest) String() string {
+func (x *MFAEnabled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployeeRequest) ProtoMessage() {}
+func (*MFAEnabled) ProtoMessage() {}
 
-func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
+func (x *MFAEnabled) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -887,47 +978,54 @@ func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MFAEnabled.ProtoReflect.Descriptor instead.
+func (*MFAEnabled) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UpdateEmployeeRequest) GetId() uint64 {
+func (x *MFAEnabled) GetRecoveryCodes() []string {
 	if x != nil {
-		return x.Id
+		return x.RecoveryCodes
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateEmployeeRequest) GetEmployee() *Employee {
+func (x *MFAEnabled) GetAccessToken() string {
 	if x != nil {
-		return x.Employee
+		return x.AccessToken
 	}
-	return nil
+	return ""
 }
 
-// DeleteEmployeeRequest contains the ID for deleting an employee.
-type DeleteEmployeeRequest struct {
+func (x *MFAEnabled) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// RefreshRequest contains the refresh token to exchange for a new token pair.
+type RefreshRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEmployeeRequest) Reset() {
-	*x = DeleteEmployeeRequest{}
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
 	mi := &file_employee_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEmployeeRequest) String() string {
+func (x *RefreshRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEmployeeRequest) ProtoMessage() {}
+func (*RefreshRequest) ProtoMessage() {}
 
-func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -939,41 +1037,41 @@ func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DeleteEmployeeRequest) GetId() uint64 {
+func (x *RefreshRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Id
+		return x.RefreshToken
 	}
-	return 0
+	return ""
 }
 
-// RequestVacationRequest contains the ID and vacation data.
-type RequestVacationRequest struct {
+// ChangePasswordRequest contains the current and the new password.
+type ChangePasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Vacation      *VacationRequest       `protobuf:"bytes,2,opt,name=vacation,proto3" json:"vacation,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestVacationRequest) Reset() {
-	*x = RequestVacationRequest{}
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
 	mi := &file_employee_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestVacationRequest) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestVacationRequest) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *RequestVacationRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -985,47 +1083,48 @@ func (x *RequestVacationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestVacationRequest.ProtoReflect.Descriptor instead.
-func (*RequestVacationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RequestVacationRequest) GetId() uint64 {
+func (x *ChangePasswordRequest) GetOldPassword() string {
 	if x != nil {
-		return x.Id
+		return x.OldPassword
 	}
-	return 0
+	return ""
 }
 
-func (x *RequestVacationRequest) GetVacation() *VacationRequest {
+func (x *ChangePasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.Vacation
+		return x.NewPassword
 	}
-	return nil
+	return ""
 }
 
-// GetDepartmentByIDRequest contains the ID for retrieving a department.
-type GetDepartmentByIDRequest struct {
+// ResetPasswordRequest contains the reset token and the new password.
+type ResetPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDepartmentByIDRequest) Reset() {
-	*x = GetDepartmentByIDRequest{}
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
 	mi := &file_employee_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDepartmentByIDRequest) String() string {
+func (x *ResetPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDepartmentByIDRequest) ProtoMessage() {}
+func (*ResetPasswordRequest) ProtoMessage() {}
 
-func (x *GetDepartmentByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1037,41 +1136,48 @@ func (x *GetDepartmentByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDepartmentByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetDepartmentByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetDepartmentByIDRequest) GetId() uint64 {
+func (x *ResetPasswordRequest) GetResetToken() string {
 	if x != nil {
-		return x.Id
+		return x.ResetToken
 	}
-	return 0
+	return ""
 }
 
-// UpdateDepartmentRequest contains the ID and department data for updating.
-type UpdateDepartmentRequest struct {
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// PasswordResetResponse contains a single-use password reset token.
+type PasswordResetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Department    *DepartmentForm        `protobuf:"bytes,2,opt,name=department,proto3" json:"department,omitempty"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDepartmentRequest) Reset() {
-	*x = UpdateDepartmentRequest{}
+func (x *PasswordResetResponse) Reset() {
+	*x = PasswordResetResponse{}
 	mi := &file_employee_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDepartmentRequest) String() string {
+func (x *PasswordResetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDepartmentRequest) ProtoMessage() {}
+func (*PasswordResetResponse) ProtoMessage() {}
 
-func (x *UpdateDepartmentRequest) ProtoReflect() protoreflect.Message {
+func (x *PasswordResetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1083,47 +1189,50 @@ func (x *UpdateDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDepartmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*PasswordResetResponse) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateDepartmentRequest) GetId() uint64 {
+func (x *PasswordResetResponse) GetResetToken() string {
 	if x != nil {
-		return x.Id
+		return x.ResetToken
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateDepartmentRequest) GetDepartment() *DepartmentForm {
+func (x *PasswordResetResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Department
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-// DeleteDepartmentRequest contains the ID for deleting a department.
-type DeleteDepartmentRequest struct {
+// SessionInfo describes an active session of the user.
+type SessionInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RefreshedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	Current       bool                   `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDepartmentRequest) Reset() {
-	*x = DeleteDepartmentRequest{}
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
 	mi := &file_employee_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDepartmentRequest) String() string {
+func (x *SessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDepartmentRequest) ProtoMessage() {}
+func (*SessionInfo) ProtoMessage() {}
 
-func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_employee_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1135,29 +1244,4571 @@ func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDepartmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
 	return file_employee_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteDepartmentRequest) GetId() uint64 {
+func (x *SessionInfo) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-var File_employee_service_proto protoreflect.FileDescriptor
+func (x *SessionInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
 
-const file_employee_service_proto_rawDesc = "" +
-	"\n" +
-	"\x16employee_service.proto\x12\x10employee_service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x19google/protobuf/any.proto\x1a\x1cgoogle/api/annotations.proto\"c\n" +
+func (x *SessionInfo) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// GetSessionsResponse contains the active sessions of the user.
+type GetSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionsResponse) Reset() {
+	*x = GetSessionsResponse{}
+	mi := &file_employee_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionsResponse) ProtoMessage() {}
+
+func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetSessionsResponse) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// Lockout is a temporary block of logins for an email or a client IP.
+type Lockout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Failures      int64                  `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lockout) Reset() {
+	*x = Lockout{}
+	mi := &file_employee_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lockout) ProtoMessage() {}
+
+func (x *Lockout) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lockout.ProtoReflect.Descriptor instead.
+func (*Lockout) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Lockout) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Lockout) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Lockout) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Lockout) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// GetLockoutsResponse contains the active login lockouts.
+type GetLockoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lockouts      []*Lockout             `protobuf:"bytes,1,rep,name=lockouts,proto3" json:"lockouts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLockoutsResponse) Reset() {
+	*x = GetLockoutsResponse{}
+	mi := &file_employee_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLockoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLockoutsResponse) ProtoMessage() {}
+
+func (x *GetLockoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLockoutsResponse.ProtoReflect.Descriptor instead.
+func (*GetLockoutsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetLockoutsResponse) GetLockouts() []*Lockout {
+	if x != nil {
+		return x.Lockouts
+	}
+	return nil
+}
+
+// APIKey is a machine credential of another service, without the secret.
+type APIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedBy     *uint64                `protobuf:"varint,8,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_employee_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *APIKey) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedBy() uint64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// APIKeyForm represents the input for creating/updating an API key.
+type APIKeyForm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKeyForm) Reset() {
+	*x = APIKeyForm{}
+	mi := &file_employee_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeyForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyForm) ProtoMessage() {}
+
+func (x *APIKeyForm) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyForm.ProtoReflect.Descriptor instead.
+func (*APIKeyForm) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *APIKeyForm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKeyForm) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *APIKeyForm) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKeyForm) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreatedAPIKey contains the new key, the secret is shown only once.
+type CreatedAPIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatedAPIKey) Reset() {
+	*x = CreatedAPIKey{}
+	mi := &file_employee_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatedAPIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatedAPIKey) ProtoMessage() {}
+
+func (x *CreatedAPIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatedAPIKey.ProtoReflect.Descriptor instead.
+func (*CreatedAPIKey) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreatedAPIKey) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreatedAPIKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// GetAPIKeysResponse contains the API keys.
+type GetAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIKeysResponse) Reset() {
+	*x = GetAPIKeysResponse{}
+	mi := &file_employee_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeysResponse) ProtoMessage() {}
+
+func (x *GetAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+// CalendarToken is a token for subscribing to absence calendars, the secret is not returned.
+type CalendarToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId    uint64                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarToken) Reset() {
+	*x = CalendarToken{}
+	mi := &file_employee_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarToken) ProtoMessage() {}
+
+func (x *CalendarToken) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarToken.ProtoReflect.Descriptor instead.
+func (*CalendarToken) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CalendarToken) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CalendarToken) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *CalendarToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarToken) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *CalendarToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *CalendarToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CalendarTokenForm represents the input for creating a calendar token.
+type CalendarTokenForm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarTokenForm) Reset() {
+	*x = CalendarTokenForm{}
+	mi := &file_employee_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarTokenForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarTokenForm) ProtoMessage() {}
+
+func (x *CalendarTokenForm) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarTokenForm.ProtoReflect.Descriptor instead.
+func (*CalendarTokenForm) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CalendarTokenForm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreatedCalendarToken contains the new token, the secret is shown only once.
+type CreatedCalendarToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CalendarToken *CalendarToken         `protobuf:"bytes,1,opt,name=calendar_token,json=calendarToken,proto3" json:"calendar_token,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatedCalendarToken) Reset() {
+	*x = CreatedCalendarToken{}
+	mi := &file_employee_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatedCalendarToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatedCalendarToken) ProtoMessage() {}
+
+func (x *CreatedCalendarToken) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatedCalendarToken.ProtoReflect.Descriptor instead.
+func (*CreatedCalendarToken) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreatedCalendarToken) GetCalendarToken() *CalendarToken {
+	if x != nil {
+		return x.CalendarToken
+	}
+	return nil
+}
+
+func (x *CreatedCalendarToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// GetCalendarTokensResponse contains the calendar tokens of the current user.
+type GetCalendarTokensResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CalendarTokens []*CalendarToken       `protobuf:"bytes,1,rep,name=calendar_tokens,json=calendarTokens,proto3" json:"calendar_tokens,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCalendarTokensResponse) Reset() {
+	*x = GetCalendarTokensResponse{}
+	mi := &file_employee_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarTokensResponse) ProtoMessage() {}
+
+func (x *GetCalendarTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarTokensResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarTokensResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCalendarTokensResponse) GetCalendarTokens() []*CalendarToken {
+	if x != nil {
+		return x.CalendarTokens
+	}
+	return nil
+}
+
+// ImpersonationResponse contains a short-lived access token of another employee.
+type ImpersonationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonationResponse) Reset() {
+	*x = ImpersonationResponse{}
+	mi := &file_employee_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationResponse) ProtoMessage() {}
+
+func (x *ImpersonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonationResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImpersonationResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImpersonationResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// AuditEntry is a record of the audit trail.
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       uint64                 `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	EmployeeId    *uint64                `protobuf:"varint,3,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TargetId      *uint64                `protobuf:"varint,5,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	Allowed       bool                   `protobuf:"varint,6,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_employee_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AuditEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetActorId() uint64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetEmployeeId() uint64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTargetId() uint64 {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetAuditLogResponse contains the audit trail entries.
+type GetAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	mi := &file_employee_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogResponse) ProtoMessage() {}
+
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// VacationRequestForm represents the input for submitting a vacation request.
+type VacationRequestForm struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of annual, unpaid, study. Only annual is debited from vacation_days.
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacationRequestForm) Reset() {
+	*x = VacationRequestForm{}
+	mi := &file_employee_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacationRequestForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacationRequestForm) ProtoMessage() {}
+
+func (x *VacationRequestForm) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacationRequestForm.ProtoReflect.Descriptor instead.
+func (*VacationRequestForm) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VacationRequestForm) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VacationRequestForm) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *VacationRequestForm) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *VacationRequestForm) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// VacationRequest is a request of an employee for a leave, approved by the
+// manager of the employee and then by hr.
+type VacationRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId uint64                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Type       string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	StartDate  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Days       uint64                 `protobuf:"varint,6,opt,name=days,proto3" json:"days,omitempty"`
+	// One of pending_manager, pending_hr, approved, rejected, cancelled.
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Comment          *string                `protobuf:"bytes,8,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	ManagerId        *uint64                `protobuf:"varint,9,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	ManagerDecidedBy *uint64                `protobuf:"varint,10,opt,name=manager_decided_by,json=managerDecidedBy,proto3,oneof" json:"manager_decided_by,omitempty"`
+	HrDecidedBy      *uint64                `protobuf:"varint,11,opt,name=hr_decided_by,json=hrDecidedBy,proto3,oneof" json:"hr_decided_by,omitempty"`
+	RejectReason     *string                `protobuf:"bytes,12,opt,name=reject_reason,json=rejectReason,proto3,oneof" json:"reject_reason,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VacationRequest) Reset() {
+	*x = VacationRequest{}
+	mi := &file_employee_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacationRequest) ProtoMessage() {}
+
+func (x *VacationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacationRequest.ProtoReflect.Descriptor instead.
+func (*VacationRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VacationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VacationRequest) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *VacationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VacationRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *VacationRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *VacationRequest) GetDays() uint64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *VacationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VacationRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *VacationRequest) GetManagerId() uint64 {
+	if x != nil && x.ManagerId != nil {
+		return *x.ManagerId
+	}
+	return 0
+}
+
+func (x *VacationRequest) GetManagerDecidedBy() uint64 {
+	if x != nil && x.ManagerDecidedBy != nil {
+		return *x.ManagerDecidedBy
+	}
+	return 0
+}
+
+func (x *VacationRequest) GetHrDecidedBy() uint64 {
+	if x != nil && x.HrDecidedBy != nil {
+		return *x.HrDecidedBy
+	}
+	return 0
+}
+
+func (x *VacationRequest) GetRejectReason() string {
+	if x != nil && x.RejectReason != nil {
+		return *x.RejectReason
+	}
+	return ""
+}
+
+func (x *VacationRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VacationRequest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetVacationRequestsResponse contains the vacation requests.
+type GetVacationRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*VacationRequest     `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacationRequestsResponse) Reset() {
+	*x = GetVacationRequestsResponse{}
+	mi := &file_employee_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacationRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacationRequestsResponse) ProtoMessage() {}
+
+func (x *GetVacationRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacationRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetVacationRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetVacationRequestsResponse) GetRequests() []*VacationRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// LeaveEntry is a record of the leave ledger. Days are signed: accruals add to
+// the balance, usage and expiry take from it.
+type LeaveEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId uint64                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// Only annual.
+	LeaveType string `protobuf:"bytes,3,opt,name=leave_type,json=leaveType,proto3" json:"leave_type,omitempty"`
+	// One of accrual, usage, adjustment, expiry.
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Days          float64                `protobuf:"fixed64,5,opt,name=days,proto3" json:"days,omitempty"`
+	EffectiveDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
+	// Year of an accrual or expiry.
+	Period            *int64                 `protobuf:"varint,7,opt,name=period,proto3,oneof" json:"period,omitempty"`
+	VacationRequestId *uint64                `protobuf:"varint,8,opt,name=vacation_request_id,json=vacationRequestId,proto3,oneof" json:"vacation_request_id,omitempty"`
+	Comment           *string                `protobuf:"bytes,9,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	CreatedBy         *uint64                `protobuf:"varint,10,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Balance of the leave type after the entry, set in the ledger history.
+	Balance       float64 `protobuf:"fixed64,12,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveEntry) Reset() {
+	*x = LeaveEntry{}
+	mi := &file_employee_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveEntry) ProtoMessage() {}
+
+func (x *LeaveEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveEntry.ProtoReflect.Descriptor instead.
+func (*LeaveEntry) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LeaveEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LeaveEntry) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *LeaveEntry) GetLeaveType() string {
+	if x != nil {
+		return x.LeaveType
+	}
+	return ""
+}
+
+func (x *LeaveEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LeaveEntry) GetDays() float64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *LeaveEntry) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+func (x *LeaveEntry) GetPeriod() int64 {
+	if x != nil && x.Period != nil {
+		return *x.Period
+	}
+	return 0
+}
+
+func (x *LeaveEntry) GetVacationRequestId() uint64 {
+	if x != nil && x.VacationRequestId != nil {
+		return *x.VacationRequestId
+	}
+	return 0
+}
+
+func (x *LeaveEntry) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *LeaveEntry) GetCreatedBy() uint64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *LeaveEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LeaveEntry) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// GetLeaveLedgerResponse contains the ledger entries in the order they take effect.
+type GetLeaveLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LeaveEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaveLedgerResponse) Reset() {
+	*x = GetLeaveLedgerResponse{}
+	mi := &file_employee_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaveLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveLedgerResponse) ProtoMessage() {}
+
+func (x *GetLeaveLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaveLedgerResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaveLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetLeaveLedgerResponse) GetEntries() []*LeaveEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// LeaveBalance is the balance of a leave type with the totals of every kind of entry.
+type LeaveBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveType     string                 `protobuf:"bytes,1,opt,name=leave_type,json=leaveType,proto3" json:"leave_type,omitempty"`
+	Balance       float64                `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Accrued       float64                `protobuf:"fixed64,3,opt,name=accrued,proto3" json:"accrued,omitempty"`
+	Used          float64                `protobuf:"fixed64,4,opt,name=used,proto3" json:"used,omitempty"`
+	Adjusted      float64                `protobuf:"fixed64,5,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	Expired       float64                `protobuf:"fixed64,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveBalance) Reset() {
+	*x = LeaveBalance{}
+	mi := &file_employee_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveBalance) ProtoMessage() {}
+
+func (x *LeaveBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveBalance.ProtoReflect.Descriptor instead.
+func (*LeaveBalance) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LeaveBalance) GetLeaveType() string {
+	if x != nil {
+		return x.LeaveType
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetAccrued() float64 {
+	if x != nil {
+		return x.Accrued
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetUsed() float64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetAdjusted() float64 {
+	if x != nil {
+		return x.Adjusted
+	}
+	return 0
+}
+
+func (x *LeaveBalance) GetExpired() float64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+// LeaveBalances are the balances of an employee on a date.
+type LeaveBalances struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint64                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Balances      []*LeaveBalance        `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveBalances) Reset() {
+	*x = LeaveBalances{}
+	mi := &file_employee_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveBalances) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveBalances) ProtoMessage() {}
+
+func (x *LeaveBalances) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveBalances.ProtoReflect.Descriptor instead.
+func (*LeaveBalances) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LeaveBalances) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *LeaveBalances) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *LeaveBalances) GetBalances() []*LeaveBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// LeaveAdjustment is a correction of a leave balance by hand.
+type LeaveAdjustment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	LeaveType string                 `protobuf:"bytes,1,opt,name=leave_type,json=leaveType,proto3" json:"leave_type,omitempty"`
+	// Days added to the balance, negative to take them away.
+	Days float64 `protobuf:"fixed64,2,opt,name=days,proto3" json:"days,omitempty"`
+	// Today by default.
+	EffectiveDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_date,json=effectiveDate,proto3,oneof" json:"effective_date,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveAdjustment) Reset() {
+	*x = LeaveAdjustment{}
+	mi := &file_employee_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveAdjustment) ProtoMessage() {}
+
+func (x *LeaveAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveAdjustment.ProtoReflect.Descriptor instead.
+func (*LeaveAdjustment) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LeaveAdjustment) GetLeaveType() string {
+	if x != nil {
+		return x.LeaveType
+	}
+	return ""
+}
+
+func (x *LeaveAdjustment) GetDays() float64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *LeaveAdjustment) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+func (x *LeaveAdjustment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// SickLeave is a sick leave of an employee. An open leave has no end_date yet,
+// its days are counted up to today.
+type SickLeave struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId        uint64                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Days              uint64                 `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`
+	CertificateNumber *string                `protobuf:"bytes,6,opt,name=certificate_number,json=certificateNumber,proto3,oneof" json:"certificate_number,omitempty"`
+	Comment           *string                `protobuf:"bytes,7,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	CreatedBy         *uint64                `protobuf:"varint,8,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	ClosedBy          *uint64                `protobuf:"varint,9,opt,name=closed_by,json=closedBy,proto3,oneof" json:"closed_by,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SickLeave) Reset() {
+	*x = SickLeave{}
+	mi := &file_employee_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SickLeave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SickLeave) ProtoMessage() {}
+
+func (x *SickLeave) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SickLeave.ProtoReflect.Descriptor instead.
+func (*SickLeave) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SickLeave) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SickLeave) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *SickLeave) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *SickLeave) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *SickLeave) GetDays() uint64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *SickLeave) GetCertificateNumber() string {
+	if x != nil && x.CertificateNumber != nil {
+		return *x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *SickLeave) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *SickLeave) GetCreatedBy() uint64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *SickLeave) GetClosedBy() uint64 {
+	if x != nil && x.ClosedBy != nil {
+		return *x.ClosedBy
+	}
+	return 0
+}
+
+func (x *SickLeave) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SickLeave) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SickLeaveForm is the input for registering a sick leave.
+type SickLeaveForm struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Without it the leave stays open until it is closed.
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	CertificateNumber *string                `protobuf:"bytes,3,opt,name=certificate_number,json=certificateNumber,proto3,oneof" json:"certificate_number,omitempty"`
+	Comment           *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SickLeaveForm) Reset() {
+	*x = SickLeaveForm{}
+	mi := &file_employee_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SickLeaveForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SickLeaveForm) ProtoMessage() {}
+
+func (x *SickLeaveForm) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SickLeaveForm.ProtoReflect.Descriptor instead.
+func (*SickLeaveForm) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SickLeaveForm) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *SickLeaveForm) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *SickLeaveForm) GetCertificateNumber() string {
+	if x != nil && x.CertificateNumber != nil {
+		return *x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *SickLeaveForm) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// GetSickLeavesResponse contains the sick leaves, latest first.
+type GetSickLeavesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SickLeaves    []*SickLeave           `protobuf:"bytes,1,rep,name=sick_leaves,json=sickLeaves,proto3" json:"sick_leaves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSickLeavesResponse) Reset() {
+	*x = GetSickLeavesResponse{}
+	mi := &file_employee_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSickLeavesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSickLeavesResponse) ProtoMessage() {}
+
+func (x *GetSickLeavesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSickLeavesResponse.ProtoReflect.Descriptor instead.
+func (*GetSickLeavesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetSickLeavesResponse) GetSickLeaves() []*SickLeave {
+	if x != nil {
+		return x.SickLeaves
+	}
+	return nil
+}
+
+// Calendar is a working-day calendar of an office.
+type Calendar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_employee_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Calendar) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Calendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Calendar) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Calendar) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Calendar) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CalendarForm is the input for creating a calendar.
+type CalendarForm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarForm) Reset() {
+	*x = CalendarForm{}
+	mi := &file_employee_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarForm) ProtoMessage() {}
+
+func (x *CalendarForm) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarForm.ProtoReflect.Descriptor instead.
+func (*CalendarForm) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CalendarForm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarForm) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// CalendarDay is a public holiday or a transferred working day of a calendar.
+type CalendarDay struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId uint64                 `protobuf:"varint,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Date       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// holiday or working.
+	Kind          string  `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          *string `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarDay) Reset() {
+	*x = CalendarDay{}
+	mi := &file_employee_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDay) ProtoMessage() {}
+
+func (x *CalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDay.ProtoReflect.Descriptor instead.
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CalendarDay) GetCalendarId() uint64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *CalendarDay) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CalendarDay) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// GetCalendarsResponse contains the calendars by name.
+type GetCalendarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendars     []*Calendar            `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarsResponse) Reset() {
+	*x = GetCalendarsResponse{}
+	mi := &file_employee_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarsResponse) ProtoMessage() {}
+
+func (x *GetCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCalendarsResponse) GetCalendars() []*Calendar {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+// GetCalendarDaysResponse contains the days of a calendar by date.
+type GetCalendarDaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*CalendarDay         `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarDaysResponse) Reset() {
+	*x = GetCalendarDaysResponse{}
+	mi := &file_employee_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarDaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarDaysResponse) ProtoMessage() {}
+
+func (x *GetCalendarDaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarDaysResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarDaysResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCalendarDaysResponse) GetDays() []*CalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// CalendarImport is the result of importing days into a calendar.
+type CalendarImport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId    uint64                 `protobuf:"varint,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Imported      int64                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarImport) Reset() {
+	*x = CalendarImport{}
+	mi := &file_employee_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarImport) ProtoMessage() {}
+
+func (x *CalendarImport) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarImport.ProtoReflect.Descriptor instead.
+func (*CalendarImport) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CalendarImport) GetCalendarId() uint64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *CalendarImport) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+// WorkingDays are the days of a period counted by the calendar of an employee.
+type WorkingDays struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId uint64                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// Not set when the employee has no calendar and only weekends are days off.
+	CalendarId    *uint64                `protobuf:"varint,2,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	CalendarDays  uint64                 `protobuf:"varint,5,opt,name=calendar_days,json=calendarDays,proto3" json:"calendar_days,omitempty"`
+	WorkingDays   uint64                 `protobuf:"varint,6,opt,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
+	Holidays      uint64                 `protobuf:"varint,7,opt,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingDays) Reset() {
+	*x = WorkingDays{}
+	mi := &file_employee_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingDays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingDays) ProtoMessage() {}
+
+func (x *WorkingDays) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingDays.ProtoReflect.Descriptor instead.
+func (*WorkingDays) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WorkingDays) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *WorkingDays) GetCalendarId() uint64 {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return 0
+}
+
+func (x *WorkingDays) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *WorkingDays) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *WorkingDays) GetCalendarDays() uint64 {
+	if x != nil {
+		return x.CalendarDays
+	}
+	return 0
+}
+
+func (x *WorkingDays) GetWorkingDays() uint64 {
+	if x != nil {
+		return x.WorkingDays
+	}
+	return 0
+}
+
+func (x *WorkingDays) GetHolidays() uint64 {
+	if x != nil {
+		return x.Holidays
+	}
+	return 0
+}
+
+// StaffingConflict is sent in the status details when a vacation would leave
+// fewer employees of the department at work than its minimum.
+type StaffingConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentId  uint64                 `protobuf:"varint,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	Headcount     uint64                 `protobuf:"varint,2,opt,name=headcount,proto3" json:"headcount,omitempty"`
+	MinOnDuty     uint64                 `protobuf:"varint,3,opt,name=min_on_duty,json=minOnDuty,proto3" json:"min_on_duty,omitempty"`
+	Days          []*StaffingConflictDay `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffingConflict) Reset() {
+	*x = StaffingConflict{}
+	mi := &file_employee_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffingConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffingConflict) ProtoMessage() {}
+
+func (x *StaffingConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffingConflict.ProtoReflect.Descriptor instead.
+func (*StaffingConflict) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StaffingConflict) GetDepartmentId() uint64 {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return 0
+}
+
+func (x *StaffingConflict) GetHeadcount() uint64 {
+	if x != nil {
+		return x.Headcount
+	}
+	return 0
+}
+
+func (x *StaffingConflict) GetMinOnDuty() uint64 {
+	if x != nil {
+		return x.MinOnDuty
+	}
+	return 0
+}
+
+func (x *StaffingConflict) GetDays() []*StaffingConflictDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// StaffingConflictDay is a working day of the conflict with the colleagues who are out.
+type StaffingConflictDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	OnDuty        uint64                 `protobuf:"varint,2,opt,name=on_duty,json=onDuty,proto3" json:"on_duty,omitempty"`
+	Absent        []*StaffingAbsence     `protobuf:"bytes,3,rep,name=absent,proto3" json:"absent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffingConflictDay) Reset() {
+	*x = StaffingConflictDay{}
+	mi := &file_employee_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffingConflictDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffingConflictDay) ProtoMessage() {}
+
+func (x *StaffingConflictDay) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffingConflictDay.ProtoReflect.Descriptor instead.
+func (*StaffingConflictDay) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StaffingConflictDay) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *StaffingConflictDay) GetOnDuty() uint64 {
+	if x != nil {
+		return x.OnDuty
+	}
+	return 0
+}
+
+func (x *StaffingConflictDay) GetAbsent() []*StaffingAbsence {
+	if x != nil {
+		return x.Absent
+	}
+	return nil
+}
+
+// StaffingAbsence is an approved vacation or a sick leave of a colleague.
+type StaffingAbsence struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId uint64                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	FirstName  string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName   string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// The vacation type, or sick for a sick leave.
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffingAbsence) Reset() {
+	*x = StaffingAbsence{}
+	mi := &file_employee_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffingAbsence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffingAbsence) ProtoMessage() {}
+
+func (x *StaffingAbsence) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffingAbsence.ProtoReflect.Descriptor instead.
+func (*StaffingAbsence) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StaffingAbsence) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *StaffingAbsence) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *StaffingAbsence) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *StaffingAbsence) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StaffingAbsence) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *StaffingAbsence) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// GetEmployeesRequest contains filters and pagination for querying employees.
+type GetEmployeesRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Role         *string                `protobuf:"bytes,1,opt,name=role,proto3,oneof" json:"role,omitempty"`
+	DepartmentId *uint64                `protobuf:"varint,2,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	Status       *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// Page size, 1 to 500, 50 by default.
+	Limit *int32 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// next_page_token of the previous page.
+	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// One of id, last_name, first_name, hire_date, created_at, updated_at.
+	SortBy *string `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
+	// asc or desc.
+	SortOrder    *string `protobuf:"bytes,7,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"`
+	IncludeTotal *bool   `protobuf:"varint,8,opt,name=include_total,json=includeTotal,proto3,oneof" json:"include_total,omitempty"`
+	ManagerId    *uint64 `protobuf:"varint,9,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	IsActive     *bool   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	// Hire date range, both bounds inclusive.
+	HiredFrom *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=hired_from,json=hiredFrom,proto3" json:"hired_from,omitempty"`
+	HiredTo   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=hired_to,json=hiredTo,proto3" json:"hired_to,omitempty"`
+	// Month of the birthday, 1 to 12.
+	BirthdayMonth *int32 `protobuf:"varint,13,opt,name=birthday_month,json=birthdayMonth,proto3,oneof" json:"birthday_month,omitempty"`
+	// Case-insensitive position.
+	Position      *string `protobuf:"bytes,14,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeesRequest) Reset() {
+	*x = GetEmployeesRequest{}
+	mi := &file_employee_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeesRequest) ProtoMessage() {}
+
+func (x *GetEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetEmployeesRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+func (x *GetEmployeesRequest) GetDepartmentId() uint64 {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return 0
+}
+
+func (x *GetEmployeesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *GetEmployeesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetEmployeesRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *GetEmployeesRequest) GetSortBy() string {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ""
+}
+
+func (x *GetEmployeesRequest) GetSortOrder() string {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetEmployeesRequest) GetIncludeTotal() bool {
+	if x != nil && x.IncludeTotal != nil {
+		return *x.IncludeTotal
+	}
+	return false
+}
+
+func (x *GetEmployeesRequest) GetManagerId() uint64 {
+	if x != nil && x.ManagerId != nil {
+		return *x.ManagerId
+	}
+	return 0
+}
+
+func (x *GetEmployeesRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *GetEmployeesRequest) GetHiredFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HiredFrom
+	}
+	return nil
+}
+
+func (x *GetEmployeesRequest) GetHiredTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HiredTo
+	}
+	return nil
+}
+
+func (x *GetEmployeesRequest) GetBirthdayMonth() int32 {
+	if x != nil && x.BirthdayMonth != nil {
+		return *x.BirthdayMonth
+	}
+	return 0
+}
+
+func (x *GetEmployeesRequest) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+// GetEmployeesResponse contains a page of employees.
+type GetEmployeesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Employees []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Set when include_total was requested.
+	TotalCount    *int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeesResponse) Reset() {
+	*x = GetEmployeesResponse{}
+	mi := &file_employee_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeesResponse) ProtoMessage() {}
+
+func (x *GetEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *GetEmployeesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetEmployeesResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+// SearchEmployeesRequest contains the search query.
+type SearchEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Q     string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// Maximum number of results, 1 to 100, 20 by default.
+	Limit *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Also match the query transliterated between Latin and Cyrillic.
+	Translit *bool `protobuf:"varint,3,opt,name=translit,proto3,oneof" json:"translit,omitempty"`
+	// Only employees with the status, fired employees are found only with status fired.
+	Status        *string `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEmployeesRequest) Reset() {
+	*x = SearchEmployeesRequest{}
+	mi := &file_employee_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEmployeesRequest) ProtoMessage() {}
+
+func (x *SearchEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*SearchEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchEmployeesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchEmployeesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *SearchEmployeesRequest) GetTranslit() bool {
+	if x != nil && x.Translit != nil {
+		return *x.Translit
+	}
+	return false
+}
+
+func (x *SearchEmployeesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// SearchEmployeesResponse contains the found employees, best matches first.
+type SearchEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEmployeesResponse) Reset() {
+	*x = SearchEmployeesResponse{}
+	mi := &file_employee_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEmployeesResponse) ProtoMessage() {}
+
+func (x *SearchEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*SearchEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+// GetDepartmentsResponse contains a list of departments.
+type GetDepartmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentsResponse) Reset() {
+	*x = GetDepartmentsResponse{}
+	mi := &file_employee_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentsResponse) ProtoMessage() {}
+
+func (x *GetDepartmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetDepartmentsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetDepartmentsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+// RevokeSessionRequest contains the ID of the session to revoke.
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_employee_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RevokeSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ClearLockoutRequest identifies the lockout to clear, scope is email or ip.
+type ClearLockoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearLockoutRequest) Reset() {
+	*x = ClearLockoutRequest{}
+	mi := &file_employee_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearLockoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLockoutRequest) ProtoMessage() {}
+
+func (x *ClearLockoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLockoutRequest.ProtoReflect.Descriptor instead.
+func (*ClearLockoutRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ClearLockoutRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ClearLockoutRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// UpdateAPIKeyRequest contains the ID and the new data of an API key.
+type UpdateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApiKey        *APIKeyForm            `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAPIKeyRequest) Reset() {
+	*x = UpdateAPIKeyRequest{}
+	mi := &file_employee_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAPIKeyRequest) ProtoMessage() {}
+
+func (x *UpdateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateAPIKeyRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAPIKeyRequest) GetApiKey() *APIKeyForm {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+// DeleteAPIKeyRequest contains the ID of the API key to revoke.
+type DeleteAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAPIKeyRequest) Reset() {
+	*x = DeleteAPIKeyRequest{}
+	mi := &file_employee_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAPIKeyRequest) ProtoMessage() {}
+
+func (x *DeleteAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteAPIKeyRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteCalendarTokenRequest contains the ID of the calendar token to revoke.
+type DeleteCalendarTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarTokenRequest) Reset() {
+	*x = DeleteCalendarTokenRequest{}
+	mi := &file_employee_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarTokenRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarTokenRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteCalendarTokenRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ImpersonateRequest contains the ID of the employee to impersonate.
+type ImpersonateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateRequest) Reset() {
+	*x = ImpersonateRequest{}
+	mi := &file_employee_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateRequest) ProtoMessage() {}
+
+func (x *ImpersonateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ImpersonateRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetAuditLogRequest contains the optional filters of the audit trail.
+type GetAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       *uint64                `protobuf:"varint,1,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	EmployeeId    *uint64                `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogRequest) Reset() {
+	*x = GetAuditLogRequest{}
+	mi := &file_employee_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogRequest) ProtoMessage() {}
+
+func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetAuditLogRequest) GetActorId() uint64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *GetAuditLogRequest) GetEmployeeId() uint64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return 0
+}
+
+// GetEmployeeByIDRequest contains the ID for retrieving an employee.
+type GetEmployeeByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeByIDRequest) Reset() {
+	*x = GetEmployeeByIDRequest{}
+	mi := &file_employee_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeByIDRequest) ProtoMessage() {}
+
+func (x *GetEmployeeByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeByIDRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetEmployeeByIDRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// UpdateEmployeeRequest contains the ID and employee data for updating.
+type UpdateEmployeeRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Employee *Employee              `protobuf:"bytes,2,opt,name=employee,proto3" json:"employee,omitempty"`
+	// Fields of employee to change. Without a mask the whole employee is replaced.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Version of the employee the change is based on, required.
+	ExpectedVersion uint64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeRequest) Reset() {
+	*x = UpdateEmployeeRequest{}
+	mi := &file_employee_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeRequest) ProtoMessage() {}
+
+func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateEmployeeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEmployeeRequest) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// DeleteEmployeeRequest contains the ID for deleting an employee.
+type DeleteEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the employee the deletion is based on, required.
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeRequest) Reset() {
+	*x = DeleteEmployeeRequest{}
+	mi := &file_employee_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeRequest) ProtoMessage() {}
+
+func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteEmployeeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteEmployeeRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// RestoreEmployeeRequest contains the ID of the employee to rehire.
+type RestoreEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEmployeeRequest) Reset() {
+	*x = RestoreEmployeeRequest{}
+	mi := &file_employee_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmployeeRequest) ProtoMessage() {}
+
+func (x *RestoreEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RestoreEmployeeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// PurgeEmployeeRequest contains the ID of the employee to erase.
+type PurgeEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the employee the purge is based on, required.
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PurgeEmployeeRequest) Reset() {
+	*x = PurgeEmployeeRequest{}
+	mi := &file_employee_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEmployeeRequest) ProtoMessage() {}
+
+func (x *PurgeEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*PurgeEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PurgeEmployeeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurgeEmployeeRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// RequestVacationRequest contains the ID of the employee and the vacation data.
+type RequestVacationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vacation      *VacationRequestForm   `protobuf:"bytes,2,opt,name=vacation,proto3" json:"vacation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestVacationRequest) Reset() {
+	*x = RequestVacationRequest{}
+	mi := &file_employee_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestVacationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestVacationRequest) ProtoMessage() {}
+
+func (x *RequestVacationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestVacationRequest.ProtoReflect.Descriptor instead.
+func (*RequestVacationRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RequestVacationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RequestVacationRequest) GetVacation() *VacationRequestForm {
+	if x != nil {
+		return x.Vacation
+	}
+	return nil
+}
+
+// GetVacationRequestsRequest contains the optional filters of vacation requests.
+type GetVacationRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *string                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	EmployeeId    *uint64                `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacationRequestsRequest) Reset() {
+	*x = GetVacationRequestsRequest{}
+	mi := &file_employee_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacationRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacationRequestsRequest) ProtoMessage() {}
+
+func (x *GetVacationRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacationRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetVacationRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetVacationRequestsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *GetVacationRequestsRequest) GetEmployeeId() uint64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return 0
+}
+
+// ApproveVacationRequestRequest contains the ID of the vacation request to approve.
+type ApproveVacationRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveVacationRequestRequest) Reset() {
+	*x = ApproveVacationRequestRequest{}
+	mi := &file_employee_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveVacationRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveVacationRequestRequest) ProtoMessage() {}
+
+func (x *ApproveVacationRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveVacationRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveVacationRequestRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ApproveVacationRequestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// RejectVacationRequestRequest contains the ID of the vacation request to reject and the reason.
+type RejectVacationRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectVacationRequestRequest) Reset() {
+	*x = RejectVacationRequestRequest{}
+	mi := &file_employee_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectVacationRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectVacationRequestRequest) ProtoMessage() {}
+
+func (x *RejectVacationRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectVacationRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectVacationRequestRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RejectVacationRequestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectVacationRequestRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// CancelVacationRequestRequest contains the ID of the vacation request to cancel.
+type CancelVacationRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelVacationRequestRequest) Reset() {
+	*x = CancelVacationRequestRequest{}
+	mi := &file_employee_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelVacationRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVacationRequestRequest) ProtoMessage() {}
+
+func (x *CancelVacationRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVacationRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelVacationRequestRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CancelVacationRequestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetLeaveBalanceRequest contains the employee and the date of the balances, today by default.
+type GetLeaveBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaveBalanceRequest) Reset() {
+	*x = GetLeaveBalanceRequest{}
+	mi := &file_employee_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaveBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveBalanceRequest) ProtoMessage() {}
+
+func (x *GetLeaveBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaveBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaveBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetLeaveBalanceRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetLeaveBalanceRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// GetLeaveLedgerRequest contains the employee and the optional filters of the ledger.
+type GetLeaveLedgerRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeaveType *string                `protobuf:"bytes,2,opt,name=leave_type,json=leaveType,proto3,oneof" json:"leave_type,omitempty"`
+	// Effective date range, both bounds inclusive.
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaveLedgerRequest) Reset() {
+	*x = GetLeaveLedgerRequest{}
+	mi := &file_employee_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaveLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveLedgerRequest) ProtoMessage() {}
+
+func (x *GetLeaveLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaveLedgerRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaveLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetLeaveLedgerRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetLeaveLedgerRequest) GetLeaveType() string {
+	if x != nil && x.LeaveType != nil {
+		return *x.LeaveType
+	}
+	return ""
+}
+
+func (x *GetLeaveLedgerRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetLeaveLedgerRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// AdjustLeaveRequest contains the employee and the adjustment of the balance.
+type AdjustLeaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Adjustment    *LeaveAdjustment       `protobuf:"bytes,2,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustLeaveRequest) Reset() {
+	*x = AdjustLeaveRequest{}
+	mi := &file_employee_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustLeaveRequest) ProtoMessage() {}
+
+func (x *AdjustLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustLeaveRequest.ProtoReflect.Descriptor instead.
+func (*AdjustLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AdjustLeaveRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdjustLeaveRequest) GetAdjustment() *LeaveAdjustment {
+	if x != nil {
+		return x.Adjustment
+	}
+	return nil
+}
+
+// RegisterSickLeaveRequest contains the employee and the sick leave to register.
+type RegisterSickLeaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SickLeave     *SickLeaveForm         `protobuf:"bytes,2,opt,name=sick_leave,json=sickLeave,proto3" json:"sick_leave,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterSickLeaveRequest) Reset() {
+	*x = RegisterSickLeaveRequest{}
+	mi := &file_employee_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterSickLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterSickLeaveRequest) ProtoMessage() {}
+
+func (x *RegisterSickLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterSickLeaveRequest.ProtoReflect.Descriptor instead.
+func (*RegisterSickLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RegisterSickLeaveRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RegisterSickLeaveRequest) GetSickLeave() *SickLeaveForm {
+	if x != nil {
+		return x.SickLeave
+	}
+	return nil
+}
+
+// GetSickLeavesRequest contains the optional filters of sick leaves.
+type GetSickLeavesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Period the leaves overlap, both bounds inclusive.
+	From         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	DepartmentId *uint64                `protobuf:"varint,3,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	EmployeeId   *uint64                `protobuf:"varint,4,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	// Only open leaves if true, only closed ones if false.
+	Open          *bool `protobuf:"varint,5,opt,name=open,proto3,oneof" json:"open,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSickLeavesRequest) Reset() {
+	*x = GetSickLeavesRequest{}
+	mi := &file_employee_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSickLeavesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSickLeavesRequest) ProtoMessage() {}
+
+func (x *GetSickLeavesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSickLeavesRequest.ProtoReflect.Descriptor instead.
+func (*GetSickLeavesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetSickLeavesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetSickLeavesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetSickLeavesRequest) GetDepartmentId() uint64 {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return 0
+}
+
+func (x *GetSickLeavesRequest) GetEmployeeId() uint64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return 0
+}
+
+func (x *GetSickLeavesRequest) GetOpen() bool {
+	if x != nil && x.Open != nil {
+		return *x.Open
+	}
+	return false
+}
+
+// CloseSickLeaveRequest contains the sick leave to close and its last day.
+type CloseSickLeaveRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Number of the medical certificate, if it was not known when the leave was registered.
+	CertificateNumber *string `protobuf:"bytes,3,opt,name=certificate_number,json=certificateNumber,proto3,oneof" json:"certificate_number,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CloseSickLeaveRequest) Reset() {
+	*x = CloseSickLeaveRequest{}
+	mi := &file_employee_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseSickLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSickLeaveRequest) ProtoMessage() {}
+
+func (x *CloseSickLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSickLeaveRequest.ProtoReflect.Descriptor instead.
+func (*CloseSickLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CloseSickLeaveRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CloseSickLeaveRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CloseSickLeaveRequest) GetCertificateNumber() string {
+	if x != nil && x.CertificateNumber != nil {
+		return *x.CertificateNumber
+	}
+	return ""
+}
+
+// CountWorkingDaysRequest contains the employee and the period, both dates included.
+type CountWorkingDaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountWorkingDaysRequest) Reset() {
+	*x = CountWorkingDaysRequest{}
+	mi := &file_employee_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountWorkingDaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountWorkingDaysRequest) ProtoMessage() {}
+
+func (x *CountWorkingDaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountWorkingDaysRequest.ProtoReflect.Descriptor instead.
+func (*CountWorkingDaysRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CountWorkingDaysRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CountWorkingDaysRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CountWorkingDaysRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// DeleteCalendarRequest contains the ID of the calendar to delete.
+type DeleteCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarRequest) Reset() {
+	*x = DeleteCalendarRequest{}
+	mi := &file_employee_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteCalendarRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetCalendarDaysRequest contains the calendar and an optional period.
+type GetCalendarDaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarDaysRequest) Reset() {
+	*x = GetCalendarDaysRequest{}
+	mi := &file_employee_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarDaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarDaysRequest) ProtoMessage() {}
+
+func (x *GetCalendarDaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarDaysRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarDaysRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetCalendarDaysRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetCalendarDaysRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetCalendarDaysRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// ImportCalendarDaysRequest contains the file to import into the calendar.
+type ImportCalendarDaysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ics or csv.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Content of the file, up to 1 MiB.
+	Content       []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCalendarDaysRequest) Reset() {
+	*x = ImportCalendarDaysRequest{}
+	mi := &file_employee_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCalendarDaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCalendarDaysRequest) ProtoMessage() {}
+
+func (x *ImportCalendarDaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCalendarDaysRequest.ProtoReflect.Descriptor instead.
+func (*ImportCalendarDaysRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ImportCalendarDaysRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportCalendarDaysRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportCalendarDaysRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// DeleteCalendarDayRequest contains the calendar and the date of the day to remove.
+type DeleteCalendarDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarDayRequest) Reset() {
+	*x = DeleteCalendarDayRequest{}
+	mi := &file_employee_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarDayRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarDayRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarDayRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteCalendarDayRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCalendarDayRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+// CreatePasswordResetRequest contains the ID of the employee whose password is reset.
+type CreatePasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePasswordResetRequest) Reset() {
+	*x = CreatePasswordResetRequest{}
+	mi := &file_employee_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePasswordResetRequest) ProtoMessage() {}
+
+func (x *CreatePasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*CreatePasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreatePasswordResetRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetDepartmentByIDRequest contains the ID for retrieving a department.
+type GetDepartmentByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentByIDRequest) Reset() {
+	*x = GetDepartmentByIDRequest{}
+	mi := &file_employee_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentByIDRequest) ProtoMessage() {}
+
+func (x *GetDepartmentByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentByIDRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetDepartmentByIDRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// UpdateDepartmentRequest contains the ID and department data for updating.
+type UpdateDepartmentRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Department *DepartmentForm        `protobuf:"bytes,2,opt,name=department,proto3" json:"department,omitempty"`
+	// Fields of department to change. Without a mask the whole department is replaced.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Version of the department the change is based on, required.
+	ExpectedVersion uint64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateDepartmentRequest) Reset() {
+	*x = UpdateDepartmentRequest{}
+	mi := &file_employee_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepartmentRequest) ProtoMessage() {}
+
+func (x *UpdateDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateDepartmentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateDepartmentRequest) GetDepartment() *DepartmentForm {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+func (x *UpdateDepartmentRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateDepartmentRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// DeleteDepartmentRequest contains the ID for deleting a department.
+type DeleteDepartmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the department the deletion is based on, required.
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteDepartmentRequest) Reset() {
+	*x = DeleteDepartmentRequest{}
+	mi := &file_employee_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentRequest) ProtoMessage() {}
+
+func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_employee_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteDepartmentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteDepartmentRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+var File_employee_service_proto protoreflect.FileDescriptor
+
+const file_employee_service_proto_rawDesc = "" +
+	"\n" +
+	"\x16employee_service.proto\x12\x10employee_service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x19google/protobuf/any.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/api/annotations.proto\"c\n" +
 	"\vApiResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x03R\x06status\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12(\n" +
 	"\x04data\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\x04data\"!\n" +
 	"\tErrorData\x12\x14\n" +
-	"\x05error\x18\x01 \x01(\tR\x05error\"\xf1\a\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\"\x8b\b\n" +
 	"\bEmployee\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
 	"\n" +
@@ -1187,7 +5838,8 @@ const file_employee_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"updated_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x17 \x01(\x04R\aversionB\x0e\n" +
 	"\f_middle_nameB\b\n" +
 	"\x06_phoneB\x12\n" +
 	"\x10_personal_numberB\b\n" +
@@ -1202,7 +5854,7 @@ const file_employee_service_proto_rawDesc = "" +
 	"_fire_dateB\v\n" +
 	"\t_birthdayB\n" +
 	"\n" +
-	"\b_address\"\xb7\x02\n" +
+	"\b_address\"\x88\x04\n" +
 	"\n" +
 	"Department\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
@@ -1213,75 +5865,579 @@ const file_employee_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x04R\aversion\x12$\n" +
+	"\vcalendar_id\x18\t \x01(\x04H\x03R\n" +
+	"calendarId\x88\x01\x01\x12#\n" +
+	"\vmin_on_duty\x18\n" +
+	" \x01(\x04H\x04R\tminOnDuty\x88\x01\x01\x122\n" +
+	"\x13min_on_duty_percent\x18\v \x01(\x04H\x05R\x10minOnDutyPercent\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
 	"_parent_idB\n" +
 	"\n" +
-	"\b_head_id\"\xb5\x01\n" +
+	"\b_head_idB\x0e\n" +
+	"\f_calendar_idB\x0e\n" +
+	"\f_min_on_dutyB\x16\n" +
+	"\x14_min_on_duty_percent\"\xec\x02\n" +
 	"\x0eDepartmentForm\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12 \n" +
 	"\tparent_id\x18\x03 \x01(\x04H\x01R\bparentId\x88\x01\x01\x12\x1c\n" +
-	"\ahead_id\x18\x04 \x01(\x04H\x02R\x06headId\x88\x01\x01B\x0e\n" +
+	"\ahead_id\x18\x04 \x01(\x04H\x02R\x06headId\x88\x01\x01\x12$\n" +
+	"\vcalendar_id\x18\x05 \x01(\x04H\x03R\n" +
+	"calendarId\x88\x01\x01\x12#\n" +
+	"\vmin_on_duty\x18\x06 \x01(\x04H\x04R\tminOnDuty\x88\x01\x01\x122\n" +
+	"\x13min_on_duty_percent\x18\a \x01(\x04H\x05R\x10minOnDutyPercent\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
 	"_parent_idB\n" +
 	"\n" +
-	"\b_head_id\"@\n" +
+	"\b_head_idB\x0e\n" +
+	"\f_calendar_idB\x0e\n" +
+	"\f_min_on_dutyB\x16\n" +
+	"\x14_min_on_duty_percent\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"W\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"t\n" +
 	"\rLoginResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"%\n" +
-	"\x0fVacationRequest\x12\x12\n" +
-	"\x04days\x18\x01 \x01(\x04R\x04days\"\x9b\x01\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1b\n" +
+	"\tmfa_token\x18\x03 \x01(\tR\bmfaToken\"@\n" +
+	"\x11OIDCLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\"?\n" +
+	"\x13OIDCCallbackRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"$\n" +
+	"\x0eMFACodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"C\n" +
+	"\x10MFAVerifyRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"9\n" +
+	"\rMFAEnrollment\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\"{\n" +
+	"\n" +
+	"MFAEnabled\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\"5\n" +
+	"\x0eRefreshRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"]\n" +
+	"\x15ChangePasswordRequest\x12!\n" +
+	"\fold_password\x18\x01 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"Z\n" +
+	"\x14ResetPasswordRequest\x12\x1f\n" +
+	"\vreset_token\x18\x01 \x01(\tR\n" +
+	"resetToken\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"s\n" +
+	"\x15PasswordResetResponse\x12\x1f\n" +
+	"\vreset_token\x18\x01 \x01(\tR\n" +
+	"resetToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xb1\x01\n" +
+	"\vSessionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\frefreshed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAt\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\bR\acurrent\"P\n" +
+	"\x13GetSessionsResponse\x129\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1d.employee_service.SessionInfoR\bsessions\"\x8c\x01\n" +
+	"\aLockout\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1a\n" +
+	"\bfailures\x18\x03 \x01(\x03R\bfailures\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"L\n" +
+	"\x13GetLockoutsResponse\x125\n" +
+	"\blockouts\x18\x01 \x03(\v2\x19.employee_service.LockoutR\blockouts\"\xd9\x02\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12\"\n" +
+	"\n" +
+	"created_by\x18\b \x01(\x04H\x00R\tcreatedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_created_by\"\x89\x01\n" +
+	"\n" +
+	"APIKeyForm\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"T\n" +
+	"\rCreatedAPIKey\x121\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x18.employee_service.APIKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"I\n" +
+	"\x12GetAPIKeysResponse\x123\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x18.employee_service.APIKeyR\aapiKeys\"\xe5\x01\n" +
+	"\rCalendarToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\x04R\n" +
+	"employeeId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"'\n" +
+	"\x11CalendarTokenForm\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"t\n" +
+	"\x14CreatedCalendarToken\x12F\n" +
+	"\x0ecalendar_token\x18\x01 \x01(\v2\x1f.employee_service.CalendarTokenR\rcalendarToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"e\n" +
+	"\x19GetCalendarTokensResponse\x12H\n" +
+	"\x0fcalendar_tokens\x18\x01 \x03(\v2\x1f.employee_service.CalendarTokenR\x0ecalendarTokens\"u\n" +
+	"\x15ImpersonationResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x8a\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x04R\aactorId\x12$\n" +
+	"\vemployee_id\x18\x03 \x01(\x04H\x00R\n" +
+	"employeeId\x88\x01\x01\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12 \n" +
+	"\ttarget_id\x18\x05 \x01(\x04H\x01R\btargetId\x88\x01\x01\x12\x18\n" +
+	"\aallowed\x18\x06 \x01(\bR\aallowed\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_employee_idB\f\n" +
+	"\n" +
+	"_target_id\"M\n" +
+	"\x13GetAuditLogResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.employee_service.AuditEntryR\aentries\"\xc6\x01\n" +
+	"\x13VacationRequestForm\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1d\n" +
+	"\acomment\x18\x04 \x01(\tH\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"\x89\x05\n" +
+	"\x0fVacationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\x04R\n" +
+	"employeeId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x12\n" +
+	"\x04days\x18\x06 \x01(\x04R\x04days\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\acomment\x18\b \x01(\tH\x00R\acomment\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"manager_id\x18\t \x01(\x04H\x01R\tmanagerId\x88\x01\x01\x121\n" +
+	"\x12manager_decided_by\x18\n" +
+	" \x01(\x04H\x02R\x10managerDecidedBy\x88\x01\x01\x12'\n" +
+	"\rhr_decided_by\x18\v \x01(\x04H\x03R\vhrDecidedBy\x88\x01\x01\x12(\n" +
+	"\rreject_reason\x18\f \x01(\tH\x04R\frejectReason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"\n" +
+	"\b_commentB\r\n" +
+	"\v_manager_idB\x15\n" +
+	"\x13_manager_decided_byB\x10\n" +
+	"\x0e_hr_decided_byB\x10\n" +
+	"\x0e_reject_reason\"\\\n" +
+	"\x1bGetVacationRequestsResponse\x12=\n" +
+	"\brequests\x18\x01 \x03(\v2!.employee_service.VacationRequestR\brequests\"\xef\x03\n" +
+	"\n" +
+	"LeaveEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\x04R\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"leave_type\x18\x03 \x01(\tR\tleaveType\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04days\x18\x05 \x01(\x01R\x04days\x12A\n" +
+	"\x0eeffective_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveDate\x12\x1b\n" +
+	"\x06period\x18\a \x01(\x03H\x00R\x06period\x88\x01\x01\x123\n" +
+	"\x13vacation_request_id\x18\b \x01(\x04H\x01R\x11vacationRequestId\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\t \x01(\tH\x02R\acomment\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\x04H\x03R\tcreatedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\abalance\x18\f \x01(\x01R\abalanceB\t\n" +
+	"\a_periodB\x16\n" +
+	"\x14_vacation_request_idB\n" +
+	"\n" +
+	"\b_commentB\r\n" +
+	"\v_created_by\"P\n" +
+	"\x16GetLeaveLedgerResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.employee_service.LeaveEntryR\aentries\"\xab\x01\n" +
+	"\fLeaveBalance\x12\x1d\n" +
+	"\n" +
+	"leave_type\x18\x01 \x01(\tR\tleaveType\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x18\n" +
+	"\aaccrued\x18\x03 \x01(\x01R\aaccrued\x12\x12\n" +
+	"\x04used\x18\x04 \x01(\x01R\x04used\x12\x1a\n" +
+	"\badjusted\x18\x05 \x01(\x01R\badjusted\x12\x18\n" +
+	"\aexpired\x18\x06 \x01(\x01R\aexpired\"\x9d\x01\n" +
+	"\rLeaveBalances\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x04R\n" +
+	"employeeId\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12:\n" +
+	"\bbalances\x18\x03 \x03(\v2\x1e.employee_service.LeaveBalanceR\bbalances\"\xb9\x01\n" +
+	"\x0fLeaveAdjustment\x12\x1d\n" +
+	"\n" +
+	"leave_type\x18\x01 \x01(\tR\tleaveType\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x01R\x04days\x12F\n" +
+	"\x0eeffective_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\reffectiveDate\x88\x01\x01\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acommentB\x11\n" +
+	"\x0f_effective_date\"\xa3\x04\n" +
+	"\tSickLeave\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\x04R\n" +
+	"employeeId\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x12:\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\aendDate\x88\x01\x01\x12\x12\n" +
+	"\x04days\x18\x05 \x01(\x04R\x04days\x122\n" +
+	"\x12certificate_number\x18\x06 \x01(\tH\x01R\x11certificateNumber\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\a \x01(\tH\x02R\acomment\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\b \x01(\x04H\x03R\tcreatedBy\x88\x01\x01\x12 \n" +
+	"\tclosed_by\x18\t \x01(\x04H\x04R\bclosedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\v\n" +
+	"\t_end_dateB\x15\n" +
+	"\x13_certificate_numberB\n" +
+	"\n" +
+	"\b_commentB\r\n" +
+	"\v_created_byB\f\n" +
+	"\n" +
+	"_closed_by\"\x89\x02\n" +
+	"\rSickLeaveForm\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x12:\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\aendDate\x88\x01\x01\x122\n" +
+	"\x12certificate_number\x18\x03 \x01(\tH\x01R\x11certificateNumber\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\x04 \x01(\tH\x02R\acomment\x88\x01\x01B\v\n" +
+	"\t_end_dateB\x15\n" +
+	"\x13_certificate_numberB\n" +
+	"\n" +
+	"\b_comment\"U\n" +
+	"\x15GetSickLeavesResponse\x12<\n" +
+	"\vsick_leaves\x18\x01 \x03(\v2\x1b.employee_service.SickLeaveR\n" +
+	"sickLeaves\"\xdb\x01\n" +
+	"\bCalendar\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_description\"Y\n" +
+	"\fCalendarForm\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"\x94\x01\n" +
+	"\vCalendarDay\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\x04R\n" +
+	"calendarId\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x17\n" +
+	"\x04name\x18\x04 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"P\n" +
+	"\x14GetCalendarsResponse\x128\n" +
+	"\tcalendars\x18\x01 \x03(\v2\x1a.employee_service.CalendarR\tcalendars\"L\n" +
+	"\x17GetCalendarDaysResponse\x121\n" +
+	"\x04days\x18\x01 \x03(\v2\x1d.employee_service.CalendarDayR\x04days\"M\n" +
+	"\x0eCalendarImport\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\x04R\n" +
+	"calendarId\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x03R\bimported\"\xa4\x02\n" +
+	"\vWorkingDays\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x04R\n" +
+	"employeeId\x12$\n" +
+	"\vcalendar_id\x18\x02 \x01(\x04H\x00R\n" +
+	"calendarId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12#\n" +
+	"\rcalendar_days\x18\x05 \x01(\x04R\fcalendarDays\x12!\n" +
+	"\fworking_days\x18\x06 \x01(\x04R\vworkingDays\x12\x1a\n" +
+	"\bholidays\x18\a \x01(\x04R\bholidaysB\x0e\n" +
+	"\f_calendar_id\"\xb0\x01\n" +
+	"\x10StaffingConflict\x12#\n" +
+	"\rdepartment_id\x18\x01 \x01(\x04R\fdepartmentId\x12\x1c\n" +
+	"\theadcount\x18\x02 \x01(\x04R\theadcount\x12\x1e\n" +
+	"\vmin_on_duty\x18\x03 \x01(\x04R\tminOnDuty\x129\n" +
+	"\x04days\x18\x04 \x03(\v2%.employee_service.StaffingConflictDayR\x04days\"\x99\x01\n" +
+	"\x13StaffingConflictDay\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x17\n" +
+	"\aon_duty\x18\x02 \x01(\x04R\x06onDuty\x129\n" +
+	"\x06absent\x18\x03 \x03(\v2!.employee_service.StaffingAbsenceR\x06absent\"\xf8\x01\n" +
+	"\x0fStaffingAbsence\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x04R\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xce\x05\n" +
 	"\x13GetEmployeesRequest\x12\x17\n" +
 	"\x04role\x18\x01 \x01(\tH\x00R\x04role\x88\x01\x01\x12(\n" +
 	"\rdepartment_id\x18\x02 \x01(\x04H\x01R\fdepartmentId\x88\x01\x01\x12\x1b\n" +
-	"\x06status\x18\x03 \x01(\tH\x02R\x06status\x88\x01\x01B\a\n" +
+	"\x06status\x18\x03 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x03R\x05limit\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tH\x04R\tpageToken\x88\x01\x01\x12\x1c\n" +
+	"\asort_by\x18\x06 \x01(\tH\x05R\x06sortBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\tH\x06R\tsortOrder\x88\x01\x01\x12(\n" +
+	"\rinclude_total\x18\b \x01(\bH\aR\fincludeTotal\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"manager_id\x18\t \x01(\x04H\bR\tmanagerId\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\n" +
+	" \x01(\bH\tR\bisActive\x88\x01\x01\x129\n" +
+	"\n" +
+	"hired_from\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\thiredFrom\x125\n" +
+	"\bhired_to\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\ahiredTo\x12*\n" +
+	"\x0ebirthday_month\x18\r \x01(\x05H\n" +
+	"R\rbirthdayMonth\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x0e \x01(\tH\vR\bposition\x88\x01\x01B\a\n" +
 	"\x05_roleB\x10\n" +
 	"\x0e_department_idB\t\n" +
-	"\a_status\"P\n" +
+	"\a_statusB\b\n" +
+	"\x06_limitB\r\n" +
+	"\v_page_tokenB\n" +
+	"\n" +
+	"\b_sort_byB\r\n" +
+	"\v_sort_orderB\x10\n" +
+	"\x0e_include_totalB\r\n" +
+	"\v_manager_idB\f\n" +
+	"\n" +
+	"_is_activeB\x11\n" +
+	"\x0f_birthday_monthB\v\n" +
+	"\t_position\"\xae\x01\n" +
 	"\x14GetEmployeesResponse\x128\n" +
+	"\temployees\x18\x01 \x03(\v2\x1a.employee_service.EmployeeR\temployees\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\xa1\x01\n" +
+	"\x16SearchEmployeesRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1f\n" +
+	"\btranslit\x18\x03 \x01(\bH\x01R\btranslit\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x02R\x06status\x88\x01\x01B\b\n" +
+	"\x06_limitB\v\n" +
+	"\t_translitB\t\n" +
+	"\a_status\"S\n" +
+	"\x17SearchEmployeesResponse\x128\n" +
 	"\temployees\x18\x01 \x03(\v2\x1a.employee_service.EmployeeR\temployees\"X\n" +
 	"\x16GetDepartmentsResponse\x12>\n" +
-	"\vdepartments\x18\x01 \x03(\v2\x1c.employee_service.DepartmentR\vdepartments\"(\n" +
+	"\vdepartments\x18\x01 \x03(\v2\x1c.employee_service.DepartmentR\vdepartments\"&\n" +
+	"\x14RevokeSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x13ClearLockoutRequest\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\\\n" +
+	"\x13UpdateAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x125\n" +
+	"\aapi_key\x18\x02 \x01(\v2\x1c.employee_service.APIKeyFormR\x06apiKey\"%\n" +
+	"\x13DeleteAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\",\n" +
+	"\x1aDeleteCalendarTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"$\n" +
+	"\x12ImpersonateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"w\n" +
+	"\x12GetAuditLogRequest\x12\x1e\n" +
+	"\bactor_id\x18\x01 \x01(\x04H\x00R\aactorId\x88\x01\x01\x12$\n" +
+	"\vemployee_id\x18\x02 \x01(\x04H\x01R\n" +
+	"employeeId\x88\x01\x01B\v\n" +
+	"\t_actor_idB\x0e\n" +
+	"\f_employee_id\"(\n" +
 	"\x16GetEmployeeByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"_\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xc7\x01\n" +
 	"\x15UpdateEmployeeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x126\n" +
-	"\bemployee\x18\x02 \x01(\v2\x1a.employee_service.EmployeeR\bemployee\"'\n" +
+	"\bemployee\x18\x02 \x01(\v2\x1a.employee_service.EmployeeR\bemployee\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x04R\x0fexpectedVersion\"R\n" +
 	"\x15DeleteEmployeeRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"g\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x04R\x0fexpectedVersion\"(\n" +
+	"\x16RestoreEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"Q\n" +
+	"\x14PurgeEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x04R\x0fexpectedVersion\"k\n" +
 	"\x16RequestVacationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12=\n" +
-	"\bvacation\x18\x02 \x01(\v2!.employee_service.VacationRequestR\bvacation\"*\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12A\n" +
+	"\bvacation\x18\x02 \x01(\v2%.employee_service.VacationRequestFormR\bvacation\"z\n" +
+	"\x1aGetVacationRequestsRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12$\n" +
+	"\vemployee_id\x18\x02 \x01(\x04H\x01R\n" +
+	"employeeId\x88\x01\x01B\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_employee_id\"/\n" +
+	"\x1dApproveVacationRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"V\n" +
+	"\x1cRejectVacationRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\".\n" +
+	"\x1cCancelVacationRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"h\n" +
+	"\x16GetLeaveBalanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x124\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04asOf\x88\x01\x01B\b\n" +
+	"\x06_as_of\"\xd0\x01\n" +
+	"\x15GetLeaveLedgerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\"\n" +
+	"\n" +
+	"leave_type\x18\x02 \x01(\tH\x00R\tleaveType\x88\x01\x01\x123\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x02to\x88\x01\x01B\r\n" +
+	"\v_leave_typeB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"g\n" +
+	"\x12AdjustLeaveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12A\n" +
+	"\n" +
+	"adjustment\x18\x02 \x01(\v2!.employee_service.LeaveAdjustmentR\n" +
+	"adjustment\"j\n" +
+	"\x18RegisterSickLeaveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12>\n" +
+	"\n" +
+	"sick_leave\x18\x02 \x01(\v2\x1f.employee_service.SickLeaveFormR\tsickLeave\"\xa0\x02\n" +
+	"\x14GetSickLeavesRequest\x123\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01\x12(\n" +
+	"\rdepartment_id\x18\x03 \x01(\x04H\x02R\fdepartmentId\x88\x01\x01\x12$\n" +
+	"\vemployee_id\x18\x04 \x01(\x04H\x03R\n" +
+	"employeeId\x88\x01\x01\x12\x17\n" +
+	"\x04open\x18\x05 \x01(\bH\x04R\x04open\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\x10\n" +
+	"\x0e_department_idB\x0e\n" +
+	"\f_employee_idB\a\n" +
+	"\x05_open\"\xa9\x01\n" +
+	"\x15CloseSickLeaveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x122\n" +
+	"\x12certificate_number\x18\x03 \x01(\tH\x00R\x11certificateNumber\x88\x01\x01B\x15\n" +
+	"\x13_certificate_number\"\x85\x01\n" +
+	"\x17CountWorkingDaysRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"'\n" +
+	"\x15DeleteCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x9e\x01\n" +
+	"\x16GetCalendarDaysRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x123\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"]\n" +
+	"\x19ImportCalendarDaysRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"Z\n" +
+	"\x18DeleteCalendarDayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\",\n" +
+	"\x1aCreatePasswordResetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"*\n" +
 	"\x18GetDepartmentByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"k\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xd3\x01\n" +
 	"\x17UpdateDepartmentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12@\n" +
 	"\n" +
 	"department\x18\x02 \x01(\v2 .employee_service.DepartmentFormR\n" +
-	"department\")\n" +
+	"department\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x04R\x0fexpectedVersion\"T\n" +
 	"\x17DeleteDepartmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id2\xb1\f\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x04R\x0fexpectedVersion2\xa28\n" +
 	"\x0fEmployeeService\x12i\n" +
-	"\tAuthLogin\x12\x1e.employee_service.LoginRequest\x1a\x1d.employee_service.ApiResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/login\x12`\n" +
+	"\tAuthLogin\x12\x1e.employee_service.LoginRequest\x1a\x1d.employee_service.ApiResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/login\x12o\n" +
+	"\vAuthRefresh\x12 .employee_service.RefreshRequest\x1a\x1d.employee_service.ApiResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/auth/refresh\x12`\n" +
+	"\n" +
+	"AuthLogout\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/logout\x12z\n" +
+	"\x0eChangePassword\x12'.employee_service.ChangePasswordRequest\x1a\x1d.employee_service.ApiResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/password\x12~\n" +
+	"\rResetPassword\x12&.employee_service.ResetPasswordRequest\x1a\x1d.employee_service.ApiResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/auth/password/reset\x12c\n" +
+	"\vGetSessions\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/auth/sessions\x12z\n" +
+	"\rRevokeSession\x12&.employee_service.RevokeSessionRequest\x1a\x1d.employee_service.ApiResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/auth/sessions/{id}\x12i\n" +
+	"\x11RevokeAllSessions\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/auth/sessions\x12c\n" +
+	"\tOIDCLogin\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/auth/oidc/login\x12x\n" +
+	"\fOIDCCallback\x12%.employee_service.OIDCCallbackRequest\x1a\x1d.employee_service.ApiResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/auth/oidc/callback\x12c\n" +
+	"\tEnrollMFA\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/api/v1/auth/mfa/enroll\x12p\n" +
+	"\tEnableMFA\x12 .employee_service.MFACodeRequest\x1a\x1d.employee_service.ApiResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/auth/mfa/enable\x12r\n" +
+	"\n" +
+	"DisableMFA\x12 .employee_service.MFACodeRequest\x1a\x1d.employee_service.ApiResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/auth/mfa/disable\x12r\n" +
+	"\tVerifyMFA\x12\".employee_service.MFAVerifyRequest\x1a\x1d.employee_service.ApiResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/auth/mfa/verify\x12c\n" +
+	"\vGetLockouts\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/auth/lockouts\x12\x83\x01\n" +
+	"\fClearLockout\x12%.employee_service.ClearLockoutRequest\x1a\x1d.employee_service.ApiResponse\"-\x82\xd3\xe4\x93\x02'*%/api/v1/auth/lockouts/{scope}/{value}\x12b\n" +
 	"\n" +
-	"AuthLogout\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/logout\x12o\n" +
+	"GetAPIKeys\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/auth/api-keys\x12m\n" +
+	"\fCreateAPIKey\x12\x1c.employee_service.APIKeyForm\x1a\x1d.employee_service.ApiResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/api-keys\x12\x81\x01\n" +
+	"\fUpdateAPIKey\x12%.employee_service.UpdateAPIKeyRequest\x1a\x1d.employee_service.ApiResponse\"+\x82\xd3\xe4\x93\x02%:\aapi_key\x1a\x1a/api/v1/auth/api-keys/{id}\x12x\n" +
+	"\fDeleteAPIKey\x12%.employee_service.DeleteAPIKeyRequest\x1a\x1d.employee_service.ApiResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/auth/api-keys/{id}\x12p\n" +
+	"\x11GetCalendarTokens\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/auth/calendar-tokens\x12\x82\x01\n" +
+	"\x13CreateCalendarToken\x12#.employee_service.CalendarTokenForm\x1a\x1d.employee_service.ApiResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/auth/calendar-tokens\x12\x8d\x01\n" +
+	"\x13DeleteCalendarToken\x12,.employee_service.DeleteCalendarTokenRequest\x1a\x1d.employee_service.ApiResponse\")\x82\xd3\xe4\x93\x02#*!/api/v1/auth/calendar-tokens/{id}\x12~\n" +
+	"\vImpersonate\x12$.employee_service.ImpersonateRequest\x1a\x1d.employee_service.ApiResponse\"*\x82\xd3\xe4\x93\x02$\"\"/api/v1/employees/{id}/impersonate\x12m\n" +
+	"\vGetAuditLog\x12$.employee_service.GetAuditLogRequest\x1a\x1d.employee_service.ApiResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/audit-log\x12o\n" +
 	"\fGetEmployees\x12%.employee_service.GetEmployeesRequest\x1a\x1d.employee_service.ApiResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/employees\x12i\n" +
 	"\x0eCreateEmployee\x12\x1a.employee_service.Employee\x1a\x1d.employee_service.ApiResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/employees\x12{\n" +
-	"\x10GetEmployeesByID\x12(.employee_service.GetEmployeeByIDRequest\x1a\x1d.employee_service.ApiResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/employees/{id}\x12\x82\x01\n" +
-	"\x0eUpdateEmployee\x12'.employee_service.UpdateEmployeeRequest\x1a\x1d.employee_service.ApiResponse\"(\x82\xd3\xe4\x93\x02\":\bemployee\x1a\x16/api/v1/employees/{id}\x12x\n" +
-	"\x0eDeleteEmployee\x12'.employee_service.DeleteEmployeeRequest\x1a\x1d.employee_service.ApiResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/employees/{id}\x12\x8d\x01\n" +
-	"\x0fRequestVacation\x12(.employee_service.RequestVacationRequest\x1a\x1d.employee_service.ApiResponse\"1\x82\xd3\xe4\x93\x02+:\bvacation\"\x1f/api/v1/employees/{id}/vacation\x12d\n" +
+	"\x10GetEmployeesByID\x12(.employee_service.GetEmployeeByIDRequest\x1a\x1d.employee_service.ApiResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/employees/{id}\x12|\n" +
+	"\x0fSearchEmployees\x12(.employee_service.SearchEmployeesRequest\x1a\x1d.employee_service.ApiResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/employees/search\x12\xa6\x01\n" +
+	"\x0eUpdateEmployee\x12'.employee_service.UpdateEmployeeRequest\x1a\x1d.employee_service.ApiResponse\"L\x82\xd3\xe4\x93\x02F:\bemployeeZ\":\bemployee2\x16/api/v1/employees/{id}\x1a\x16/api/v1/employees/{id}\x12x\n" +
+	"\x0eDeleteEmployee\x12'.employee_service.DeleteEmployeeRequest\x1a\x1d.employee_service.ApiResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/employees/{id}\x12\x82\x01\n" +
+	"\x0fRestoreEmployee\x12(.employee_service.RestoreEmployeeRequest\x1a\x1d.employee_service.ApiResponse\"&\x82\xd3\xe4\x93\x02 \"\x1e/api/v1/employees/{id}/restore\x12|\n" +
+	"\rPurgeEmployee\x12&.employee_service.PurgeEmployeeRequest\x1a\x1d.employee_service.ApiResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x1c/api/v1/employees/{id}/purge\x12\x8d\x01\n" +
+	"\x0fRequestVacation\x12(.employee_service.RequestVacationRequest\x1a\x1d.employee_service.ApiResponse\"1\x82\xd3\xe4\x93\x02+:\bvacation\"\x1f/api/v1/employees/{id}/vacation\x12\x85\x01\n" +
+	"\x13GetVacationRequests\x12,.employee_service.GetVacationRequestsRequest\x1a\x1d.employee_service.ApiResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/vacation-requests\x12\x98\x01\n" +
+	"\x16ApproveVacationRequest\x12/.employee_service.ApproveVacationRequestRequest\x1a\x1d.employee_service.ApiResponse\".\x82\xd3\xe4\x93\x02(\"&/api/v1/vacation-requests/{id}/approve\x12\x98\x01\n" +
+	"\x15RejectVacationRequest\x12..employee_service.RejectVacationRequestRequest\x1a\x1d.employee_service.ApiResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/vacation-requests/{id}/reject\x12\x95\x01\n" +
+	"\x15CancelVacationRequest\x12..employee_service.CancelVacationRequestRequest\x1a\x1d.employee_service.ApiResponse\"-\x82\xd3\xe4\x93\x02'\"%/api/v1/vacation-requests/{id}/cancel\x12\x88\x01\n" +
+	"\x0fGetLeaveBalance\x12(.employee_service.GetLeaveBalanceRequest\x1a\x1d.employee_service.ApiResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/employees/{id}/leave/balance\x12\x85\x01\n" +
+	"\x0eGetLeaveLedger\x12'.employee_service.GetLeaveLedgerRequest\x1a\x1d.employee_service.ApiResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/employees/{id}/leave/ledger\x12\x90\x01\n" +
+	"\vAdjustLeave\x12$.employee_service.AdjustLeaveRequest\x1a\x1d.employee_service.ApiResponse\"<\x82\xd3\xe4\x93\x026:\n" +
+	"adjustment\"(/api/v1/employees/{id}/leave/adjustments\x12\x96\x01\n" +
+	"\x11RegisterSickLeave\x12*.employee_service.RegisterSickLeaveRequest\x1a\x1d.employee_service.ApiResponse\"6\x82\xd3\xe4\x93\x020:\n" +
+	"sick_leave\"\"/api/v1/employees/{id}/sick-leaves\x12s\n" +
+	"\rGetSickLeaves\x12&.employee_service.GetSickLeavesRequest\x1a\x1d.employee_service.ApiResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/sick-leaves\x12\x83\x01\n" +
+	"\x0eCloseSickLeave\x12'.employee_service.CloseSickLeaveRequest\x1a\x1d.employee_service.ApiResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/sick-leaves/{id}/close\x12\x89\x01\n" +
+	"\x10CountWorkingDays\x12).employee_service.CountWorkingDaysRequest\x1a\x1d.employee_service.ApiResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/employees/{id}/working-days\x12`\n" +
+	"\fGetCalendars\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/calendars\x12m\n" +
+	"\x0eCreateCalendar\x12\x1e.employee_service.CalendarForm\x1a\x1d.employee_service.ApiResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/calendars\x12x\n" +
+	"\x0eDeleteCalendar\x12'.employee_service.DeleteCalendarRequest\x1a\x1d.employee_service.ApiResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/calendars/{id}\x12\x7f\n" +
+	"\x0fGetCalendarDays\x12(.employee_service.GetCalendarDaysRequest\x1a\x1d.employee_service.ApiResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/calendars/{id}/days\x12\x8f\x01\n" +
+	"\x12ImportCalendarDays\x12+.employee_service.ImportCalendarDaysRequest\x1a\x1d.employee_service.ApiResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/calendars/{id}/days/import\x12\x8a\x01\n" +
+	"\x11DeleteCalendarDay\x12*.employee_service.DeleteCalendarDayRequest\x1a\x1d.employee_service.ApiResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/calendars/{id}/days/{date}\x12\x91\x01\n" +
+	"\x13CreatePasswordReset\x12,.employee_service.CreatePasswordResetRequest\x1a\x1d.employee_service.ApiResponse\"-\x82\xd3\xe4\x93\x02'\"%/api/v1/employees/{id}/password-reset\x12d\n" +
 	"\x0eGetDepartments\x12\x16.google.protobuf.Empty\x1a\x1d.employee_service.ApiResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/departments\x12s\n" +
 	"\x10CreateDepartment\x12 .employee_service.DepartmentForm\x1a\x1d.employee_service.ApiResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/departments\x12\x80\x01\n" +
-	"\x11GetDepartmentByID\x12*.employee_service.GetDepartmentByIDRequest\x1a\x1d.employee_service.ApiResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/departments/{id}\x12\x8a\x01\n" +
-	"\x10UpdateDepartment\x12).employee_service.UpdateDepartmentRequest\x1a\x1d.employee_service.ApiResponse\",\x82\xd3\xe4\x93\x02&:\n" +
-	"department\x1a\x18/api/v1/departments/{id}\x12~\n" +
+	"\x11GetDepartmentByID\x12*.employee_service.GetDepartmentByIDRequest\x1a\x1d.employee_service.ApiResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/departments/{id}\x12\xb2\x01\n" +
+	"\x10UpdateDepartment\x12).employee_service.UpdateDepartmentRequest\x1a\x1d.employee_service.ApiResponse\"T\x82\xd3\xe4\x93\x02N:\n" +
+	"departmentZ&:\n" +
+	"department2\x18/api/v1/departments/{id}\x1a\x18/api/v1/departments/{id}\x12~\n" +
 	"\x10DeleteDepartment\x12).employee_service.DeleteDepartmentRequest\x1a\x1d.employee_service.ApiResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/departments/{id}B+Z)github.com/adamanr/employes_service/protob\x06proto3"
 
 var (
@@ -1296,75 +6452,306 @@ func file_employee_service_proto_rawDescGZIP() []byte {
 	return file_employee_service_proto_rawDescData
 }
 
-var file_employee_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_employee_service_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_employee_service_proto_goTypes = []any{
-	(*ApiResponse)(nil),              // 0: employee_service.ApiResponse
-	(*ErrorData)(nil),                // 1: employee_service.ErrorData
-	(*Employee)(nil),                 // 2: employee_service.Employee
-	(*Department)(nil),               // 3: employee_service.Department
-	(*DepartmentForm)(nil),           // 4: employee_service.DepartmentForm
-	(*LoginRequest)(nil),             // 5: employee_service.LoginRequest
-	(*LoginResponse)(nil),            // 6: employee_service.LoginResponse
-	(*VacationRequest)(nil),          // 7: employee_service.VacationRequest
-	(*GetEmployeesRequest)(nil),      // 8: employee_service.GetEmployeesRequest
-	(*GetEmployeesResponse)(nil),     // 9: employee_service.GetEmployeesResponse
-	(*GetDepartmentsResponse)(nil),   // 10: employee_service.GetDepartmentsResponse
-	(*GetEmployeeByIDRequest)(nil),   // 11: employee_service.GetEmployeeByIDRequest
-	(*UpdateEmployeeRequest)(nil),    // 12: employee_service.UpdateEmployeeRequest
-	(*DeleteEmployeeRequest)(nil),    // 13: employee_service.DeleteEmployeeRequest
-	(*RequestVacationRequest)(nil),   // 14: employee_service.RequestVacationRequest
-	(*GetDepartmentByIDRequest)(nil), // 15: employee_service.GetDepartmentByIDRequest
-	(*UpdateDepartmentRequest)(nil),  // 16: employee_service.UpdateDepartmentRequest
-	(*DeleteDepartmentRequest)(nil),  // 17: employee_service.DeleteDepartmentRequest
-	(*anypb.Any)(nil),                // 18: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 20: google.protobuf.Empty
+	(*ApiResponse)(nil),                   // 0: employee_service.ApiResponse
+	(*ErrorData)(nil),                     // 1: employee_service.ErrorData
+	(*Employee)(nil),                      // 2: employee_service.Employee
+	(*Department)(nil),                    // 3: employee_service.Department
+	(*DepartmentForm)(nil),                // 4: employee_service.DepartmentForm
+	(*LoginRequest)(nil),                  // 5: employee_service.LoginRequest
+	(*LoginResponse)(nil),                 // 6: employee_service.LoginResponse
+	(*OIDCLoginResponse)(nil),             // 7: employee_service.OIDCLoginResponse
+	(*OIDCCallbackRequest)(nil),           // 8: employee_service.OIDCCallbackRequest
+	(*MFACodeRequest)(nil),                // 9: employee_service.MFACodeRequest
+	(*MFAVerifyRequest)(nil),              // 10: employee_service.MFAVerifyRequest
+	(*MFAEnrollment)(nil),                 // 11: employee_service.MFAEnrollment
+	(*MFAEnabled)(nil),                    // 12: employee_service.MFAEnabled
+	(*RefreshRequest)(nil),                // 13: employee_service.RefreshRequest
+	(*ChangePasswordRequest)(nil),         // 14: employee_service.ChangePasswordRequest
+	(*ResetPasswordRequest)(nil),          // 15: employee_service.ResetPasswordRequest
+	(*PasswordResetResponse)(nil),         // 16: employee_service.PasswordResetResponse
+	(*SessionInfo)(nil),                   // 17: employee_service.SessionInfo
+	(*GetSessionsResponse)(nil),           // 18: employee_service.GetSessionsResponse
+	(*Lockout)(nil),                       // 19: employee_service.Lockout
+	(*GetLockoutsResponse)(nil),           // 20: employee_service.GetLockoutsResponse
+	(*APIKey)(nil),                        // 21: employee_service.APIKey
+	(*APIKeyForm)(nil),                    // 22: employee_service.APIKeyForm
+	(*CreatedAPIKey)(nil),                 // 23: employee_service.CreatedAPIKey
+	(*GetAPIKeysResponse)(nil),            // 24: employee_service.GetAPIKeysResponse
+	(*CalendarToken)(nil),                 // 25: employee_service.CalendarToken
+	(*CalendarTokenForm)(nil),             // 26: employee_service.CalendarTokenForm
+	(*CreatedCalendarToken)(nil),          // 27: employee_service.CreatedCalendarToken
+	(*GetCalendarTokensResponse)(nil),     // 28: employee_service.GetCalendarTokensResponse
+	(*ImpersonationResponse)(nil),         // 29: employee_service.ImpersonationResponse
+	(*AuditEntry)(nil),                    // 30: employee_service.AuditEntry
+	(*GetAuditLogResponse)(nil),           // 31: employee_service.GetAuditLogResponse
+	(*VacationRequestForm)(nil),           // 32: employee_service.VacationRequestForm
+	(*VacationRequest)(nil),               // 33: employee_service.VacationRequest
+	(*GetVacationRequestsResponse)(nil),   // 34: employee_service.GetVacationRequestsResponse
+	(*LeaveEntry)(nil),                    // 35: employee_service.LeaveEntry
+	(*GetLeaveLedgerResponse)(nil),        // 36: employee_service.GetLeaveLedgerResponse
+	(*LeaveBalance)(nil),                  // 37: employee_service.LeaveBalance
+	(*LeaveBalances)(nil),                 // 38: employee_service.LeaveBalances
+	(*LeaveAdjustment)(nil),               // 39: employee_service.LeaveAdjustment
+	(*SickLeave)(nil),                     // 40: employee_service.SickLeave
+	(*SickLeaveForm)(nil),                 // 41: employee_service.SickLeaveForm
+	(*GetSickLeavesResponse)(nil),         // 42: employee_service.GetSickLeavesResponse
+	(*Calendar)(nil),                      // 43: employee_service.Calendar
+	(*CalendarForm)(nil),                  // 44: employee_service.CalendarForm
+	(*CalendarDay)(nil),                   // 45: employee_service.CalendarDay
+	(*GetCalendarsResponse)(nil),          // 46: employee_service.GetCalendarsResponse
+	(*GetCalendarDaysResponse)(nil),       // 47: employee_service.GetCalendarDaysResponse
+	(*CalendarImport)(nil),                // 48: employee_service.CalendarImport
+	(*WorkingDays)(nil),                   // 49: employee_service.WorkingDays
+	(*StaffingConflict)(nil),              // 50: employee_service.StaffingConflict
+	(*StaffingConflictDay)(nil),           // 51: employee_service.StaffingConflictDay
+	(*StaffingAbsence)(nil),               // 52: employee_service.StaffingAbsence
+	(*GetEmployeesRequest)(nil),           // 53: employee_service.GetEmployeesRequest
+	(*GetEmployeesResponse)(nil),          // 54: employee_service.GetEmployeesResponse
+	(*SearchEmployeesRequest)(nil),        // 55: employee_service.SearchEmployeesRequest
+	(*SearchEmployeesResponse)(nil),       // 56: employee_service.SearchEmployeesResponse
+	(*GetDepartmentsResponse)(nil),        // 57: employee_service.GetDepartmentsResponse
+	(*RevokeSessionRequest)(nil),          // 58: employee_service.RevokeSessionRequest
+	(*ClearLockoutRequest)(nil),           // 59: employee_service.ClearLockoutRequest
+	(*UpdateAPIKeyRequest)(nil),           // 60: employee_service.UpdateAPIKeyRequest
+	(*DeleteAPIKeyRequest)(nil),           // 61: employee_service.DeleteAPIKeyRequest
+	(*DeleteCalendarTokenRequest)(nil),    // 62: employee_service.DeleteCalendarTokenRequest
+	(*ImpersonateRequest)(nil),            // 63: employee_service.ImpersonateRequest
+	(*GetAuditLogRequest)(nil),            // 64: employee_service.GetAuditLogRequest
+	(*GetEmployeeByIDRequest)(nil),        // 65: employee_service.GetEmployeeByIDRequest
+	(*UpdateEmployeeRequest)(nil),         // 66: employee_service.UpdateEmployeeRequest
+	(*DeleteEmployeeRequest)(nil),         // 67: employee_service.DeleteEmployeeRequest
+	(*RestoreEmployeeRequest)(nil),        // 68: employee_service.RestoreEmployeeRequest
+	(*PurgeEmployeeRequest)(nil),          // 69: employee_service.PurgeEmployeeRequest
+	(*RequestVacationRequest)(nil),        // 70: employee_service.RequestVacationRequest
+	(*GetVacationRequestsRequest)(nil),    // 71: employee_service.GetVacationRequestsRequest
+	(*ApproveVacationRequestRequest)(nil), // 72: employee_service.ApproveVacationRequestRequest
+	(*RejectVacationRequestRequest)(nil),  // 73: employee_service.RejectVacationRequestRequest
+	(*CancelVacationRequestRequest)(nil),  // 74: employee_service.CancelVacationRequestRequest
+	(*GetLeaveBalanceRequest)(nil),        // 75: employee_service.GetLeaveBalanceRequest
+	(*GetLeaveLedgerRequest)(nil),         // 76: employee_service.GetLeaveLedgerRequest
+	(*AdjustLeaveRequest)(nil),            // 77: employee_service.AdjustLeaveRequest
+	(*RegisterSickLeaveRequest)(nil),      // 78: employee_service.RegisterSickLeaveRequest
+	(*GetSickLeavesRequest)(nil),          // 79: employee_service.GetSickLeavesRequest
+	(*CloseSickLeaveRequest)(nil),         // 80: employee_service.CloseSickLeaveRequest
+	(*CountWorkingDaysRequest)(nil),       // 81: employee_service.CountWorkingDaysRequest
+	(*DeleteCalendarRequest)(nil),         // 82: employee_service.DeleteCalendarRequest
+	(*GetCalendarDaysRequest)(nil),        // 83: employee_service.GetCalendarDaysRequest
+	(*ImportCalendarDaysRequest)(nil),     // 84: employee_service.ImportCalendarDaysRequest
+	(*DeleteCalendarDayRequest)(nil),      // 85: employee_service.DeleteCalendarDayRequest
+	(*CreatePasswordResetRequest)(nil),    // 86: employee_service.CreatePasswordResetRequest
+	(*GetDepartmentByIDRequest)(nil),      // 87: employee_service.GetDepartmentByIDRequest
+	(*UpdateDepartmentRequest)(nil),       // 88: employee_service.UpdateDepartmentRequest
+	(*DeleteDepartmentRequest)(nil),       // 89: employee_service.DeleteDepartmentRequest
+	(*anypb.Any)(nil),                     // 90: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),         // 91: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 92: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                 // 93: google.protobuf.Empty
 }
 var file_employee_service_proto_depIdxs = []int32{
-	18, // 0: employee_service.ApiResponse.data:type_name -> google.protobuf.Any
-	19, // 1: employee_service.Employee.hire_date:type_name -> google.protobuf.Timestamp
-	19, // 2: employee_service.Employee.fire_date:type_name -> google.protobuf.Timestamp
-	19, // 3: employee_service.Employee.birthday:type_name -> google.protobuf.Timestamp
-	19, // 4: employee_service.Employee.created_at:type_name -> google.protobuf.Timestamp
-	19, // 5: employee_service.Employee.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 6: employee_service.Department.created_at:type_name -> google.protobuf.Timestamp
-	19, // 7: employee_service.Department.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 8: employee_service.GetEmployeesResponse.employees:type_name -> employee_service.Employee
-	3,  // 9: employee_service.GetDepartmentsResponse.departments:type_name -> employee_service.Department
-	2,  // 10: employee_service.UpdateEmployeeRequest.employee:type_name -> employee_service.Employee
-	7,  // 11: employee_service.RequestVacationRequest.vacation:type_name -> employee_service.VacationRequest
-	4,  // 12: employee_service.UpdateDepartmentRequest.department:type_name -> employee_service.DepartmentForm
-	5,  // 13: employee_service.EmployeeService.AuthLogin:input_type -> employee_service.LoginRequest
-	20, // 14: employee_service.EmployeeService.AuthLogout:input_type -> google.protobuf.Empty
-	8,  // 15: employee_service.EmployeeService.GetEmployees:input_type -> employee_service.GetEmployeesRequest
-	2,  // 16: employee_service.EmployeeService.CreateEmployee:input_type -> employee_service.Employee
-	11, // 17: employee_service.EmployeeService.GetEmployeesByID:input_type -> employee_service.GetEmployeeByIDRequest
-	12, // 18: employee_service.EmployeeService.UpdateEmployee:input_type -> employee_service.UpdateEmployeeRequest
-	13, // 19: employee_service.EmployeeService.DeleteEmployee:input_type -> employee_service.DeleteEmployeeRequest
-	14, // 20: employee_service.EmployeeService.RequestVacation:input_type -> employee_service.RequestVacationRequest
-	20, // 21: employee_service.EmployeeService.GetDepartments:input_type -> google.protobuf.Empty
-	4,  // 22: employee_service.EmployeeService.CreateDepartment:input_type -> employee_service.DepartmentForm
-	15, // 23: employee_service.EmployeeService.GetDepartmentByID:input_type -> employee_service.GetDepartmentByIDRequest
-	16, // 24: employee_service.EmployeeService.UpdateDepartment:input_type -> employee_service.UpdateDepartmentRequest
-	17, // 25: employee_service.EmployeeService.DeleteDepartment:input_type -> employee_service.DeleteDepartmentRequest
-	0,  // 26: employee_service.EmployeeService.AuthLogin:output_type -> employee_service.ApiResponse
-	0,  // 27: employee_service.EmployeeService.AuthLogout:output_type -> employee_service.ApiResponse
-	0,  // 28: employee_service.EmployeeService.GetEmployees:output_type -> employee_service.ApiResponse
-	0,  // 29: employee_service.EmployeeService.CreateEmployee:output_type -> employee_service.ApiResponse
-	0,  // 30: employee_service.EmployeeService.GetEmployeesByID:output_type -> employee_service.ApiResponse
-	0,  // 31: employee_service.EmployeeService.UpdateEmployee:output_type -> employee_service.ApiResponse
-	0,  // 32: employee_service.EmployeeService.DeleteEmployee:output_type -> employee_service.ApiResponse
-	0,  // 33: employee_service.EmployeeService.RequestVacation:output_type -> employee_service.ApiResponse
-	0,  // 34: employee_service.EmployeeService.GetDepartments:output_type -> employee_service.ApiResponse
-	0,  // 35: employee_service.EmployeeService.CreateDepartment:output_type -> employee_service.ApiResponse
-	0,  // 36: employee_service.EmployeeService.GetDepartmentByID:output_type -> employee_service.ApiResponse
-	0,  // 37: employee_service.EmployeeService.UpdateDepartment:output_type -> employee_service.ApiResponse
-	0,  // 38: employee_service.EmployeeService.DeleteDepartment:output_type -> employee_service.ApiResponse
-	26, // [26:39] is the sub-list for method output_type
-	13, // [13:26] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	90,  // 0: employee_service.ApiResponse.data:type_name -> google.protobuf.Any
+	91,  // 1: employee_service.Employee.hire_date:type_name -> google.protobuf.Timestamp
+	91,  // 2: employee_service.Employee.fire_date:type_name -> google.protobuf.Timestamp
+	91,  // 3: employee_service.Employee.birthday:type_name -> google.protobuf.Timestamp
+	91,  // 4: employee_service.Employee.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 5: employee_service.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	91,  // 6: employee_service.Department.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 7: employee_service.Department.updated_at:type_name -> google.protobuf.Timestamp
+	91,  // 8: employee_service.PasswordResetResponse.expires_at:type_name -> google.protobuf.Timestamp
+	91,  // 9: employee_service.SessionInfo.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 10: employee_service.SessionInfo.refreshed_at:type_name -> google.protobuf.Timestamp
+	17,  // 11: employee_service.GetSessionsResponse.sessions:type_name -> employee_service.SessionInfo
+	91,  // 12: employee_service.Lockout.expires_at:type_name -> google.protobuf.Timestamp
+	19,  // 13: employee_service.GetLockoutsResponse.lockouts:type_name -> employee_service.Lockout
+	91,  // 14: employee_service.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	91,  // 15: employee_service.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	91,  // 16: employee_service.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 17: employee_service.APIKeyForm.expires_at:type_name -> google.protobuf.Timestamp
+	21,  // 18: employee_service.CreatedAPIKey.api_key:type_name -> employee_service.APIKey
+	21,  // 19: employee_service.GetAPIKeysResponse.api_keys:type_name -> employee_service.APIKey
+	91,  // 20: employee_service.CalendarToken.last_used_at:type_name -> google.protobuf.Timestamp
+	91,  // 21: employee_service.CalendarToken.created_at:type_name -> google.protobuf.Timestamp
+	25,  // 22: employee_service.CreatedCalendarToken.calendar_token:type_name -> employee_service.CalendarToken
+	25,  // 23: employee_service.GetCalendarTokensResponse.calendar_tokens:type_name -> employee_service.CalendarToken
+	91,  // 24: employee_service.ImpersonationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	91,  // 25: employee_service.AuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	30,  // 26: employee_service.GetAuditLogResponse.entries:type_name -> employee_service.AuditEntry
+	91,  // 27: employee_service.VacationRequestForm.start_date:type_name -> google.protobuf.Timestamp
+	91,  // 28: employee_service.VacationRequestForm.end_date:type_name -> google.protobuf.Timestamp
+	91,  // 29: employee_service.VacationRequest.start_date:type_name -> google.protobuf.Timestamp
+	91,  // 30: employee_service.VacationRequest.end_date:type_name -> google.protobuf.Timestamp
+	91,  // 31: employee_service.VacationRequest.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 32: employee_service.VacationRequest.updated_at:type_name -> google.protobuf.Timestamp
+	33,  // 33: employee_service.GetVacationRequestsResponse.requests:type_name -> employee_service.VacationRequest
+	91,  // 34: employee_service.LeaveEntry.effective_date:type_name -> google.protobuf.Timestamp
+	91,  // 35: employee_service.LeaveEntry.created_at:type_name -> google.protobuf.Timestamp
+	35,  // 36: employee_service.GetLeaveLedgerResponse.entries:type_name -> employee_service.LeaveEntry
+	91,  // 37: employee_service.LeaveBalances.as_of:type_name -> google.protobuf.Timestamp
+	37,  // 38: employee_service.LeaveBalances.balances:type_name -> employee_service.LeaveBalance
+	91,  // 39: employee_service.LeaveAdjustment.effective_date:type_name -> google.protobuf.Timestamp
+	91,  // 40: employee_service.SickLeave.start_date:type_name -> google.protobuf.Timestamp
+	91,  // 41: employee_service.SickLeave.end_date:type_name -> google.protobuf.Timestamp
+	91,  // 42: employee_service.SickLeave.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 43: employee_service.SickLeave.updated_at:type_name -> google.protobuf.Timestamp
+	91,  // 44: employee_service.SickLeaveForm.start_date:type_name -> google.protobuf.Timestamp
+	91,  // 45: employee_service.SickLeaveForm.end_date:type_name -> google.protobuf.Timestamp
+	40,  // 46: employee_service.GetSickLeavesResponse.sick_leaves:type_name -> employee_service.SickLeave
+	91,  // 47: employee_service.Calendar.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 48: employee_service.Calendar.updated_at:type_name -> google.protobuf.Timestamp
+	91,  // 49: employee_service.CalendarDay.date:type_name -> google.protobuf.Timestamp
+	43,  // 50: employee_service.GetCalendarsResponse.calendars:type_name -> employee_service.Calendar
+	45,  // 51: employee_service.GetCalendarDaysResponse.days:type_name -> employee_service.CalendarDay
+	91,  // 52: employee_service.WorkingDays.from:type_name -> google.protobuf.Timestamp
+	91,  // 53: employee_service.WorkingDays.to:type_name -> google.protobuf.Timestamp
+	51,  // 54: employee_service.StaffingConflict.days:type_name -> employee_service.StaffingConflictDay
+	91,  // 55: employee_service.StaffingConflictDay.date:type_name -> google.protobuf.Timestamp
+	52,  // 56: employee_service.StaffingConflictDay.absent:type_name -> employee_service.StaffingAbsence
+	91,  // 57: employee_service.StaffingAbsence.start_date:type_name -> google.protobuf.Timestamp
+	91,  // 58: employee_service.StaffingAbsence.end_date:type_name -> google.protobuf.Timestamp
+	91,  // 59: employee_service.GetEmployeesRequest.hired_from:type_name -> google.protobuf.Timestamp
+	91,  // 60: employee_service.GetEmployeesRequest.hired_to:type_name -> google.protobuf.Timestamp
+	2,   // 61: employee_service.GetEmployeesResponse.employees:type_name -> employee_service.Employee
+	2,   // 62: employee_service.SearchEmployeesResponse.employees:type_name -> employee_service.Employee
+	3,   // 63: employee_service.GetDepartmentsResponse.departments:type_name -> employee_service.Department
+	22,  // 64: employee_service.UpdateAPIKeyRequest.api_key:type_name -> employee_service.APIKeyForm
+	2,   // 65: employee_service.UpdateEmployeeRequest.employee:type_name -> employee_service.Employee
+	92,  // 66: employee_service.UpdateEmployeeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	32,  // 67: employee_service.RequestVacationRequest.vacation:type_name -> employee_service.VacationRequestForm
+	91,  // 68: employee_service.GetLeaveBalanceRequest.as_of:type_name -> google.protobuf.Timestamp
+	91,  // 69: employee_service.GetLeaveLedgerRequest.from:type_name -> google.protobuf.Timestamp
+	91,  // 70: employee_service.GetLeaveLedgerRequest.to:type_name -> google.protobuf.Timestamp
+	39,  // 71: employee_service.AdjustLeaveRequest.adjustment:type_name -> employee_service.LeaveAdjustment
+	41,  // 72: employee_service.RegisterSickLeaveRequest.sick_leave:type_name -> employee_service.SickLeaveForm
+	91,  // 73: employee_service.GetSickLeavesRequest.from:type_name -> google.protobuf.Timestamp
+	91,  // 74: employee_service.GetSickLeavesRequest.to:type_name -> google.protobuf.Timestamp
+	91,  // 75: employee_service.CloseSickLeaveRequest.end_date:type_name -> google.protobuf.Timestamp
+	91,  // 76: employee_service.CountWorkingDaysRequest.from:type_name -> google.protobuf.Timestamp
+	91,  // 77: employee_service.CountWorkingDaysRequest.to:type_name -> google.protobuf.Timestamp
+	91,  // 78: employee_service.GetCalendarDaysRequest.from:type_name -> google.protobuf.Timestamp
+	91,  // 79: employee_service.GetCalendarDaysRequest.to:type_name -> google.protobuf.Timestamp
+	91,  // 80: employee_service.DeleteCalendarDayRequest.date:type_name -> google.protobuf.Timestamp
+	4,   // 81: employee_service.UpdateDepartmentRequest.department:type_name -> employee_service.DepartmentForm
+	92,  // 82: employee_service.UpdateDepartmentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,   // 83: employee_service.EmployeeService.AuthLogin:input_type -> employee_service.LoginRequest
+	13,  // 84: employee_service.EmployeeService.AuthRefresh:input_type -> employee_service.RefreshRequest
+	93,  // 85: employee_service.EmployeeService.AuthLogout:input_type -> google.protobuf.Empty
+	14,  // 86: employee_service.EmployeeService.ChangePassword:input_type -> employee_service.ChangePasswordRequest
+	15,  // 87: employee_service.EmployeeService.ResetPassword:input_type -> employee_service.ResetPasswordRequest
+	93,  // 88: employee_service.EmployeeService.GetSessions:input_type -> google.protobuf.Empty
+	58,  // 89: employee_service.EmployeeService.RevokeSession:input_type -> employee_service.RevokeSessionRequest
+	93,  // 90: employee_service.EmployeeService.RevokeAllSessions:input_type -> google.protobuf.Empty
+	93,  // 91: employee_service.EmployeeService.OIDCLogin:input_type -> google.protobuf.Empty
+	8,   // 92: employee_service.EmployeeService.OIDCCallback:input_type -> employee_service.OIDCCallbackRequest
+	93,  // 93: employee_service.EmployeeService.EnrollMFA:input_type -> google.protobuf.Empty
+	9,   // 94: employee_service.EmployeeService.EnableMFA:input_type -> employee_service.MFACodeRequest
+	9,   // 95: employee_service.EmployeeService.DisableMFA:input_type -> employee_service.MFACodeRequest
+	10,  // 96: employee_service.EmployeeService.VerifyMFA:input_type -> employee_service.MFAVerifyRequest
+	93,  // 97: employee_service.EmployeeService.GetLockouts:input_type -> google.protobuf.Empty
+	59,  // 98: employee_service.EmployeeService.ClearLockout:input_type -> employee_service.ClearLockoutRequest
+	93,  // 99: employee_service.EmployeeService.GetAPIKeys:input_type -> google.protobuf.Empty
+	22,  // 100: employee_service.EmployeeService.CreateAPIKey:input_type -> employee_service.APIKeyForm
+	60,  // 101: employee_service.EmployeeService.UpdateAPIKey:input_type -> employee_service.UpdateAPIKeyRequest
+	61,  // 102: employee_service.EmployeeService.DeleteAPIKey:input_type -> employee_service.DeleteAPIKeyRequest
+	93,  // 103: employee_service.EmployeeService.GetCalendarTokens:input_type -> google.protobuf.Empty
+	26,  // 104: employee_service.EmployeeService.CreateCalendarToken:input_type -> employee_service.CalendarTokenForm
+	62,  // 105: employee_service.EmployeeService.DeleteCalendarToken:input_type -> employee_service.DeleteCalendarTokenRequest
+	63,  // 106: employee_service.EmployeeService.Impersonate:input_type -> employee_service.ImpersonateRequest
+	64,  // 107: employee_service.EmployeeService.GetAuditLog:input_type -> employee_service.GetAuditLogRequest
+	53,  // 108: employee_service.EmployeeService.GetEmployees:input_type -> employee_service.GetEmployeesRequest
+	2,   // 109: employee_service.EmployeeService.CreateEmployee:input_type -> employee_service.Employee
+	65,  // 110: employee_service.EmployeeService.GetEmployeesByID:input_type -> employee_service.GetEmployeeByIDRequest
+	55,  // 111: employee_service.EmployeeService.SearchEmployees:input_type -> employee_service.SearchEmployeesRequest
+	66,  // 112: employee_service.EmployeeService.UpdateEmployee:input_type -> employee_service.UpdateEmployeeRequest
+	67,  // 113: employee_service.EmployeeService.DeleteEmployee:input_type -> employee_service.DeleteEmployeeRequest
+	68,  // 114: employee_service.EmployeeService.RestoreEmployee:input_type -> employee_service.RestoreEmployeeRequest
+	69,  // 115: employee_service.EmployeeService.PurgeEmployee:input_type -> employee_service.PurgeEmployeeRequest
+	70,  // 116: employee_service.EmployeeService.RequestVacation:input_type -> employee_service.RequestVacationRequest
+	71,  // 117: employee_service.EmployeeService.GetVacationRequests:input_type -> employee_service.GetVacationRequestsRequest
+	72,  // 118: employee_service.EmployeeService.ApproveVacationRequest:input_type -> employee_service.ApproveVacationRequestRequest
+	73,  // 119: employee_service.EmployeeService.RejectVacationRequest:input_type -> employee_service.RejectVacationRequestRequest
+	74,  // 120: employee_service.EmployeeService.CancelVacationRequest:input_type -> employee_service.CancelVacationRequestRequest
+	75,  // 121: employee_service.EmployeeService.GetLeaveBalance:input_type -> employee_service.GetLeaveBalanceRequest
+	76,  // 122: employee_service.EmployeeService.GetLeaveLedger:input_type -> employee_service.GetLeaveLedgerRequest
+	77,  // 123: employee_service.EmployeeService.AdjustLeave:input_type -> employee_service.AdjustLeaveRequest
+	78,  // 124: employee_service.EmployeeService.RegisterSickLeave:input_type -> employee_service.RegisterSickLeaveRequest
+	79,  // 125: employee_service.EmployeeService.GetSickLeaves:input_type -> employee_service.GetSickLeavesRequest
+	80,  // 126: employee_service.EmployeeService.CloseSickLeave:input_type -> employee_service.CloseSickLeaveRequest
+	81,  // 127: employee_service.EmployeeService.CountWorkingDays:input_type -> employee_service.CountWorkingDaysRequest
+	93,  // 128: employee_service.EmployeeService.GetCalendars:input_type -> google.protobuf.Empty
+	44,  // 129: employee_service.EmployeeService.CreateCalendar:input_type -> employee_service.CalendarForm
+	82,  // 130: employee_service.EmployeeService.DeleteCalendar:input_type -> employee_service.DeleteCalendarRequest
+	83,  // 131: employee_service.EmployeeService.GetCalendarDays:input_type -> employee_service.GetCalendarDaysRequest
+	84,  // 132: employee_service.EmployeeService.ImportCalendarDays:input_type -> employee_service.ImportCalendarDaysRequest
+	85,  // 133: employee_service.EmployeeService.DeleteCalendarDay:input_type -> employee_service.DeleteCalendarDayRequest
+	86,  // 134: employee_service.EmployeeService.CreatePasswordReset:input_type -> employee_service.CreatePasswordResetRequest
+	93,  // 135: employee_service.EmployeeService.GetDepartments:input_type -> google.protobuf.Empty
+	4,   // 136: employee_service.EmployeeService.CreateDepartment:input_type -> employee_service.DepartmentForm
+	87,  // 137: employee_service.EmployeeService.GetDepartmentByID:input_type -> employee_service.GetDepartmentByIDRequest
+	88,  // 138: employee_service.EmployeeService.UpdateDepartment:input_type -> employee_service.UpdateDepartmentRequest
+	89,  // 139: employee_service.EmployeeService.DeleteDepartment:input_type -> employee_service.DeleteDepartmentRequest
+	0,   // 140: employee_service.EmployeeService.AuthLogin:output_type -> employee_service.ApiResponse
+	0,   // 141: employee_service.EmployeeService.AuthRefresh:output_type -> employee_service.ApiResponse
+	0,   // 142: employee_service.EmployeeService.AuthLogout:output_type -> employee_service.ApiResponse
+	0,   // 143: employee_service.EmployeeService.ChangePassword:output_type -> employee_service.ApiResponse
+	0,   // 144: employee_service.EmployeeService.ResetPassword:output_type -> employee_service.ApiResponse
+	0,   // 145: employee_service.EmployeeService.GetSessions:output_type -> employee_service.ApiResponse
+	0,   // 146: employee_service.EmployeeService.RevokeSession:output_type -> employee_service.ApiResponse
+	0,   // 147: employee_service.EmployeeService.RevokeAllSessions:output_type -> employee_service.ApiResponse
+	0,   // 148: employee_service.EmployeeService.OIDCLogin:output_type -> employee_service.ApiResponse
+	0,   // 149: employee_service.EmployeeService.OIDCCallback:output_type -> employee_service.ApiResponse
+	0,   // 150: employee_service.EmployeeService.EnrollMFA:output_type -> employee_service.ApiResponse
+	0,   // 151: employee_service.EmployeeService.EnableMFA:output_type -> employee_service.ApiResponse
+	0,   // 152: employee_service.EmployeeService.DisableMFA:output_type -> employee_service.ApiResponse
+	0,   // 153: employee_service.EmployeeService.VerifyMFA:output_type -> employee_service.ApiResponse
+	0,   // 154: employee_service.EmployeeService.GetLockouts:output_type -> employee_service.ApiResponse
+	0,   // 155: employee_service.EmployeeService.ClearLockout:output_type -> employee_service.ApiResponse
+	0,   // 156: employee_service.EmployeeService.GetAPIKeys:output_type -> employee_service.ApiResponse
+	0,   // 157: employee_service.EmployeeService.CreateAPIKey:output_type -> employee_service.ApiResponse
+	0,   // 158: employee_service.EmployeeService.UpdateAPIKey:output_type -> employee_service.ApiResponse
+	0,   // 159: employee_service.EmployeeService.DeleteAPIKey:output_type -> employee_service.ApiResponse
+	0,   // 160: employee_service.EmployeeService.GetCalendarTokens:output_type -> employee_service.ApiResponse
+	0,   // 161: employee_service.EmployeeService.CreateCalendarToken:output_type -> employee_service.ApiResponse
+	0,   // 162: employee_service.EmployeeService.DeleteCalendarToken:output_type -> employee_service.ApiResponse
+	0,   // 163: employee_service.EmployeeService.Impersonate:output_type -> employee_service.ApiResponse
+	0,   // 164: employee_service.EmployeeService.GetAuditLog:output_type -> employee_service.ApiResponse
+	0,   // 165: employee_service.EmployeeService.GetEmployees:output_type -> employee_service.ApiResponse
+	0,   // 166: employee_service.EmployeeService.CreateEmployee:output_type -> employee_service.ApiResponse
+	0,   // 167: employee_service.EmployeeService.GetEmployeesByID:output_type -> employee_service.ApiResponse
+	0,   // 168: employee_service.EmployeeService.SearchEmployees:output_type -> employee_service.ApiResponse
+	0,   // 169: employee_service.EmployeeService.UpdateEmployee:output_type -> employee_service.ApiResponse
+	0,   // 170: employee_service.EmployeeService.DeleteEmployee:output_type -> employee_service.ApiResponse
+	0,   // 171: employee_service.EmployeeService.RestoreEmployee:output_type -> employee_service.ApiResponse
+	0,   // 172: employee_service.EmployeeService.PurgeEmployee:output_type -> employee_service.ApiResponse
+	0,   // 173: employee_service.EmployeeService.RequestVacation:output_type -> employee_service.ApiResponse
+	0,   // 174: employee_service.EmployeeService.GetVacationRequests:output_type -> employee_service.ApiResponse
+	0,   // 175: employee_service.EmployeeService.ApproveVacationRequest:output_type -> employee_service.ApiResponse
+	0,   // 176: employee_service.EmployeeService.RejectVacationRequest:output_type -> employee_service.ApiResponse
+	0,   // 177: employee_service.EmployeeService.CancelVacationRequest:output_type -> employee_service.ApiResponse
+	0,   // 178: employee_service.EmployeeService.GetLeaveBalance:output_type -> employee_service.ApiResponse
+	0,   // 179: employee_service.EmployeeService.GetLeaveLedger:output_type -> employee_service.ApiResponse
+	0,   // 180: employee_service.EmployeeService.AdjustLeave:output_type -> employee_service.ApiResponse
+	0,   // 181: employee_service.EmployeeService.RegisterSickLeave:output_type -> employee_service.ApiResponse
+	0,   // 182: employee_service.EmployeeService.GetSickLeaves:output_type -> employee_service.ApiResponse
+	0,   // 183: employee_service.EmployeeService.CloseSickLeave:output_type -> employee_service.ApiResponse
+	0,   // 184: employee_service.EmployeeService.CountWorkingDays:output_type -> employee_service.ApiResponse
+	0,   // 185: employee_service.EmployeeService.GetCalendars:output_type -> employee_service.ApiResponse
+	0,   // 186: employee_service.EmployeeService.CreateCalendar:output_type -> employee_service.ApiResponse
+	0,   // 187: employee_service.EmployeeService.DeleteCalendar:output_type -> employee_service.ApiResponse
+	0,   // 188: employee_service.EmployeeService.GetCalendarDays:output_type -> employee_service.ApiResponse
+	0,   // 189: employee_service.EmployeeService.ImportCalendarDays:output_type -> employee_service.ApiResponse
+	0,   // 190: employee_service.EmployeeService.DeleteCalendarDay:output_type -> employee_service.ApiResponse
+	0,   // 191: employee_service.EmployeeService.CreatePasswordReset:output_type -> employee_service.ApiResponse
+	0,   // 192: employee_service.EmployeeService.GetDepartments:output_type -> employee_service.ApiResponse
+	0,   // 193: employee_service.EmployeeService.CreateDepartment:output_type -> employee_service.ApiResponse
+	0,   // 194: employee_service.EmployeeService.GetDepartmentByID:output_type -> employee_service.ApiResponse
+	0,   // 195: employee_service.EmployeeService.UpdateDepartment:output_type -> employee_service.ApiResponse
+	0,   // 196: employee_service.EmployeeService.DeleteDepartment:output_type -> employee_service.ApiResponse
+	140, // [140:197] is the sub-list for method output_type
+	83,  // [83:140] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_employee_service_proto_init() }
@@ -1375,14 +6762,36 @@ func file_employee_service_proto_init() {
 	file_employee_service_proto_msgTypes[2].OneofWrappers = []any{}
 	file_employee_service_proto_msgTypes[3].OneofWrappers = []any{}
 	file_employee_service_proto_msgTypes[4].OneofWrappers = []any{}
-	file_employee_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[32].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[35].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[39].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[41].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[44].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[45].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[53].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[54].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[55].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[64].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[71].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[73].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[75].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[76].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[79].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[80].OneofWrappers = []any{}
+	file_employee_service_proto_msgTypes[83].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_employee_service_proto_rawDesc), len(file_employee_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
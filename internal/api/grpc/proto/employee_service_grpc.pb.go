@@ -20,19 +20,63 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EmployeeService_AuthLogin_FullMethodName         = "/employee_service.EmployeeService/AuthLogin"
-	EmployeeService_AuthLogout_FullMethodName        = "/employee_service.EmployeeService/AuthLogout"
-	EmployeeService_GetEmployees_FullMethodName      = "/employee_service.EmployeeService/GetEmployees"
-	EmployeeService_CreateEmployee_FullMethodName    = "/employee_service.EmployeeService/CreateEmployee"
-	EmployeeService_GetEmployeesByID_FullMethodName  = "/employee_service.EmployeeService/GetEmployeesByID"
-	EmployeeService_UpdateEmployee_FullMethodName    = "/employee_service.EmployeeService/UpdateEmployee"
-	EmployeeService_DeleteEmployee_FullMethodName    = "/employee_service.EmployeeService/DeleteEmployee"
-	EmployeeService_RequestVacation_FullMethodName   = "/employee_service.EmployeeService/RequestVacation"
-	EmployeeService_GetDepartments_FullMethodName    = "/employee_service.EmployeeService/GetDepartments"
-	EmployeeService_CreateDepartment_FullMethodName  = "/employee_service.EmployeeService/CreateDepartment"
-	EmployeeService_GetDepartmentByID_FullMethodName = "/employee_service.EmployeeService/GetDepartmentByID"
-	EmployeeService_UpdateDepartment_FullMethodName  = "/employee_service.EmployeeService/UpdateDepartment"
-	EmployeeService_DeleteDepartment_FullMethodName  = "/employee_service.EmployeeService/DeleteDepartment"
+	EmployeeService_AuthLogin_FullMethodName              = "/employee_service.EmployeeService/AuthLogin"
+	EmployeeService_AuthRefresh_FullMethodName            = "/employee_service.EmployeeService/AuthRefresh"
+	EmployeeService_AuthLogout_FullMethodName             = "/employee_service.EmployeeService/AuthLogout"
+	EmployeeService_ChangePassword_FullMethodName         = "/employee_service.EmployeeService/ChangePassword"
+	EmployeeService_ResetPassword_FullMethodName          = "/employee_service.EmployeeService/ResetPassword"
+	EmployeeService_GetSessions_FullMethodName            = "/employee_service.EmployeeService/GetSessions"
+	EmployeeService_RevokeSession_FullMethodName          = "/employee_service.EmployeeService/RevokeSession"
+	EmployeeService_RevokeAllSessions_FullMethodName      = "/employee_service.EmployeeService/RevokeAllSessions"
+	EmployeeService_OIDCLogin_FullMethodName              = "/employee_service.EmployeeService/OIDCLogin"
+	EmployeeService_OIDCCallback_FullMethodName           = "/employee_service.EmployeeService/OIDCCallback"
+	EmployeeService_EnrollMFA_FullMethodName              = "/employee_service.EmployeeService/EnrollMFA"
+	EmployeeService_EnableMFA_FullMethodName              = "/employee_service.EmployeeService/EnableMFA"
+	EmployeeService_DisableMFA_FullMethodName             = "/employee_service.EmployeeService/DisableMFA"
+	EmployeeService_VerifyMFA_FullMethodName              = "/employee_service.EmployeeService/VerifyMFA"
+	EmployeeService_GetLockouts_FullMethodName            = "/employee_service.EmployeeService/GetLockouts"
+	EmployeeService_ClearLockout_FullMethodName           = "/employee_service.EmployeeService/ClearLockout"
+	EmployeeService_GetAPIKeys_FullMethodName             = "/employee_service.EmployeeService/GetAPIKeys"
+	EmployeeService_CreateAPIKey_FullMethodName           = "/employee_service.EmployeeService/CreateAPIKey"
+	EmployeeService_UpdateAPIKey_FullMethodName           = "/employee_service.EmployeeService/UpdateAPIKey"
+	EmployeeService_DeleteAPIKey_FullMethodName           = "/employee_service.EmployeeService/DeleteAPIKey"
+	EmployeeService_GetCalendarTokens_FullMethodName      = "/employee_service.EmployeeService/GetCalendarTokens"
+	EmployeeService_CreateCalendarToken_FullMethodName    = "/employee_service.EmployeeService/CreateCalendarToken"
+	EmployeeService_DeleteCalendarToken_FullMethodName    = "/employee_service.EmployeeService/DeleteCalendarToken"
+	EmployeeService_Impersonate_FullMethodName            = "/employee_service.EmployeeService/Impersonate"
+	EmployeeService_GetAuditLog_FullMethodName            = "/employee_service.EmployeeService/GetAuditLog"
+	EmployeeService_GetEmployees_FullMethodName           = "/employee_service.EmployeeService/GetEmployees"
+	EmployeeService_CreateEmployee_FullMethodName         = "/employee_service.EmployeeService/CreateEmployee"
+	EmployeeService_GetEmployeesByID_FullMethodName       = "/employee_service.EmployeeService/GetEmployeesByID"
+	EmployeeService_SearchEmployees_FullMethodName        = "/employee_service.EmployeeService/SearchEmployees"
+	EmployeeService_UpdateEmployee_FullMethodName         = "/employee_service.EmployeeService/UpdateEmployee"
+	EmployeeService_DeleteEmployee_FullMethodName         = "/employee_service.EmployeeService/DeleteEmployee"
+	EmployeeService_RestoreEmployee_FullMethodName        = "/employee_service.EmployeeService/RestoreEmployee"
+	EmployeeService_PurgeEmployee_FullMethodName          = "/employee_service.EmployeeService/PurgeEmployee"
+	EmployeeService_RequestVacation_FullMethodName        = "/employee_service.EmployeeService/RequestVacation"
+	EmployeeService_GetVacationRequests_FullMethodName    = "/employee_service.EmployeeService/GetVacationRequests"
+	EmployeeService_ApproveVacationRequest_FullMethodName = "/employee_service.EmployeeService/ApproveVacationRequest"
+	EmployeeService_RejectVacationRequest_FullMethodName  = "/employee_service.EmployeeService/RejectVacationRequest"
+	EmployeeService_CancelVacationRequest_FullMethodName  = "/employee_service.EmployeeService/CancelVacationRequest"
+	EmployeeService_GetLeaveBalance_FullMethodName        = "/employee_service.EmployeeService/GetLeaveBalance"
+	EmployeeService_GetLeaveLedger_FullMethodName         = "/employee_service.EmployeeService/GetLeaveLedger"
+	EmployeeService_AdjustLeave_FullMethodName            = "/employee_service.EmployeeService/AdjustLeave"
+	EmployeeService_RegisterSickLeave_FullMethodName      = "/employee_service.EmployeeService/RegisterSickLeave"
+	EmployeeService_GetSickLeaves_FullMethodName          = "/employee_service.EmployeeService/GetSickLeaves"
+	EmployeeService_CloseSickLeave_FullMethodName         = "/employee_service.EmployeeService/CloseSickLeave"
+	EmployeeService_CountWorkingDays_FullMethodName       = "/employee_service.EmployeeService/CountWorkingDays"
+	EmployeeService_GetCalendars_FullMethodName           = "/employee_service.EmployeeService/GetCalendars"
+	EmployeeService_CreateCalendar_FullMethodName         = "/employee_service.EmployeeService/CreateCalendar"
+	EmployeeService_DeleteCalendar_FullMethodName         = "/employee_service.EmployeeService/DeleteCalendar"
+	EmployeeService_GetCalendarDays_FullMethodName        = "/employee_service.EmployeeService/GetCalendarDays"
+	EmployeeService_ImportCalendarDays_FullMethodName     = "/employee_service.EmployeeService/ImportCalendarDays"
+	EmployeeService_DeleteCalendarDay_FullMethodName      = "/employee_service.EmployeeService/DeleteCalendarDay"
+	EmployeeService_CreatePasswordReset_FullMethodName    = "/employee_service.EmployeeService/CreatePasswordReset"
+	EmployeeService_GetDepartments_FullMethodName         = "/employee_service.EmployeeService/GetDepartments"
+	EmployeeService_CreateDepartment_FullMethodName       = "/employee_service.EmployeeService/CreateDepartment"
+	EmployeeService_GetDepartmentByID_FullMethodName      = "/employee_service.EmployeeService/GetDepartmentByID"
+	EmployeeService_UpdateDepartment_FullMethodName       = "/employee_service.EmployeeService/UpdateDepartment"
+	EmployeeService_DeleteDepartment_FullMethodName       = "/employee_service.EmployeeService/DeleteDepartment"
 )
 
 // EmployeeServiceClient is the client API for EmployeeService service.
@@ -41,27 +85,119 @@ const (
 type EmployeeServiceClient interface {
 	// AuthLogin authenticates a user and returns JWT tokens.
 	AuthLogin(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// AuthRefresh rotates the refresh token and returns a new JWT token pair.
+	AuthRefresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*ApiResponse, error)
 	// AuthLogout invalidates the current JWT token.
 	AuthLogout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// ChangePassword changes the password of the current user and returns new JWT tokens.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// ResetPassword sets a new password using a reset token.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetSessions returns the active sessions of the current user.
+	GetSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// RevokeSession revokes one session of the current user.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// RevokeAllSessions revokes every session of the current user.
+	RevokeAllSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// OIDCLogin returns the authorization URL of the company IdP.
+	OIDCLogin(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// OIDCCallback completes the IdP login and returns a JWT token pair, or an MFA
+	// token for VerifyMFA if the employee has two-factor authentication enabled.
+	OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// EnrollMFA generates a new TOTP secret for the current user.
+	EnrollMFA(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// EnableMFA confirms the TOTP secret with a code and returns recovery codes.
+	EnableMFA(ctx context.Context, in *MFACodeRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// DisableMFA turns off TOTP of the current user.
+	DisableMFA(ctx context.Context, in *MFACodeRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// VerifyMFA exchanges the mfa token and the second factor for a token pair.
+	VerifyMFA(ctx context.Context, in *MFAVerifyRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetLockouts lists the emails and client IPs locked after failed logins.
+	GetLockouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// ClearLockout lifts the login lockout of an email or a client IP.
+	ClearLockout(ctx context.Context, in *ClearLockoutRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetAPIKeys lists the API keys of other services.
+	GetAPIKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// CreateAPIKey creates an API key and returns its secret once.
+	CreateAPIKey(ctx context.Context, in *APIKeyForm, opts ...grpc.CallOption) (*ApiResponse, error)
+	// UpdateAPIKey changes the name, owner, scopes and expiry of an API key.
+	UpdateAPIKey(ctx context.Context, in *UpdateAPIKeyRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// DeleteAPIKey revokes an API key.
+	DeleteAPIKey(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetCalendarTokens lists the calendar tokens of the current user.
+	GetCalendarTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// CreateCalendarToken creates a calendar token of the current user and returns its secret once.
+	CreateCalendarToken(ctx context.Context, in *CalendarTokenForm, opts ...grpc.CallOption) (*ApiResponse, error)
+	// DeleteCalendarToken revokes a calendar token of the current user.
+	DeleteCalendarToken(ctx context.Context, in *DeleteCalendarTokenRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// Impersonate issues a short-lived access token of an employee to an admin.
+	Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetAuditLog lists the audit trail entries.
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*ApiResponse, error)
 	// GetEmployees retrieves a list of employees with optional filters.
 	GetEmployees(ctx context.Context, in *GetEmployeesRequest, opts ...grpc.CallOption) (*ApiResponse, error)
-	// CreateEmployee creates a new employee.
+	// CreateEmployee creates a new employee. The generated temporary password is
+	// returned only by the first response, not by a replay of an idempotency-key.
 	CreateEmployee(ctx context.Context, in *Employee, opts ...grpc.CallOption) (*ApiResponse, error)
 	// GetEmployeesByID retrieves an employee by ID.
 	GetEmployeesByID(ctx context.Context, in *GetEmployeeByIDRequest, opts ...grpc.CallOption) (*ApiResponse, error)
-	// UpdateEmployee updates an employee's details.
+	// SearchEmployees finds employees by names, email, phone, position and personal number.
+	// It is declared after GetEmployeesByID so that the gateway matches it first.
+	SearchEmployees(ctx context.Context, in *SearchEmployeesRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// UpdateEmployee updates an employee's details, only the update_mask fields if set.
+	// PATCH through the gateway fills update_mask with the fields of the body.
 	UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*ApiResponse, error)
-	// DeleteEmployee deletes an employee.
+	// DeleteEmployee terminates an employee, the record is kept with status fired.
 	DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*ApiResponse, error)
-	// RequestVacation adds vacation days for an employee.
+	// RestoreEmployee rehires a terminated employee.
+	RestoreEmployee(ctx context.Context, in *RestoreEmployeeRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// PurgeEmployee erases a terminated employee for good.
+	PurgeEmployee(ctx context.Context, in *PurgeEmployeeRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// RequestVacation submits a vacation request of an employee.
 	RequestVacation(ctx context.Context, in *RequestVacationRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetVacationRequests lists the vacation requests visible to the caller.
+	GetVacationRequests(ctx context.Context, in *GetVacationRequestsRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// ApproveVacationRequest approves a vacation request at its current stage.
+	ApproveVacationRequest(ctx context.Context, in *ApproveVacationRequestRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// RejectVacationRequest rejects a vacation request at its current stage.
+	RejectVacationRequest(ctx context.Context, in *RejectVacationRequestRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// CancelVacationRequest withdraws a vacation request.
+	CancelVacationRequest(ctx context.Context, in *CancelVacationRequestRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetLeaveBalance derives the leave balances of an employee from the ledger.
+	GetLeaveBalance(ctx context.Context, in *GetLeaveBalanceRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetLeaveLedger lists the leave ledger entries of an employee.
+	GetLeaveLedger(ctx context.Context, in *GetLeaveLedgerRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// AdjustLeave posts a correction of a leave balance.
+	AdjustLeave(ctx context.Context, in *AdjustLeaveRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// RegisterSickLeave records a sick leave of an employee.
+	RegisterSickLeave(ctx context.Context, in *RegisterSickLeaveRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetSickLeaves lists the sick leaves visible to the caller.
+	GetSickLeaves(ctx context.Context, in *GetSickLeavesRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// CloseSickLeave sets the last day of an open sick leave.
+	CloseSickLeave(ctx context.Context, in *CloseSickLeaveRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// CountWorkingDays counts the working days of a period by the calendar of an employee.
+	CountWorkingDays(ctx context.Context, in *CountWorkingDaysRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetCalendars lists the working-day calendars.
+	GetCalendars(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
+	// CreateCalendar creates an empty working-day calendar.
+	CreateCalendar(ctx context.Context, in *CalendarForm, opts ...grpc.CallOption) (*ApiResponse, error)
+	// DeleteCalendar deletes a calendar with its days.
+	DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// GetCalendarDays lists the holidays and transferred working days of a calendar.
+	GetCalendarDays(ctx context.Context, in *GetCalendarDaysRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// ImportCalendarDays adds the days of an .ics or CSV file to a calendar.
+	ImportCalendarDays(ctx context.Context, in *ImportCalendarDaysRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// DeleteCalendarDay removes a day from a calendar.
+	DeleteCalendarDay(ctx context.Context, in *DeleteCalendarDayRequest, opts ...grpc.CallOption) (*ApiResponse, error)
+	// CreatePasswordReset issues a password reset token for an employee.
+	CreatePasswordReset(ctx context.Context, in *CreatePasswordResetRequest, opts ...grpc.CallOption) (*ApiResponse, error)
 	// GetDepartments retrieves a list of departments.
 	GetDepartments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error)
 	// CreateDepartment creates a new department.
 	CreateDepartment(ctx context.Context, in *DepartmentForm, opts ...grpc.CallOption) (*ApiResponse, error)
 	// GetDepartmentByID retrieves a department by ID.
 	GetDepartmentByID(ctx context.Context, in *GetDepartmentByIDRequest, opts ...grpc.CallOption) (*ApiResponse, error)
-	// UpdateDepartment updates a department's details.
+	// UpdateDepartment updates a department's details, only the update_mask fields if set.
 	UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*ApiResponse, error)
 	// DeleteDepartment deletes a department.
 	DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*ApiResponse, error)
@@ -85,6 +221,16 @@ func (c *employeeServiceClient) AuthLogin(ctx context.Context, in *LoginRequest,
 	return out, nil
 }
 
+func (c *employeeServiceClient) AuthRefresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_AuthRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *employeeServiceClient) AuthLogout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
@@ -95,6 +241,226 @@ func (c *employeeServiceClient) AuthLogout(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *employeeServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RevokeAllSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RevokeAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) OIDCLogin(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_OIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_OIDCCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) EnrollMFA(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_EnrollMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) EnableMFA(ctx context.Context, in *MFACodeRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_EnableMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) DisableMFA(ctx context.Context, in *MFACodeRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_DisableMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) VerifyMFA(ctx context.Context, in *MFAVerifyRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_VerifyMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetLockouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetLockouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ClearLockout(ctx context.Context, in *ClearLockoutRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ClearLockout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetAPIKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CreateAPIKey(ctx context.Context, in *APIKeyForm, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) UpdateAPIKey(ctx context.Context, in *UpdateAPIKeyRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_UpdateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) DeleteAPIKey(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_DeleteAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetCalendarTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetCalendarTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CreateCalendarToken(ctx context.Context, in *CalendarTokenForm, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CreateCalendarToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) DeleteCalendarToken(ctx context.Context, in *DeleteCalendarTokenRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_DeleteCalendarToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_Impersonate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *employeeServiceClient) GetEmployees(ctx context.Context, in *GetEmployeesRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
@@ -125,6 +491,16 @@ func (c *employeeServiceClient) GetEmployeesByID(ctx context.Context, in *GetEmp
 	return out, nil
 }
 
+func (c *employeeServiceClient) SearchEmployees(ctx context.Context, in *SearchEmployeesRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_SearchEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *employeeServiceClient) UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
@@ -145,6 +521,26 @@ func (c *employeeServiceClient) DeleteEmployee(ctx context.Context, in *DeleteEm
 	return out, nil
 }
 
+func (c *employeeServiceClient) RestoreEmployee(ctx context.Context, in *RestoreEmployeeRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RestoreEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) PurgeEmployee(ctx context.Context, in *PurgeEmployeeRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_PurgeEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *employeeServiceClient) RequestVacation(ctx context.Context, in *RequestVacationRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
@@ -155,102 +551,443 @@ func (c *employeeServiceClient) RequestVacation(ctx context.Context, in *Request
 	return out, nil
 }
 
-func (c *employeeServiceClient) GetDepartments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+func (c *employeeServiceClient) GetVacationRequests(ctx context.Context, in *GetVacationRequestsRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_GetDepartments_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, EmployeeService_GetVacationRequests_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) CreateDepartment(ctx context.Context, in *DepartmentForm, opts ...grpc.CallOption) (*ApiResponse, error) {
+func (c *employeeServiceClient) ApproveVacationRequest(ctx context.Context, in *ApproveVacationRequestRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_CreateDepartment_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, EmployeeService_ApproveVacationRequest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) GetDepartmentByID(ctx context.Context, in *GetDepartmentByIDRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+func (c *employeeServiceClient) RejectVacationRequest(ctx context.Context, in *RejectVacationRequestRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_GetDepartmentByID_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, EmployeeService_RejectVacationRequest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+func (c *employeeServiceClient) CancelVacationRequest(ctx context.Context, in *CancelVacationRequestRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_UpdateDepartment_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, EmployeeService_CancelVacationRequest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+func (c *employeeServiceClient) GetLeaveBalance(ctx context.Context, in *GetLeaveBalanceRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_DeleteDepartment_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, EmployeeService_GetLeaveBalance_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// EmployeeServiceServer is the server API for EmployeeService service.
-// All implementations must embed UnimplementedEmployeeServiceServer
-// for forward compatibility.
-type EmployeeServiceServer interface {
-	// AuthLogin authenticates a user and returns JWT tokens.
-	AuthLogin(context.Context, *LoginRequest) (*ApiResponse, error)
-	// AuthLogout invalidates the current JWT token.
-	AuthLogout(context.Context, *emptypb.Empty) (*ApiResponse, error)
-	// GetEmployees retrieves a list of employees with optional filters.
-	GetEmployees(context.Context, *GetEmployeesRequest) (*ApiResponse, error)
-	// CreateEmployee creates a new employee.
-	CreateEmployee(context.Context, *Employee) (*ApiResponse, error)
-	// GetEmployeesByID retrieves an employee by ID.
-	GetEmployeesByID(context.Context, *GetEmployeeByIDRequest) (*ApiResponse, error)
-	// UpdateEmployee updates an employee's details.
-	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*ApiResponse, error)
-	// DeleteEmployee deletes an employee.
-	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*ApiResponse, error)
-	// RequestVacation adds vacation days for an employee.
-	RequestVacation(context.Context, *RequestVacationRequest) (*ApiResponse, error)
-	// GetDepartments retrieves a list of departments.
-	GetDepartments(context.Context, *emptypb.Empty) (*ApiResponse, error)
-	// CreateDepartment creates a new department.
-	CreateDepartment(context.Context, *DepartmentForm) (*ApiResponse, error)
-	// GetDepartmentByID retrieves a department by ID.
-	GetDepartmentByID(context.Context, *GetDepartmentByIDRequest) (*ApiResponse, error)
-	// UpdateDepartment updates a department's details.
-	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*ApiResponse, error)
-	// DeleteDepartment deletes a department.
-	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*ApiResponse, error)
-	mustEmbedUnimplementedEmployeeServiceServer()
+func (c *employeeServiceClient) GetLeaveLedger(ctx context.Context, in *GetLeaveLedgerRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetLeaveLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedEmployeeServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedEmployeeServiceServer struct{}
+func (c *employeeServiceClient) AdjustLeave(ctx context.Context, in *AdjustLeaveRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_AdjustLeave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RegisterSickLeave(ctx context.Context, in *RegisterSickLeaveRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RegisterSickLeave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetSickLeaves(ctx context.Context, in *GetSickLeavesRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetSickLeaves_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CloseSickLeave(ctx context.Context, in *CloseSickLeaveRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CloseSickLeave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CountWorkingDays(ctx context.Context, in *CountWorkingDaysRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CountWorkingDays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetCalendars(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetCalendars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CreateCalendar(ctx context.Context, in *CalendarForm, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CreateCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_DeleteCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetCalendarDays(ctx context.Context, in *GetCalendarDaysRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetCalendarDays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ImportCalendarDays(ctx context.Context, in *ImportCalendarDaysRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ImportCalendarDays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) DeleteCalendarDay(ctx context.Context, in *DeleteCalendarDayRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_DeleteCalendarDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CreatePasswordReset(ctx context.Context, in *CreatePasswordResetRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CreatePasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetDepartments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetDepartments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CreateDepartment(ctx context.Context, in *DepartmentForm, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CreateDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetDepartmentByID(ctx context.Context, in *GetDepartmentByIDRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetDepartmentByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_UpdateDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*ApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_DeleteDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmployeeServiceServer is the server API for EmployeeService service.
+// All implementations must embed UnimplementedEmployeeServiceServer
+// for forward compatibility.
+type EmployeeServiceServer interface {
+	// AuthLogin authenticates a user and returns JWT tokens.
+	AuthLogin(context.Context, *LoginRequest) (*ApiResponse, error)
+	// AuthRefresh rotates the refresh token and returns a new JWT token pair.
+	AuthRefresh(context.Context, *RefreshRequest) (*ApiResponse, error)
+	// AuthLogout invalidates the current JWT token.
+	AuthLogout(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// ChangePassword changes the password of the current user and returns new JWT tokens.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ApiResponse, error)
+	// ResetPassword sets a new password using a reset token.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ApiResponse, error)
+	// GetSessions returns the active sessions of the current user.
+	GetSessions(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// RevokeSession revokes one session of the current user.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*ApiResponse, error)
+	// RevokeAllSessions revokes every session of the current user.
+	RevokeAllSessions(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// OIDCLogin returns the authorization URL of the company IdP.
+	OIDCLogin(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// OIDCCallback completes the IdP login and returns a JWT token pair, or an MFA
+	// token for VerifyMFA if the employee has two-factor authentication enabled.
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*ApiResponse, error)
+	// EnrollMFA generates a new TOTP secret for the current user.
+	EnrollMFA(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// EnableMFA confirms the TOTP secret with a code and returns recovery codes.
+	EnableMFA(context.Context, *MFACodeRequest) (*ApiResponse, error)
+	// DisableMFA turns off TOTP of the current user.
+	DisableMFA(context.Context, *MFACodeRequest) (*ApiResponse, error)
+	// VerifyMFA exchanges the mfa token and the second factor for a token pair.
+	VerifyMFA(context.Context, *MFAVerifyRequest) (*ApiResponse, error)
+	// GetLockouts lists the emails and client IPs locked after failed logins.
+	GetLockouts(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// ClearLockout lifts the login lockout of an email or a client IP.
+	ClearLockout(context.Context, *ClearLockoutRequest) (*ApiResponse, error)
+	// GetAPIKeys lists the API keys of other services.
+	GetAPIKeys(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// CreateAPIKey creates an API key and returns its secret once.
+	CreateAPIKey(context.Context, *APIKeyForm) (*ApiResponse, error)
+	// UpdateAPIKey changes the name, owner, scopes and expiry of an API key.
+	UpdateAPIKey(context.Context, *UpdateAPIKeyRequest) (*ApiResponse, error)
+	// DeleteAPIKey revokes an API key.
+	DeleteAPIKey(context.Context, *DeleteAPIKeyRequest) (*ApiResponse, error)
+	// GetCalendarTokens lists the calendar tokens of the current user.
+	GetCalendarTokens(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// CreateCalendarToken creates a calendar token of the current user and returns its secret once.
+	CreateCalendarToken(context.Context, *CalendarTokenForm) (*ApiResponse, error)
+	// DeleteCalendarToken revokes a calendar token of the current user.
+	DeleteCalendarToken(context.Context, *DeleteCalendarTokenRequest) (*ApiResponse, error)
+	// Impersonate issues a short-lived access token of an employee to an admin.
+	Impersonate(context.Context, *ImpersonateRequest) (*ApiResponse, error)
+	// GetAuditLog lists the audit trail entries.
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*ApiResponse, error)
+	// GetEmployees retrieves a list of employees with optional filters.
+	GetEmployees(context.Context, *GetEmployeesRequest) (*ApiResponse, error)
+	// CreateEmployee creates a new employee. The generated temporary password is
+	// returned only by the first response, not by a replay of an idempotency-key.
+	CreateEmployee(context.Context, *Employee) (*ApiResponse, error)
+	// GetEmployeesByID retrieves an employee by ID.
+	GetEmployeesByID(context.Context, *GetEmployeeByIDRequest) (*ApiResponse, error)
+	// SearchEmployees finds employees by names, email, phone, position and personal number.
+	// It is declared after GetEmployeesByID so that the gateway matches it first.
+	SearchEmployees(context.Context, *SearchEmployeesRequest) (*ApiResponse, error)
+	// UpdateEmployee updates an employee's details, only the update_mask fields if set.
+	// PATCH through the gateway fills update_mask with the fields of the body.
+	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*ApiResponse, error)
+	// DeleteEmployee terminates an employee, the record is kept with status fired.
+	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*ApiResponse, error)
+	// RestoreEmployee rehires a terminated employee.
+	RestoreEmployee(context.Context, *RestoreEmployeeRequest) (*ApiResponse, error)
+	// PurgeEmployee erases a terminated employee for good.
+	PurgeEmployee(context.Context, *PurgeEmployeeRequest) (*ApiResponse, error)
+	// RequestVacation submits a vacation request of an employee.
+	RequestVacation(context.Context, *RequestVacationRequest) (*ApiResponse, error)
+	// GetVacationRequests lists the vacation requests visible to the caller.
+	GetVacationRequests(context.Context, *GetVacationRequestsRequest) (*ApiResponse, error)
+	// ApproveVacationRequest approves a vacation request at its current stage.
+	ApproveVacationRequest(context.Context, *ApproveVacationRequestRequest) (*ApiResponse, error)
+	// RejectVacationRequest rejects a vacation request at its current stage.
+	RejectVacationRequest(context.Context, *RejectVacationRequestRequest) (*ApiResponse, error)
+	// CancelVacationRequest withdraws a vacation request.
+	CancelVacationRequest(context.Context, *CancelVacationRequestRequest) (*ApiResponse, error)
+	// GetLeaveBalance derives the leave balances of an employee from the ledger.
+	GetLeaveBalance(context.Context, *GetLeaveBalanceRequest) (*ApiResponse, error)
+	// GetLeaveLedger lists the leave ledger entries of an employee.
+	GetLeaveLedger(context.Context, *GetLeaveLedgerRequest) (*ApiResponse, error)
+	// AdjustLeave posts a correction of a leave balance.
+	AdjustLeave(context.Context, *AdjustLeaveRequest) (*ApiResponse, error)
+	// RegisterSickLeave records a sick leave of an employee.
+	RegisterSickLeave(context.Context, *RegisterSickLeaveRequest) (*ApiResponse, error)
+	// GetSickLeaves lists the sick leaves visible to the caller.
+	GetSickLeaves(context.Context, *GetSickLeavesRequest) (*ApiResponse, error)
+	// CloseSickLeave sets the last day of an open sick leave.
+	CloseSickLeave(context.Context, *CloseSickLeaveRequest) (*ApiResponse, error)
+	// CountWorkingDays counts the working days of a period by the calendar of an employee.
+	CountWorkingDays(context.Context, *CountWorkingDaysRequest) (*ApiResponse, error)
+	// GetCalendars lists the working-day calendars.
+	GetCalendars(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// CreateCalendar creates an empty working-day calendar.
+	CreateCalendar(context.Context, *CalendarForm) (*ApiResponse, error)
+	// DeleteCalendar deletes a calendar with its days.
+	DeleteCalendar(context.Context, *DeleteCalendarRequest) (*ApiResponse, error)
+	// GetCalendarDays lists the holidays and transferred working days of a calendar.
+	GetCalendarDays(context.Context, *GetCalendarDaysRequest) (*ApiResponse, error)
+	// ImportCalendarDays adds the days of an .ics or CSV file to a calendar.
+	ImportCalendarDays(context.Context, *ImportCalendarDaysRequest) (*ApiResponse, error)
+	// DeleteCalendarDay removes a day from a calendar.
+	DeleteCalendarDay(context.Context, *DeleteCalendarDayRequest) (*ApiResponse, error)
+	// CreatePasswordReset issues a password reset token for an employee.
+	CreatePasswordReset(context.Context, *CreatePasswordResetRequest) (*ApiResponse, error)
+	// GetDepartments retrieves a list of departments.
+	GetDepartments(context.Context, *emptypb.Empty) (*ApiResponse, error)
+	// CreateDepartment creates a new department.
+	CreateDepartment(context.Context, *DepartmentForm) (*ApiResponse, error)
+	// GetDepartmentByID retrieves a department by ID.
+	GetDepartmentByID(context.Context, *GetDepartmentByIDRequest) (*ApiResponse, error)
+	// UpdateDepartment updates a department's details, only the update_mask fields if set.
+	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*ApiResponse, error)
+	// DeleteDepartment deletes a department.
+	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*ApiResponse, error)
+	mustEmbedUnimplementedEmployeeServiceServer()
+}
+
+// UnimplementedEmployeeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmployeeServiceServer struct{}
 
 func (UnimplementedEmployeeServiceServer) AuthLogin(context.Context, *LoginRequest) (*ApiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthLogin not implemented")
 }
+func (UnimplementedEmployeeServiceServer) AuthRefresh(context.Context, *RefreshRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthRefresh not implemented")
+}
 func (UnimplementedEmployeeServiceServer) AuthLogout(context.Context, *emptypb.Empty) (*ApiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthLogout not implemented")
 }
+func (UnimplementedEmployeeServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetSessions(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RevokeAllSessions(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
+func (UnimplementedEmployeeServiceServer) OIDCLogin(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCLogin not implemented")
+}
+func (UnimplementedEmployeeServiceServer) OIDCCallback(context.Context, *OIDCCallbackRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCCallback not implemented")
+}
+func (UnimplementedEmployeeServiceServer) EnrollMFA(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollMFA not implemented")
+}
+func (UnimplementedEmployeeServiceServer) EnableMFA(context.Context, *MFACodeRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableMFA not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DisableMFA(context.Context, *MFACodeRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMFA not implemented")
+}
+func (UnimplementedEmployeeServiceServer) VerifyMFA(context.Context, *MFAVerifyRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMFA not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetLockouts(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLockouts not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ClearLockout(context.Context, *ClearLockoutRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearLockout not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetAPIKeys(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeys not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CreateAPIKey(context.Context, *APIKeyForm) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedEmployeeServiceServer) UpdateAPIKey(context.Context, *UpdateAPIKeyRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAPIKey not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DeleteAPIKey(context.Context, *DeleteAPIKeyRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAPIKey not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetCalendarTokens(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarTokens not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CreateCalendarToken(context.Context, *CalendarTokenForm) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendarToken not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DeleteCalendarToken(context.Context, *DeleteCalendarTokenRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCalendarToken not implemented")
+}
+func (UnimplementedEmployeeServiceServer) Impersonate(context.Context, *ImpersonateRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impersonate not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
 func (UnimplementedEmployeeServiceServer) GetEmployees(context.Context, *GetEmployeesRequest) (*ApiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmployees not implemented")
 }
@@ -260,191 +997,1046 @@ func (UnimplementedEmployeeServiceServer) CreateEmployee(context.Context, *Emplo
 func (UnimplementedEmployeeServiceServer) GetEmployeesByID(context.Context, *GetEmployeeByIDRequest) (*ApiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmployeesByID not implemented")
 }
+func (UnimplementedEmployeeServiceServer) SearchEmployees(context.Context, *SearchEmployeesRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEmployees not implemented")
+}
 func (UnimplementedEmployeeServiceServer) UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*ApiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmployee not implemented")
 }
 func (UnimplementedEmployeeServiceServer) DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*ApiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmployee not implemented")
 }
-func (UnimplementedEmployeeServiceServer) RequestVacation(context.Context, *RequestVacationRequest) (*ApiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestVacation not implemented")
+func (UnimplementedEmployeeServiceServer) RestoreEmployee(context.Context, *RestoreEmployeeRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) PurgeEmployee(context.Context, *PurgeEmployeeRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RequestVacation(context.Context, *RequestVacationRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestVacation not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetVacationRequests(context.Context, *GetVacationRequestsRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacationRequests not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ApproveVacationRequest(context.Context, *ApproveVacationRequestRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveVacationRequest not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RejectVacationRequest(context.Context, *RejectVacationRequestRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectVacationRequest not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CancelVacationRequest(context.Context, *CancelVacationRequestRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelVacationRequest not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetLeaveBalance(context.Context, *GetLeaveBalanceRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaveBalance not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetLeaveLedger(context.Context, *GetLeaveLedgerRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaveLedger not implemented")
+}
+func (UnimplementedEmployeeServiceServer) AdjustLeave(context.Context, *AdjustLeaveRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustLeave not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RegisterSickLeave(context.Context, *RegisterSickLeaveRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSickLeave not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetSickLeaves(context.Context, *GetSickLeavesRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSickLeaves not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CloseSickLeave(context.Context, *CloseSickLeaveRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSickLeave not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CountWorkingDays(context.Context, *CountWorkingDaysRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountWorkingDays not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetCalendars(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendars not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CreateCalendar(context.Context, *CalendarForm) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendar not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DeleteCalendar(context.Context, *DeleteCalendarRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCalendar not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetCalendarDays(context.Context, *GetCalendarDaysRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarDays not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ImportCalendarDays(context.Context, *ImportCalendarDaysRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCalendarDays not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DeleteCalendarDay(context.Context, *DeleteCalendarDayRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCalendarDay not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CreatePasswordReset(context.Context, *CreatePasswordResetRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePasswordReset not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetDepartments(context.Context, *emptypb.Empty) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartments not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CreateDepartment(context.Context, *DepartmentForm) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDepartment not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetDepartmentByID(context.Context, *GetDepartmentByIDRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentByID not implemented")
+}
+func (UnimplementedEmployeeServiceServer) UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepartment not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*ApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepartment not implemented")
+}
+func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
+func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeEmployeeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmployeeServiceServer will
+// result in compilation errors.
+type UnsafeEmployeeServiceServer interface {
+	mustEmbedUnimplementedEmployeeServiceServer()
+}
+
+func RegisterEmployeeServiceServer(s grpc.ServiceRegistrar, srv EmployeeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmployeeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmployeeService_ServiceDesc, srv)
+}
+
+func _EmployeeService_AuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).AuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_AuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).AuthLogin(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_AuthRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).AuthRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_AuthRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).AuthRefresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_AuthLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).AuthLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_AuthLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).AuthLogout(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RevokeAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RevokeAllSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_OIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).OIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_OIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).OIDCLogin(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_OIDCCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).OIDCCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_OIDCCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).OIDCCallback(ctx, req.(*OIDCCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_EnrollMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).EnrollMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_EnrollMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).EnrollMFA(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_EnableMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFACodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).EnableMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_EnableMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).EnableMFA(ctx, req.(*MFACodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_DisableMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFACodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).DisableMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_DisableMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).DisableMFA(ctx, req.(*MFACodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_VerifyMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFAVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).VerifyMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_VerifyMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).VerifyMFA(ctx, req.(*MFAVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetLockouts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ClearLockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ClearLockout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ClearLockout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ClearLockout(ctx, req.(*ClearLockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetAPIKeys(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIKeyForm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CreateAPIKey(ctx, req.(*APIKeyForm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_UpdateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).UpdateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_UpdateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).UpdateAPIKey(ctx, req.(*UpdateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_DeleteAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).DeleteAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_DeleteAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).DeleteAPIKey(ctx, req.(*DeleteAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetCalendarTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetCalendarTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetCalendarTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetCalendarTokens(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_CreateCalendarToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarTokenForm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CreateCalendarToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CreateCalendarToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CreateCalendarToken(ctx, req.(*CalendarTokenForm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_DeleteCalendarToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalendarTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).DeleteCalendarToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_DeleteCalendarToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).DeleteCalendarToken(ctx, req.(*DeleteCalendarTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_Impersonate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).Impersonate(ctx, req.(*ImpersonateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetEmployees(ctx, req.(*GetEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_CreateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Employee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CreateEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CreateEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CreateEmployee(ctx, req.(*Employee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetEmployeesByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetEmployeesByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetEmployeesByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetEmployeesByID(ctx, req.(*GetEmployeeByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_SearchEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).SearchEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_SearchEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).SearchEmployees(ctx, req.(*SearchEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_UpdateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).UpdateEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_UpdateEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).UpdateEmployee(ctx, req.(*UpdateEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_DeleteEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).DeleteEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_DeleteEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).DeleteEmployee(ctx, req.(*DeleteEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RestoreEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RestoreEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RestoreEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RestoreEmployee(ctx, req.(*RestoreEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_PurgeEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).PurgeEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_PurgeEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).PurgeEmployee(ctx, req.(*PurgeEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RequestVacation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestVacationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RequestVacation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RequestVacation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RequestVacation(ctx, req.(*RequestVacationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetVacationRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacationRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetVacationRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetVacationRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetVacationRequests(ctx, req.(*GetVacationRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ApproveVacationRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveVacationRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ApproveVacationRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ApproveVacationRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ApproveVacationRequest(ctx, req.(*ApproveVacationRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RejectVacationRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectVacationRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RejectVacationRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RejectVacationRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RejectVacationRequest(ctx, req.(*RejectVacationRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmployeeServiceServer) GetDepartments(context.Context, *emptypb.Empty) (*ApiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepartments not implemented")
+
+func _EmployeeService_CancelVacationRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelVacationRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CancelVacationRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CancelVacationRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CancelVacationRequest(ctx, req.(*CancelVacationRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmployeeServiceServer) CreateDepartment(context.Context, *DepartmentForm) (*ApiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDepartment not implemented")
+
+func _EmployeeService_GetLeaveBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaveBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetLeaveBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetLeaveBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetLeaveBalance(ctx, req.(*GetLeaveBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmployeeServiceServer) GetDepartmentByID(context.Context, *GetDepartmentByIDRequest) (*ApiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentByID not implemented")
+
+func _EmployeeService_GetLeaveLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaveLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetLeaveLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetLeaveLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetLeaveLedger(ctx, req.(*GetLeaveLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmployeeServiceServer) UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*ApiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepartment not implemented")
+
+func _EmployeeService_AdjustLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustLeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).AdjustLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_AdjustLeave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).AdjustLeave(ctx, req.(*AdjustLeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmployeeServiceServer) DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*ApiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepartment not implemented")
+
+func _EmployeeService_RegisterSickLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterSickLeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RegisterSickLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RegisterSickLeave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RegisterSickLeave(ctx, req.(*RegisterSickLeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
-func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeEmployeeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EmployeeServiceServer will
-// result in compilation errors.
-type UnsafeEmployeeServiceServer interface {
-	mustEmbedUnimplementedEmployeeServiceServer()
+func _EmployeeService_GetSickLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSickLeavesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetSickLeaves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetSickLeaves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetSickLeaves(ctx, req.(*GetSickLeavesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterEmployeeServiceServer(s grpc.ServiceRegistrar, srv EmployeeServiceServer) {
-	// If the following call pancis, it indicates UnimplementedEmployeeServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _EmployeeService_CloseSickLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSickLeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&EmployeeService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CloseSickLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CloseSickLeave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CloseSickLeave(ctx, req.(*CloseSickLeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_AuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _EmployeeService_CountWorkingDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountWorkingDaysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).AuthLogin(ctx, in)
+		return srv.(EmployeeServiceServer).CountWorkingDays(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_AuthLogin_FullMethodName,
+		FullMethod: EmployeeService_CountWorkingDays_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).AuthLogin(ctx, req.(*LoginRequest))
+		return srv.(EmployeeServiceServer).CountWorkingDays(ctx, req.(*CountWorkingDaysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_AuthLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _EmployeeService_GetCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).AuthLogout(ctx, in)
+		return srv.(EmployeeServiceServer).GetCalendars(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_AuthLogout_FullMethodName,
+		FullMethod: EmployeeService_GetCalendars_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).AuthLogout(ctx, req.(*emptypb.Empty))
+		return srv.(EmployeeServiceServer).GetCalendars(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_GetEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEmployeesRequest)
+func _EmployeeService_CreateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarForm)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).GetEmployees(ctx, in)
+		return srv.(EmployeeServiceServer).CreateCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_GetEmployees_FullMethodName,
+		FullMethod: EmployeeService_CreateCalendar_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).GetEmployees(ctx, req.(*GetEmployeesRequest))
+		return srv.(EmployeeServiceServer).CreateCalendar(ctx, req.(*CalendarForm))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_CreateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Employee)
+func _EmployeeService_DeleteCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalendarRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).CreateEmployee(ctx, in)
+		return srv.(EmployeeServiceServer).DeleteCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_CreateEmployee_FullMethodName,
+		FullMethod: EmployeeService_DeleteCalendar_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).CreateEmployee(ctx, req.(*Employee))
+		return srv.(EmployeeServiceServer).DeleteCalendar(ctx, req.(*DeleteCalendarRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_GetEmployeesByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEmployeeByIDRequest)
+func _EmployeeService_GetCalendarDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarDaysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).GetEmployeesByID(ctx, in)
+		return srv.(EmployeeServiceServer).GetCalendarDays(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_GetEmployeesByID_FullMethodName,
+		FullMethod: EmployeeService_GetCalendarDays_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).GetEmployeesByID(ctx, req.(*GetEmployeeByIDRequest))
+		return srv.(EmployeeServiceServer).GetCalendarDays(ctx, req.(*GetCalendarDaysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_UpdateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEmployeeRequest)
+func _EmployeeService_ImportCalendarDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCalendarDaysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).UpdateEmployee(ctx, in)
+		return srv.(EmployeeServiceServer).ImportCalendarDays(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_UpdateEmployee_FullMethodName,
+		FullMethod: EmployeeService_ImportCalendarDays_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).UpdateEmployee(ctx, req.(*UpdateEmployeeRequest))
+		return srv.(EmployeeServiceServer).ImportCalendarDays(ctx, req.(*ImportCalendarDaysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_DeleteEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEmployeeRequest)
+func _EmployeeService_DeleteCalendarDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalendarDayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).DeleteEmployee(ctx, in)
+		return srv.(EmployeeServiceServer).DeleteCalendarDay(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_DeleteEmployee_FullMethodName,
+		FullMethod: EmployeeService_DeleteCalendarDay_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).DeleteEmployee(ctx, req.(*DeleteEmployeeRequest))
+		return srv.(EmployeeServiceServer).DeleteCalendarDay(ctx, req.(*DeleteCalendarDayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_RequestVacation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestVacationRequest)
+func _EmployeeService_CreatePasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePasswordResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).RequestVacation(ctx, in)
+		return srv.(EmployeeServiceServer).CreatePasswordReset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_RequestVacation_FullMethodName,
+		FullMethod: EmployeeService_CreatePasswordReset_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).RequestVacation(ctx, req.(*RequestVacationRequest))
+		return srv.(EmployeeServiceServer).CreatePasswordReset(ctx, req.(*CreatePasswordResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -550,10 +2142,102 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthLogin",
 			Handler:    _EmployeeService_AuthLogin_Handler,
 		},
+		{
+			MethodName: "AuthRefresh",
+			Handler:    _EmployeeService_AuthRefresh_Handler,
+		},
 		{
 			MethodName: "AuthLogout",
 			Handler:    _EmployeeService_AuthLogout_Handler,
 		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _EmployeeService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _EmployeeService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _EmployeeService_GetSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _EmployeeService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _EmployeeService_RevokeAllSessions_Handler,
+		},
+		{
+			MethodName: "OIDCLogin",
+			Handler:    _EmployeeService_OIDCLogin_Handler,
+		},
+		{
+			MethodName: "OIDCCallback",
+			Handler:    _EmployeeService_OIDCCallback_Handler,
+		},
+		{
+			MethodName: "EnrollMFA",
+			Handler:    _EmployeeService_EnrollMFA_Handler,
+		},
+		{
+			MethodName: "EnableMFA",
+			Handler:    _EmployeeService_EnableMFA_Handler,
+		},
+		{
+			MethodName: "DisableMFA",
+			Handler:    _EmployeeService_DisableMFA_Handler,
+		},
+		{
+			MethodName: "VerifyMFA",
+			Handler:    _EmployeeService_VerifyMFA_Handler,
+		},
+		{
+			MethodName: "GetLockouts",
+			Handler:    _EmployeeService_GetLockouts_Handler,
+		},
+		{
+			MethodName: "ClearLockout",
+			Handler:    _EmployeeService_ClearLockout_Handler,
+		},
+		{
+			MethodName: "GetAPIKeys",
+			Handler:    _EmployeeService_GetAPIKeys_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _EmployeeService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "UpdateAPIKey",
+			Handler:    _EmployeeService_UpdateAPIKey_Handler,
+		},
+		{
+			MethodName: "DeleteAPIKey",
+			Handler:    _EmployeeService_DeleteAPIKey_Handler,
+		},
+		{
+			MethodName: "GetCalendarTokens",
+			Handler:    _EmployeeService_GetCalendarTokens_Handler,
+		},
+		{
+			MethodName: "CreateCalendarToken",
+			Handler:    _EmployeeService_CreateCalendarToken_Handler,
+		},
+		{
+			MethodName: "DeleteCalendarToken",
+			Handler:    _EmployeeService_DeleteCalendarToken_Handler,
+		},
+		{
+			MethodName: "Impersonate",
+			Handler:    _EmployeeService_Impersonate_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _EmployeeService_GetAuditLog_Handler,
+		},
 		{
 			MethodName: "GetEmployees",
 			Handler:    _EmployeeService_GetEmployees_Handler,
@@ -566,6 +2250,10 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEmployeesByID",
 			Handler:    _EmployeeService_GetEmployeesByID_Handler,
 		},
+		{
+			MethodName: "SearchEmployees",
+			Handler:    _EmployeeService_SearchEmployees_Handler,
+		},
 		{
 			MethodName: "UpdateEmployee",
 			Handler:    _EmployeeService_UpdateEmployee_Handler,
@@ -574,10 +2262,90 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEmployee",
 			Handler:    _EmployeeService_DeleteEmployee_Handler,
 		},
+		{
+			MethodName: "RestoreEmployee",
+			Handler:    _EmployeeService_RestoreEmployee_Handler,
+		},
+		{
+			MethodName: "PurgeEmployee",
+			Handler:    _EmployeeService_PurgeEmployee_Handler,
+		},
 		{
 			MethodName: "RequestVacation",
 			Handler:    _EmployeeService_RequestVacation_Handler,
 		},
+		{
+			MethodName: "GetVacationRequests",
+			Handler:    _EmployeeService_GetVacationRequests_Handler,
+		},
+		{
+			MethodName: "ApproveVacationRequest",
+			Handler:    _EmployeeService_ApproveVacationRequest_Handler,
+		},
+		{
+			MethodName: "RejectVacationRequest",
+			Handler:    _EmployeeService_RejectVacationRequest_Handler,
+		},
+		{
+			MethodName: "CancelVacationRequest",
+			Handler:    _EmployeeService_CancelVacationRequest_Handler,
+		},
+		{
+			MethodName: "GetLeaveBalance",
+			Handler:    _EmployeeService_GetLeaveBalance_Handler,
+		},
+		{
+			MethodName: "GetLeaveLedger",
+			Handler:    _EmployeeService_GetLeaveLedger_Handler,
+		},
+		{
+			MethodName: "AdjustLeave",
+			Handler:    _EmployeeService_AdjustLeave_Handler,
+		},
+		{
+			MethodName: "RegisterSickLeave",
+			Handler:    _EmployeeService_RegisterSickLeave_Handler,
+		},
+		{
+			MethodName: "GetSickLeaves",
+			Handler:    _EmployeeService_GetSickLeaves_Handler,
+		},
+		{
+			MethodName: "CloseSickLeave",
+			Handler:    _EmployeeService_CloseSickLeave_Handler,
+		},
+		{
+			MethodName: "CountWorkingDays",
+			Handler:    _EmployeeService_CountWorkingDays_Handler,
+		},
+		{
+			MethodName: "GetCalendars",
+			Handler:    _EmployeeService_GetCalendars_Handler,
+		},
+		{
+			MethodName: "CreateCalendar",
+			Handler:    _EmployeeService_CreateCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteCalendar",
+			Handler:    _EmployeeService_DeleteCalendar_Handler,
+		},
+		{
+			MethodName: "GetCalendarDays",
+			Handler:    _EmployeeService_GetCalendarDays_Handler,
+		},
+		{
+			MethodName: "ImportCalendarDays",
+			Handler:    _EmployeeService_ImportCalendarDays_Handler,
+		},
+		{
+			MethodName: "DeleteCalendarDay",
+			Handler:    _EmployeeService_DeleteCalendarDay_Handler,
+		},
+		{
+			MethodName: "CreatePasswordReset",
+			Handler:    _EmployeeService_CreatePasswordReset_Handler,
+		},
 		{
 			MethodName: "GetDepartments",
 			Handler:    _EmployeeService_GetDepartments_Handler,
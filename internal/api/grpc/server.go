@@ -2,11 +2,22 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"math"
+	"strconv"
+	"time"
 
 	pb "github.com/adamanr/employes_service/internal/api/grpc/proto"
 	"github.com/adamanr/employes_service/internal/controllers"
+	"github.com/adamanr/employes_service/internal/entity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Server struct {
@@ -21,9 +32,17 @@ func NewServer(deps *controllers.Dependens) *Server {
 	return &Server{
 		deps: deps,
 		Controllers: &controllers.Controllers{
-			AuthController:       controllers.NewAuthController(deps),
-			DepartmentController: controllers.NewDepartmentController(deps),
-			EmployeeController:   controllers.NewEmployeeController(deps),
+			AuthController:         controllers.NewAuthController(deps),
+			APIKeyController:       controllers.NewAPIKeyController(deps),
+			AuditController:        controllers.NewAuditController(deps),
+			CalendarController:     controllers.NewCalendarController(deps),
+			CalendarFeedController: controllers.NewCalendarFeedController(deps),
+			DepartmentController:   controllers.NewDepartmentController(deps),
+			EmployeeController:     controllers.NewEmployeeController(deps),
+			IdempotencyController:  controllers.NewIdempotencyController(deps),
+			LeaveController:        controllers.NewLeaveController(deps),
+			SickLeaveController:    controllers.NewSickLeaveController(deps),
+			VacationController:     controllers.NewVacationController(deps),
 		},
 	}
 }
@@ -33,10 +52,1221 @@ var _ pb.EmployeeServiceServer = &Server{}
 // AuthLogin authenticates a user and returns a JWT token.
 func (s *Server) AuthLogin(ctx context.Context, req *pb.LoginRequest) (*pb.ApiResponse, error) {
 	reqEntity := ProtoToLoginRequest(req)
+	reqEntity.ClientIP = clientIP(ctx, s.deps.Config.Server.TrustedProxies)
 
-	accessToken, refreshToken, err := s.Controllers.AuthController.AuthLogin(reqEntity)
+	resp, err := s.Controllers.AuthController.AuthLogin(reqEntity)
 	if err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error logging in", slog.String("error", err.Error()))
+
+		var locked *controllers.LockedError
+		if errors.As(err, &locked) {
+			retryAfter := strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds())))
+			if headerErr := grpc.SetHeader(ctx, metadata.Pairs("retry-after", retryAfter)); headerErr != nil {
+				s.deps.Logger.ErrorContext(ctx, "Error setting header", slog.String("error", headerErr.Error()))
+			}
+
+			return &pb.ApiResponse{
+				Status: TooManyRequestsStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.ResourceExhausted, controllers.ErrLoginLocked.Error())
+		}
+
+		if errors.Is(err, controllers.ErrInvalidCredentials) {
+			return &pb.ApiResponse{
+				Status: UnauthorizedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		if errors.Is(err, controllers.ErrPasswordLoginDisabled) || errors.Is(err, controllers.ErrAccountInactive) {
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	loginResponse := &pb.LoginResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		MfaToken:     resp.MFAToken,
+	}
+
+	return s.grpcResponse(ctx, loginResponse)
+}
+
+// OIDCLogin returns the authorization URL of the company IdP.
+func (s *Server) OIDCLogin(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	authURL, err := s.Controllers.AuthController.StartOIDCLogin()
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error starting oidc login", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrOIDCDisabled) {
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &pb.OIDCLoginResponse{AuthorizationUrl: authURL})
+}
+
+// OIDCCallback completes the IdP login and returns a JWT token pair, or an MFA
+// token if the employee has two-factor authentication enabled.
+func (s *Server) OIDCCallback(ctx context.Context, req *pb.OIDCCallbackRequest) (*pb.ApiResponse, error) {
+	resp, err := s.Controllers.AuthController.FinishOIDCLogin(req.GetCode(), req.GetState())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error finishing oidc login", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidOIDCState), errors.Is(err, controllers.ErrInvalidIDToken):
+			return &pb.ApiResponse{
+				Status: UnauthorizedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, controllers.ErrOIDCDisabled), errors.Is(err, controllers.ErrOIDCUnknownUser),
+			errors.Is(err, controllers.ErrAccountInactive):
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	loginResponse := &pb.LoginResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		MfaToken:     resp.MFAToken,
+	}
+
+	return s.grpcResponse(ctx, loginResponse)
+}
+
+// AuthRefresh rotates the refresh token and returns a new JWT token pair.
+func (s *Server) AuthRefresh(ctx context.Context, req *pb.RefreshRequest) (*pb.ApiResponse, error) {
+	accessToken, refreshToken, err := s.Controllers.AuthController.AuthRefresh(req.GetRefreshToken())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error refreshing token", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: UnauthorizedStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	loginResponse := &pb.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+
+	return s.grpcResponse(ctx, loginResponse)
+}
+
+// ChangePassword changes the password of the current user and returns new JWT tokens.
+func (s *Server) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpChangePassword, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	accessToken, refreshToken, err := s.Controllers.AuthController.ChangePassword(user, &entity.ChangePasswordRequest{
+		OldPassword: req.GetOldPassword(),
+		NewPassword: req.GetNewPassword(),
+	})
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error changing password", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrInvalidPassword) || errors.Is(err, controllers.ErrEmptyPassword) ||
+			errors.Is(err, controllers.ErrSamePassword) || errors.Is(err, controllers.ErrWeakPassword) {
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	loginResponse := &pb.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+
+	return s.grpcResponse(ctx, loginResponse)
+}
+
+// ResetPassword sets a new password using a reset token.
+func (s *Server) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ApiResponse, error) {
+	if err := s.Controllers.AuthController.ResetPassword(&entity.ResetPasswordRequest{
+		ResetToken:  req.GetResetToken(),
+		NewPassword: req.GetNewPassword(),
+	}); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error resetting password", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrInvalidResetToken) || errors.Is(err, controllers.ErrEmptyPassword) ||
+			errors.Is(err, controllers.ErrWeakPassword) {
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &emptypb.Empty{})
+}
+
+// CreatePasswordReset issues a password reset token for the employee.
+func (s *Server) CreatePasswordReset(ctx context.Context, req *pb.CreatePasswordResetRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpResetPassword, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	reset, err := s.Controllers.AuthController.CreatePasswordReset(user, req.GetId())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error creating password reset", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrPermissionDenied):
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &pb.PasswordResetResponse{
+		ResetToken: reset.ResetToken,
+		ExpiresAt:  timestamppb.New(reset.ExpiresAt),
+	})
+}
+
+// Impersonate issues a short-lived access token of the employee to an admin.
+func (s *Server) Impersonate(ctx context.Context, req *pb.ImpersonateRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpImpersonate, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	token, err := s.Controllers.AuthController.Impersonate(user, req.GetId())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error impersonating employee", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrImpersonationNotAllowed):
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &pb.ImpersonationResponse{
+		AccessToken: token.AccessToken,
+		ExpiresAt:   timestamppb.New(token.ExpiresAt),
+	})
+}
+
+// GetAuditLog returns the audit trail.
+func (s *Server) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetAuditLog, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	entries, err := s.Controllers.AuditController.GetAuditLog(&entity.GetAuditLogParams{
+		ActorID:    req.ActorId,
+		EmployeeID: req.EmployeeId,
+	})
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting audit log", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	resp := &pb.GetAuditLogResponse{
+		Entries: AuditEntriesToProto(entries),
+	}
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// AuthLogout make logout user.
+func (s *Server) AuthLogout(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpAuthLogout, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err = s.Controllers.AuthController.AuthLogout(user); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error revoking session", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &emptypb.Empty{})
+}
+
+// GetSessions get active sessions of the current user.
+func (s *Server) GetSessions(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpGetSessions, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	sessions, err := s.Controllers.AuthController.ListSessions(user)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting sessions", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	resp := &pb.GetSessionsResponse{
+		Sessions: SessionsToProto(sessions),
+	}
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// RevokeSession revoke one session of the current user.
+func (s *Server) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpRevokeSession, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err = s.Controllers.AuthController.RevokeSession(user, req.GetId()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error revoking session", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrSessionNotFound) {
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &emptypb.Empty{})
+}
+
+// RevokeAllSessions revoke every session of the current user.
+func (s *Server) RevokeAllSessions(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpRevokeAllSessions, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err = s.Controllers.AuthController.RevokeAllSessions(user); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error revoking sessions", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &emptypb.Empty{})
+}
+
+// EnrollMFA generates a new TOTP secret for the current user.
+func (s *Server) EnrollMFA(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpEnrollMFA, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	enrollment, err := s.Controllers.AuthController.EnrollMFA(user)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error enrolling mfa", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrMFAAlreadyEnabled) {
+			return &pb.ApiResponse{
+				Status: ConflictStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.AlreadyExists, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &pb.MFAEnrollment{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	})
+}
+
+// EnableMFA confirms the TOTP secret of the current user.
+func (s *Server) EnableMFA(ctx context.Context, req *pb.MFACodeRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpEnableMFA, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	enabled, err := s.Controllers.AuthController.EnableMFA(user, &entity.MFACodeRequest{Code: req.GetCode()})
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error enabling mfa", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidMFACode), errors.Is(err, controllers.ErrMFANotEnrolled):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controllers.ErrMFAAlreadyEnabled):
+			return &pb.ApiResponse{
+				Status: ConflictStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.AlreadyExists, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &pb.MFAEnabled{
+		RecoveryCodes: enabled.RecoveryCodes,
+		AccessToken:   enabled.AccessToken,
+		RefreshToken:  enabled.RefreshToken,
+	})
+}
+
+// DisableMFA turns off TOTP of the current user.
+func (s *Server) DisableMFA(ctx context.Context, req *pb.MFACodeRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpDisableMFA, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err = s.Controllers.AuthController.DisableMFA(user, &entity.MFACodeRequest{Code: req.GetCode()}); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error disabling mfa", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidMFACode), errors.Is(err, controllers.ErrMFANotEnrolled):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controllers.ErrMFARequired):
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &emptypb.Empty{})
+}
+
+// VerifyMFA exchanges the mfa token and the second factor for a token pair.
+func (s *Server) VerifyMFA(ctx context.Context, req *pb.MFAVerifyRequest) (*pb.ApiResponse, error) {
+	accessToken, refreshToken, err := s.Controllers.AuthController.VerifyMFA(&entity.MFAVerifyRequest{
+		MFAToken: req.GetMfaToken(),
+		Code:     req.GetCode(),
+		ClientIP: clientIP(ctx, s.deps.Config.Server.TrustedProxies),
+	})
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error verifying mfa", slog.String("error", err.Error()))
+
+		var locked *controllers.LockedError
+		if errors.As(err, &locked) {
+			retryAfter := strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds())))
+			if headerErr := grpc.SetHeader(ctx, metadata.Pairs("retry-after", retryAfter)); headerErr != nil {
+				s.deps.Logger.ErrorContext(ctx, "Error setting header", slog.String("error", headerErr.Error()))
+			}
+
+			return &pb.ApiResponse{
+				Status: TooManyRequestsStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.ResourceExhausted, controllers.ErrLoginLocked.Error())
+		}
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidMFACode):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controllers.ErrInvalidMFAToken), errors.Is(err, controllers.ErrMFANotEnrolled):
+			return &pb.ApiResponse{
+				Status: UnauthorizedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, controllers.ErrAccountInactive):
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	loginResponse := &pb.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+
+	return s.grpcResponse(ctx, loginResponse)
+}
+
+// GetLockouts returns the active login lockouts.
+func (s *Server) GetLockouts(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetLockouts, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	lockouts, err := s.Controllers.AuthController.ListLockouts()
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting lockouts", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	resp := &pb.GetLockoutsResponse{
+		Lockouts: LockoutsToProto(lockouts),
+	}
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// ClearLockout lifts the login lockout of an email or a client IP.
+func (s *Server) ClearLockout(ctx context.Context, req *pb.ClearLockoutRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpClearLockout, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err = s.Controllers.AuthController.ClearLockout(user, req.GetScope(), req.GetValue()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error clearing lockout", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidLockout):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controllers.ErrLockoutNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &emptypb.Empty{})
+}
+
+// GetAPIKeys lists the API keys of other services.
+func (s *Server) GetAPIKeys(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetAPIKeys, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	keys, err := s.Controllers.APIKeyController.GetAPIKeys()
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting api keys", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	resp := &pb.GetAPIKeysResponse{
+		ApiKeys: APIKeysToProto(keys),
+	}
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// CreateAPIKey creates an API key and returns its secret once.
+func (s *Server) CreateAPIKey(ctx context.Context, req *pb.APIKeyForm) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpCreateAPIKey, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	key, err := s.Controllers.APIKeyController.CreateAPIKey(user, ProtoToAPIKeyForm(req))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error creating api key", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrInvalidAPIKeyForm) {
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &pb.CreatedAPIKey{
+		ApiKey: APIKeyToProto(&key.APIKey),
+		Key:    key.Key,
+	})
+}
+
+// UpdateAPIKey changes the name, owner, scopes and expiry of an API key.
+func (s *Server) UpdateAPIKey(ctx context.Context, req *pb.UpdateAPIKeyRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpUpdateAPIKey, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	key, err := s.Controllers.APIKeyController.UpdateAPIKey(req.GetId(), ProtoToAPIKeyForm(req.GetApiKey()))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error updating api key", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidAPIKeyForm):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controllers.ErrAPIKeyNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, APIKeyToProto(key))
+}
+
+// DeleteAPIKey revokes an API key.
+func (s *Server) DeleteAPIKey(ctx context.Context, req *pb.DeleteAPIKeyRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpDeleteAPIKey, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err := s.Controllers.APIKeyController.DeleteAPIKey(req.GetId()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error deleting api key", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrAPIKeyNotFound) {
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &emptypb.Empty{})
+}
+
+// GetCalendarTokens lists the calendar tokens of the current user.
+func (s *Server) GetCalendarTokens(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpGetCalendarTokens, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	tokens, err := s.Controllers.CalendarFeedController.GetCalendarTokens(user)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting calendar tokens", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	resp := &pb.GetCalendarTokensResponse{
+		CalendarTokens: CalendarTokensToProto(tokens),
+	}
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// CreateCalendarToken creates a calendar token of the current user and returns its secret once.
+func (s *Server) CreateCalendarToken(ctx context.Context, req *pb.CalendarTokenForm) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpCreateCalendarToken, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	token, err := s.Controllers.CalendarFeedController.CreateCalendarToken(user, entity.CalendarTokenForm{Name: req.GetName()})
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error creating calendar token", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrInvalidCalendarTokenForm) {
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &pb.CreatedCalendarToken{
+		CalendarToken: CalendarTokenToProto(&token.CalendarToken),
+		Token:         token.Token,
+	})
+}
+
+// DeleteCalendarToken revokes a calendar token of the current user.
+func (s *Server) DeleteCalendarToken(ctx context.Context, req *pb.DeleteCalendarTokenRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpDeleteCalendarToken, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err = s.Controllers.CalendarFeedController.DeleteCalendarToken(user, req.GetId()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error deleting calendar token", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrCalendarTokenNotFound) {
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, &emptypb.Empty{})
+}
+
+// CreateDepartment create new department.
+func (s *Server) CreateDepartment(ctx context.Context, req *pb.DepartmentForm) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpCreateDepartment, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	dept := ProtoToDepartment(req)
+
+	department, replayed, err := controllers.Idempotent(ctx, s.Controllers.IdempotencyController,
+		idempotentRequest(ctx, controllers.OpCreateDepartment, user, dept),
+		func() (*entity.Department, error) {
+			return s.Controllers.DepartmentController.CreateDepartment(*dept)
+		})
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error creating department", slog.String("error", err.Error()))
+		if resp, respErr := idempotencyErrorResponse(err); resp != nil {
+			return resp, respErr
+		}
+
+		if errors.Is(err, controllers.ErrInvalidDepartment) {
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	s.setReplayed(ctx, replayed)
+
+	resp := DepartmentToProto(department)
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// CreateEmployee create new employee.
+func (s *Server) CreateEmployee(ctx context.Context, req *pb.Employee) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpCreateEmployee, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	emp := ProtoToEmployee(req)
+
+	employee, replayed, err := controllers.Idempotent(ctx, s.Controllers.IdempotencyController,
+		idempotentRequest(ctx, controllers.OpCreateEmployee, user, emp),
+		func() (*entity.Employee, error) {
+			return s.Controllers.EmployeeController.CreateEmployee(*emp)
+		})
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error creating employee", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrWeakPassword) {
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if resp, respErr := idempotencyErrorResponse(err); resp != nil {
+			return resp, respErr
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	s.setReplayed(ctx, replayed)
+
+	pbEmployee := EmployeeToProto(employee)
+
+	return s.grpcResponse(ctx, pbEmployee)
+}
+
+// DeleteDepartment delete department.
+func (s *Server) DeleteDepartment(ctx context.Context, req *pb.DeleteDepartmentRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpDeleteDepartment, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err := s.Controllers.DepartmentController.DeleteDepartment(req.GetId(), req.GetExpectedVersion()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error deleting department", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrVersionRequired):
+			return &pb.ApiResponse{
+				Status: PreconditionRequiredStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			return &pb.ApiResponse{
+				Status: PreconditionFailedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, nil)
+}
+
+// DeleteEmployee terminate employee.
+func (s *Server) DeleteEmployee(ctx context.Context, req *pb.DeleteEmployeeRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpDeleteEmployee, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err := s.Controllers.EmployeeController.DeleteEmployee(req.GetId(), req.GetExpectedVersion()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error deleting employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, controllers.ErrVersionRequired):
+			return &pb.ApiResponse{
+				Status: PreconditionRequiredStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			return &pb.ApiResponse{
+				Status: PreconditionFailedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, nil)
+}
+
+// RestoreEmployee rehire terminated employee.
+func (s *Server) RestoreEmployee(ctx context.Context, req *pb.RestoreEmployeeRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpRestoreEmployee, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	employee, err := s.Controllers.EmployeeController.RestoreEmployee(req.GetId())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error restoring employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, controllers.ErrEmployeeNotFired), errors.Is(err, controllers.ErrVersionMismatch):
+			return &pb.ApiResponse{
+				Status: ConflictStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	pbEmployee := EmployeeToProto(employee)
+
+	return s.grpcResponse(ctx, pbEmployee)
+}
+
+// PurgeEmployee erase terminated employee.
+func (s *Server) PurgeEmployee(ctx context.Context, req *pb.PurgeEmployeeRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpPurgeEmployee, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	if err := s.Controllers.EmployeeController.PurgeEmployee(req.GetId(), req.GetExpectedVersion()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error purging employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, controllers.ErrEmployeeNotFired):
+			return &pb.ApiResponse{
+				Status: ConflictStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, controllers.ErrVersionRequired):
+			return &pb.ApiResponse{
+				Status: PreconditionRequiredStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			return &pb.ApiResponse{
+				Status: PreconditionFailedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, nil)
+}
+
+// GetDepartmentByID get department by id.
+func (s *Server) GetDepartmentByID(ctx context.Context, req *pb.GetDepartmentByIDRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetDepartmentByID, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	department, err := s.Controllers.DepartmentController.GetDepartmentByID(req.GetId())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting department", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	pbDepartment := DepartmentToProto(department)
+
+	return s.grpcResponse(ctx, pbDepartment)
+}
+
+// GetDepartments get all departments.
+func (s *Server) GetDepartments(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetDepartments, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	department, err := s.Controllers.DepartmentController.GetDepartments()
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting department", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
 			Status: ErrorStatus,
 			Type:   "error",
@@ -44,28 +1274,43 @@ func (s *Server) AuthLogin(ctx context.Context, req *pb.LoginRequest) (*pb.ApiRe
 		}, err
 	}
 
-	loginResponse := &pb.LoginResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+	pbEmployee := DepartmentsToProto(department)
+
+	resp := &pb.GetDepartmentsResponse{
+		Departments: pbEmployee,
 	}
 
-	return s.grpcResponse(ctx, loginResponse)
+	return s.grpcResponse(ctx, resp)
 }
 
-// AuthLogout make logout user.
-func (s *Server) AuthLogout(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// GetEmployees get all employees.
+func (s *Server) GetEmployees(ctx context.Context, req *pb.GetEmployeesRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetEmployees, nil); err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	authHeader, err := s.GetUserInfoFromMetadata(ctx)
+	page, err := s.Controllers.EmployeeController.GetEmployees(ProtoToGetEmployeesParams(req))
 	if err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error getting user info from metadata", slog.String("error", err.Error()))
+		s.deps.Logger.ErrorContext(ctx, "Error getting employees", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidEmployeesFilter),
+			errors.Is(err, controllers.ErrInvalidPageSize),
+			errors.Is(err, controllers.ErrInvalidSortField),
+			errors.Is(err, controllers.ErrInvalidSortOrder),
+			errors.Is(err, controllers.ErrInvalidPageToken):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return &pb.ApiResponse{
 			Status: ErrorStatus,
 			Type:   "error",
@@ -73,18 +1318,50 @@ func (s *Server) AuthLogout(ctx context.Context, _ *emptypb.Empty) (*pb.ApiRespo
 		}, err
 	}
 
-	redisCtx := context.Background()
-	if err = s.deps.Redis.Del(redisCtx, "access_token:"+authHeader).Err(); err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error deleting access token from Redis", slog.String("error", err.Error()))
+	resp := &pb.GetEmployeesResponse{
+		Employees:     EmployeesToProto(page.Employees),
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// SearchEmployees search employees by names, contacts and personal number.
+func (s *Server) SearchEmployees(ctx context.Context, req *pb.SearchEmployeesRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpSearchEmployees, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	if err = s.deps.Redis.Del(redisCtx, "refresh_token:*").Err(); err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error deleting refresh tokens from Redis", slog.String("error", err.Error()))
+	params := &entity.SearchEmployeesParams{
+		Q:        req.GetQ(),
+		Translit: req.Translit,
+		Status:   req.Status,
+	}
+
+	if req.Limit != nil {
+		limit := int(req.GetLimit())
+		params.Limit = &limit
+	}
+
+	employees, err := s.Controllers.EmployeeController.SearchEmployees(params)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error searching employees", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrEmptySearchQuery), errors.Is(err, controllers.ErrInvalidPageSize):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return &pb.ApiResponse{
 			Status: ErrorStatus,
 			Type:   "error",
@@ -92,25 +1369,28 @@ func (s *Server) AuthLogout(ctx context.Context, _ *emptypb.Empty) (*pb.ApiRespo
 		}, err
 	}
 
-	return s.grpcResponse(ctx, nil)
+	resp := &pb.SearchEmployeesResponse{
+		Employees: EmployeesToProto(employees),
+	}
+
+	return s.grpcResponse(ctx, resp)
 }
 
-// CreateDepartment create new department.
-func (s *Server) CreateDepartment(ctx context.Context, req *pb.DepartmentForm) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// GetEmployeesByID get employee by id.
+func (s *Server) GetEmployeesByID(ctx context.Context, req *pb.GetEmployeeByIDRequest) (*pb.ApiResponse, error) {
+	id := req.GetId()
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetEmployeeByID, &id); err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	dept := ProtoToDepartment(req)
-
-	department, err := s.Controllers.DepartmentController.CreateDepartment(*dept)
+	employee, err := s.Controllers.EmployeeController.GetEmployeeByID(id)
 	if err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error creating department", slog.String("error", err.Error()))
+		s.deps.Logger.ErrorContext(ctx, "Error getting department", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
 			Status: ErrorStatus,
 			Type:   "error",
@@ -118,239 +1398,484 @@ func (s *Server) CreateDepartment(ctx context.Context, req *pb.DepartmentForm) (
 		}, err
 	}
 
-	resp := DepartmentToProto(department)
+	pbEmployee := EmployeeToProto(employee)
 
-	return s.grpcResponse(ctx, resp)
+	return s.grpcResponse(ctx, pbEmployee)
 }
 
-// CreateEmployee create new employee.
-func (s *Server) CreateEmployee(ctx context.Context, req *pb.Employee) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// RequestVacation submit vacation request of employee.
+func (s *Server) RequestVacation(ctx context.Context, req *pb.RequestVacationRequest) (*pb.ApiResponse, error) {
+	id := req.GetId()
+	if _, err := s.checkAuthUser(ctx, controllers.OpRequestVacation, &id); err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	emp := ProtoToEmployee(req)
+	request, err := s.Controllers.VacationController.SubmitVacationRequest(id, ProtoToVacationRequestForm(req.GetVacation()))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error submitting vacation request", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrEmployeeNotFound) {
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		}
+
+		return vacationErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, VacationRequestToProto(request))
+}
+
+// GetLeaveBalance get leave balances of employee.
+func (s *Server) GetLeaveBalance(ctx context.Context, req *pb.GetLeaveBalanceRequest) (*pb.ApiResponse, error) {
+	id := req.GetId()
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetLeaveBalance, &id); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	var asOf *time.Time
+	if req.GetAsOf() != nil {
+		date := req.GetAsOf().AsTime()
+		asOf = &date
+	}
 
-	employee, err := s.Controllers.EmployeeController.CreateEmployee(*emp)
+	balances, err := s.Controllers.LeaveController.GetLeaveBalance(id, asOf)
 	if err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error creating employee", slog.String("error", err.Error()))
+		s.deps.Logger.ErrorContext(ctx, "Error getting leave balance", slog.String("error", err.Error()))
+		return leaveErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, LeaveBalancesToProto(balances))
+}
+
+// GetLeaveLedger get leave ledger entries of employee.
+func (s *Server) GetLeaveLedger(ctx context.Context, req *pb.GetLeaveLedgerRequest) (*pb.ApiResponse, error) {
+	id := req.GetId()
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetLeaveLedger, &id); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	pbEmployee := EmployeeToProto(employee)
+	entries, err := s.Controllers.LeaveController.GetLeaveLedger(id, ProtoToLeaveLedgerParams(req))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting leave ledger", slog.String("error", err.Error()))
+		return leaveErrorResponse(err)
+	}
 
-	return s.grpcResponse(ctx, pbEmployee)
+	resp := &pb.GetLeaveLedgerResponse{
+		Entries: LeaveHistoryToProto(entries),
+	}
+
+	return s.grpcResponse(ctx, resp)
 }
 
-// DeleteDepartment delete department.
-func (s *Server) DeleteDepartment(ctx context.Context, req *pb.DeleteDepartmentRequest) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// AdjustLeave post correction of leave balance of employee.
+func (s *Server) AdjustLeave(ctx context.Context, req *pb.AdjustLeaveRequest) (*pb.ApiResponse, error) {
+	id := req.GetId()
+	user, err := s.checkAuthUser(ctx, controllers.OpAdjustLeave, &id)
+	if err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	if err := s.Controllers.DepartmentController.DeleteDepartment(req.GetId()); err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error deleting department", slog.String("error", err.Error()))
+	entry, err := s.Controllers.LeaveController.AdjustLeave(user, id, ProtoToLeaveAdjustment(req.GetAdjustment()))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error adjusting leave", slog.String("error", err.Error()))
+		return leaveErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, LeaveEntryToProto(entry))
+}
+
+// RegisterSickLeave record sick leave of employee.
+func (s *Server) RegisterSickLeave(ctx context.Context, req *pb.RegisterSickLeaveRequest) (*pb.ApiResponse, error) {
+	id := req.GetId()
+	user, err := s.checkAuthUser(ctx, controllers.OpRegisterSickLeave, &id)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	return s.grpcResponse(ctx, nil)
+	leave, err := s.Controllers.SickLeaveController.RegisterSickLeave(user, id, ProtoToSickLeaveForm(req.GetSickLeave()))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error registering sick leave", slog.String("error", err.Error()))
+		return sickLeaveErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, SickLeaveToProto(leave))
 }
 
-// DeleteEmployee delete employee.
-func (s *Server) DeleteEmployee(ctx context.Context, req *pb.DeleteEmployeeRequest) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// GetSickLeaves get sick leaves visible to user.
+func (s *Server) GetSickLeaves(ctx context.Context, req *pb.GetSickLeavesRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpGetSickLeaves, nil)
+	if err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	if err := s.Controllers.EmployeeController.DeleteEmployee(req.GetId()); err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error deleting employee", slog.String("error", err.Error()))
+	leaves, err := s.Controllers.SickLeaveController.GetSickLeaves(user, ProtoToSickLeavesParams(req))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting sick leaves", slog.String("error", err.Error()))
+		return sickLeaveErrorResponse(err)
+	}
+
+	resp := &pb.GetSickLeavesResponse{
+		SickLeaves: SickLeavesToProto(leaves),
+	}
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// CloseSickLeave set last day of open sick leave.
+func (s *Server) CloseSickLeave(ctx context.Context, req *pb.CloseSickLeaveRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpCloseSickLeave, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	return s.grpcResponse(ctx, nil)
+	leave, err := s.Controllers.SickLeaveController.CloseSickLeave(user, req.GetId(), ProtoToSickLeaveClose(req))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error closing sick leave", slog.String("error", err.Error()))
+		return sickLeaveErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, SickLeaveToProto(leave))
 }
 
-// GetDepartmentByID get department by id.
-func (s *Server) GetDepartmentByID(ctx context.Context, req *pb.GetDepartmentByIDRequest) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// CountWorkingDays count working days of period by calendar of employee.
+func (s *Server) CountWorkingDays(ctx context.Context, req *pb.CountWorkingDaysRequest) (*pb.ApiResponse, error) {
+	id := req.GetId()
+	if _, err := s.checkAuthUser(ctx, controllers.OpCountWorkingDays, &id); err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	department, err := s.Controllers.DepartmentController.GetDepartmentByID(req.GetId())
+	var from, to time.Time
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	days, err := s.Controllers.CalendarController.CountWorkingDays(from, to, id)
 	if err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error getting department", slog.String("error", err.Error()))
+		s.deps.Logger.ErrorContext(ctx, "Error counting working days", slog.String("error", err.Error()))
+		return calendarErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, WorkingDaysToProto(days))
+}
+
+// GetCalendars get working-day calendars.
+func (s *Server) GetCalendars(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetCalendars, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	pbDepartment := DepartmentToProto(department)
+	calendars, err := s.Controllers.CalendarController.GetCalendars()
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting calendars", slog.String("error", err.Error()))
+		return calendarErrorResponse(err)
+	}
 
-	return s.grpcResponse(ctx, pbDepartment)
+	resp := &pb.GetCalendarsResponse{
+		Calendars: CalendarsToProto(calendars),
+	}
+
+	return s.grpcResponse(ctx, resp)
 }
 
-// GetDepartments get all departments.
-func (s *Server) GetDepartments(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// CreateCalendar create empty working-day calendar.
+func (s *Server) CreateCalendar(ctx context.Context, req *pb.CalendarForm) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpCreateCalendar, nil); err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	department, err := s.Controllers.DepartmentController.GetDepartments()
+	calendar, err := s.Controllers.CalendarController.CreateCalendar(ProtoToCalendarForm(req))
 	if err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error getting department", slog.String("error", err.Error()))
+		s.deps.Logger.ErrorContext(ctx, "Error creating calendar", slog.String("error", err.Error()))
+		return calendarErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, CalendarToProto(calendar))
+}
+
+// DeleteCalendar delete calendar with its days.
+func (s *Server) DeleteCalendar(ctx context.Context, req *pb.DeleteCalendarRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpDeleteCalendar, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	pbEmployee := DepartmentsToProto(department)
+	if err := s.Controllers.CalendarController.DeleteCalendar(req.GetId()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error deleting calendar", slog.String("error", err.Error()))
+		return calendarErrorResponse(err)
+	}
 
-	resp := &pb.GetDepartmentsResponse{
-		Departments: pbEmployee,
+	return s.grpcResponse(ctx, nil)
+}
+
+// GetCalendarDays get holidays and transferred working days of calendar.
+func (s *Server) GetCalendarDays(ctx context.Context, req *pb.GetCalendarDaysRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpGetCalendarDays, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
+		return &pb.ApiResponse{
+			Status: authErrorStatus(err),
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	days, err := s.Controllers.CalendarController.GetCalendarDays(req.GetId(), ProtoToCalendarDaysParams(req))
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error getting calendar days", slog.String("error", err.Error()))
+		return calendarErrorResponse(err)
+	}
+
+	resp := &pb.GetCalendarDaysResponse{
+		Days: CalendarDaysToProto(days),
 	}
 
 	return s.grpcResponse(ctx, resp)
 }
 
-// GetEmployees get all employees.
-func (s *Server) GetEmployees(ctx context.Context, _ *pb.GetEmployeesRequest) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// ImportCalendarDays add days of .ics or CSV file to calendar.
+func (s *Server) ImportCalendarDays(ctx context.Context, req *pb.ImportCalendarDaysRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpImportCalendarDays, nil); err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	employees, err := s.Controllers.EmployeeController.GetEmployees(nil)
+	result, err := s.Controllers.CalendarController.ImportCalendarDays(req.GetId(), req.GetFormat(), req.GetContent())
 	if err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error getting department", slog.String("error", err.Error()))
+		s.deps.Logger.ErrorContext(ctx, "Error importing calendar days", slog.String("error", err.Error()))
+		return calendarErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, &pb.CalendarImport{
+		CalendarId: result.CalendarID,
+		Imported:   result.Imported,
+	})
+}
+
+// DeleteCalendarDay remove day from calendar.
+func (s *Server) DeleteCalendarDay(ctx context.Context, req *pb.DeleteCalendarDayRequest) (*pb.ApiResponse, error) {
+	if _, err := s.checkAuthUser(ctx, controllers.OpDeleteCalendarDay, nil); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	pbEmployee := EmployeesToProto(employees)
+	if req.GetDate() == nil {
+		return calendarErrorResponse(fmt.Errorf("%w: date is required", controllers.ErrInvalidCalendar))
+	}
 
-	resp := &pb.GetEmployeesResponse{
-		Employees: pbEmployee,
+	if err := s.Controllers.CalendarController.DeleteCalendarDay(req.GetId(), req.GetDate().AsTime()); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error deleting calendar day", slog.String("error", err.Error()))
+		return calendarErrorResponse(err)
 	}
 
-	return s.grpcResponse(ctx, resp)
+	return s.grpcResponse(ctx, nil)
 }
 
-// GetEmployeesByID get employee by id.
-func (s *Server) GetEmployeesByID(ctx context.Context, req *pb.GetEmployeeByIDRequest) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// GetVacationRequests get vacation requests visible to user.
+func (s *Server) GetVacationRequests(ctx context.Context, req *pb.GetVacationRequestsRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpGetVacationRequests, nil)
+	if err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	employee, err := s.Controllers.EmployeeController.GetEmployeeByID(req.GetId())
+	requests, err := s.Controllers.VacationController.GetVacationRequests(user, &entity.GetVacationRequestsParams{
+		Status:     req.Status,
+		EmployeeID: req.EmployeeId,
+	})
 	if err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error getting department", slog.String("error", err.Error()))
+		s.deps.Logger.ErrorContext(ctx, "Error getting vacation requests", slog.String("error", err.Error()))
+		return vacationErrorResponse(err)
+	}
+
+	resp := &pb.GetVacationRequestsResponse{
+		Requests: VacationRequestsToProto(requests),
+	}
+
+	return s.grpcResponse(ctx, resp)
+}
+
+// ApproveVacationRequest approve vacation request at its current stage.
+func (s *Server) ApproveVacationRequest(ctx context.Context, req *pb.ApproveVacationRequestRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpApproveVacationRequest, nil)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	pbEmployee := EmployeeToProto(employee)
+	request, err := s.Controllers.VacationController.ApproveVacationRequest(user, req.GetId())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error approving vacation request", slog.String("error", err.Error()))
+		return vacationErrorResponse(err)
+	}
 
-	return s.grpcResponse(ctx, pbEmployee)
+	return s.grpcResponse(ctx, VacationRequestToProto(request))
 }
 
-// RequestVacation make request to vacation.
-func (s *Server) RequestVacation(ctx context.Context, req *pb.RequestVacationRequest) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+// RejectVacationRequest reject vacation request at its current stage.
+func (s *Server) RejectVacationRequest(ctx context.Context, req *pb.RejectVacationRequestRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpRejectVacationRequest, nil)
+	if err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	vacation, err := s.Controllers.EmployeeController.RequestVacation(req.GetId(), req.GetVacation().GetDays())
+	request, err := s.Controllers.VacationController.RejectVacationRequest(user, req.GetId(), entity.VacationDecision{Reason: req.Reason})
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error rejecting vacation request", slog.String("error", err.Error()))
+		return vacationErrorResponse(err)
+	}
+
+	return s.grpcResponse(ctx, VacationRequestToProto(request))
+}
+
+// CancelVacationRequest withdraw vacation request.
+func (s *Server) CancelVacationRequest(ctx context.Context, req *pb.CancelVacationRequestRequest) (*pb.ApiResponse, error) {
+	user, err := s.checkAuthUser(ctx, controllers.OpCancelVacationRequest, nil)
 	if err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Error updating employee", slog.String("error", err.Error()))
+		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: ErrorStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
-	pbEmployee := EmployeeToProto(vacation)
+	request, err := s.Controllers.VacationController.CancelVacationRequest(user, req.GetId())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error cancelling vacation request", slog.String("error", err.Error()))
+		return vacationErrorResponse(err)
+	}
 
-	return s.grpcResponse(ctx, pbEmployee)
+	return s.grpcResponse(ctx, VacationRequestToProto(request))
 }
 
 // UpdateDepartment update department.
 func (s *Server) UpdateDepartment(ctx context.Context, req *pb.UpdateDepartmentRequest) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+	if _, err := s.checkAuthUser(ctx, controllers.OpUpdateDepartment, nil); err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
+	if len(req.GetUpdateMask().GetPaths()) > 0 {
+		return s.patchDepartment(ctx, req)
+	}
+
 	enDep := ProtoToDepartment(req.GetDepartment())
 
-	department, err := s.Controllers.DepartmentController.UpdateDepartment(*enDep, req.GetId())
+	department, err := s.Controllers.DepartmentController.UpdateDepartment(*enDep, req.GetId(), req.GetExpectedVersion())
 	if err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error updating department", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidDepartment):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controllers.ErrDepartmentNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, controllers.ErrVersionRequired):
+			return &pb.ApiResponse{
+				Status: PreconditionRequiredStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			return &pb.ApiResponse{
+				Status: PreconditionFailedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
 		return &pb.ApiResponse{
 			Status: ErrorStatus,
 			Type:   "error",
@@ -363,22 +1888,117 @@ func (s *Server) UpdateDepartment(ctx context.Context, req *pb.UpdateDepartmentR
 	return s.grpcResponse(ctx, pbDepartment)
 }
 
+// patchDepartment update the update_mask fields of the department.
+func (s *Server) patchDepartment(ctx context.Context, req *pb.UpdateDepartmentRequest) (*pb.ApiResponse, error) {
+	department, err := s.Controllers.DepartmentController.PatchDepartment(req.GetId(), ProtoToDepartmentPatch(req), req.GetExpectedVersion())
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error patching department", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidPatch):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controllers.ErrDepartmentNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, controllers.ErrVersionRequired):
+			return &pb.ApiResponse{
+				Status: PreconditionRequiredStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			return &pb.ApiResponse{
+				Status: PreconditionFailedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, DepartmentToProto(department))
+}
+
 // UpdateEmployee update employee.
 func (s *Server) UpdateEmployee(ctx context.Context, req *pb.UpdateEmployeeRequest) (*pb.ApiResponse, error) {
-	if err := s.checkAuthUser(ctx); err != nil {
+	id := req.GetId()
+	user, err := s.checkAuthUser(ctx, controllers.OpUpdateEmployee, &id)
+	if err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error checking user authorization", slog.String("error", err.Error()))
 		return &pb.ApiResponse{
-			Status: UnauthorizedStatus,
+			Status: authErrorStatus(err),
 			Type:   "error",
 			Data:   nil,
 		}, err
 	}
 
+	if len(req.GetUpdateMask().GetPaths()) > 0 {
+		return s.patchEmployee(ctx, user, req)
+	}
+
 	emEntity := ProtoToEmployee(req.GetEmployee())
 
-	updateEmp, updErr := s.Controllers.EmployeeController.UpdateEmployee(req.GetId(), *emEntity)
+	var updateEmp *entity.Employee
+	var updErr error
+	if controllers.ManagesEmployee(user, id) {
+		updateEmp, updErr = s.Controllers.EmployeeController.UpdateEmployee(user, id, *emEntity, req.GetExpectedVersion())
+	} else {
+		updateEmp, updErr = s.Controllers.EmployeeController.UpdateOwnProfile(id, *emEntity, req.GetExpectedVersion())
+	}
 	if updErr != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error updating employee", slog.String("error", updErr.Error()))
+
+		switch {
+		case errors.Is(updErr, controllers.ErrInvalidEmployee):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, updErr.Error())
+		case errors.Is(updErr, controllers.ErrPermissionDenied):
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, updErr.Error())
+		case errors.Is(updErr, controllers.ErrEmployeeNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, updErr.Error())
+		case errors.Is(updErr, controllers.ErrEmployeeConflict):
+			return &pb.ApiResponse{
+				Status: ConflictStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.AlreadyExists, updErr.Error())
+		case errors.Is(updErr, controllers.ErrVersionRequired):
+			return &pb.ApiResponse{
+				Status: PreconditionRequiredStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, updErr.Error())
+		case errors.Is(updErr, controllers.ErrVersionMismatch):
+			return &pb.ApiResponse{
+				Status: PreconditionFailedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, updErr.Error())
+		}
+
 		return &pb.ApiResponse{
 			Status: ErrorStatus,
 			Type:   "error",
@@ -390,3 +2010,68 @@ func (s *Server) UpdateEmployee(ctx context.Context, req *pb.UpdateEmployeeReque
 
 	return s.grpcResponse(ctx, pbEmployee)
 }
+
+// patchEmployee update the update_mask fields of the employee.
+func (s *Server) patchEmployee(ctx context.Context, user *entity.Claims, req *pb.UpdateEmployeeRequest) (*pb.ApiResponse, error) {
+	id := req.GetId()
+	patch := ProtoToEmployeePatch(req)
+
+	var updateEmp *entity.Employee
+	var err error
+	if controllers.ManagesEmployee(user, id) {
+		updateEmp, err = s.Controllers.EmployeeController.PatchEmployee(user, id, patch, req.GetExpectedVersion())
+	} else {
+		updateEmp, err = s.Controllers.EmployeeController.PatchOwnProfile(id, patch, req.GetExpectedVersion())
+	}
+
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error patching employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidPatch):
+			return &pb.ApiResponse{
+				Status: BadRequestStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controllers.ErrPermissionDenied):
+			return &pb.ApiResponse{
+				Status: ForbiddenStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			return &pb.ApiResponse{
+				Status: NotFoundStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, controllers.ErrEmployeeConflict):
+			return &pb.ApiResponse{
+				Status: ConflictStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, controllers.ErrVersionRequired):
+			return &pb.ApiResponse{
+				Status: PreconditionRequiredStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			return &pb.ApiResponse{
+				Status: PreconditionFailedStatus,
+				Type:   "error",
+				Data:   nil,
+			}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+
+	return s.grpcResponse(ctx, EmployeeToProto(updateEmp))
+}
@@ -4,10 +4,17 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net"
+	"strings"
 
 	pb "github.com/adamanr/employes_service/internal/api/grpc/proto"
+	"github.com/adamanr/employes_service/internal/controllers"
 	"github.com/adamanr/employes_service/internal/entity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -15,8 +22,17 @@ import (
 
 const (
 	SuccessStatus      = 200
+	BadRequestStatus   = 400
 	ErrorStatus        = 500
 	UnauthorizedStatus = 401
+	ForbiddenStatus    = 403
+	NotFoundStatus     = 404
+
+	ConflictStatus             = 409
+	PreconditionFailedStatus   = 412
+	UnprocessableStatus        = 422
+	PreconditionRequiredStatus = 428
+	TooManyRequestsStatus      = 429
 )
 
 // ProtoToLoginRequest convert proto message LoginRequest to entity LoginRequest.
@@ -91,16 +107,6 @@ func ProtoToEmployee(proto *pb.Employee) *entity.Employee {
 		employee.ManagerID = &managerID
 	}
 
-	if proto.GetVacationDays() > 0 {
-		vacationDays := proto.GetVacationDays()
-		employee.VacationDays = &vacationDays
-	}
-
-	if proto.GetSickDays() > 0 {
-		sickDays := proto.GetSickDays()
-		employee.SickDays = &sickDays
-	}
-
 	employee.MiddleName = proto.MiddleName
 	employee.Phone = proto.Phone
 	employee.PersonalNumber = proto.PersonalNumber
@@ -202,9 +208,30 @@ func EmployeeToProto(employee *entity.Employee) *pb.Employee {
 		proto.UpdatedAt = timestamppb.New(*employee.UpdatedAt)
 	}
 
+	proto.Version = employee.Version
+
 	return proto
 }
 
+// ProtoToEmployeePatch convert the employee and update mask of proto message UpdateEmployeeRequest to entity EmployeePatch.
+func ProtoToEmployeePatch(req *pb.UpdateEmployeeRequest) entity.EmployeePatch {
+	proto := req.GetEmployee()
+	if proto == nil {
+		proto = &pb.Employee{}
+	}
+
+	employee := ProtoToEmployee(proto)
+
+	// Zero is a valid value of the masked fields, so it is not treated as unset.
+	employee.DepartmentID = proto.DepartmentId
+	employee.ManagerID = proto.ManagerId
+
+	return entity.EmployeePatch{
+		Fields:   req.GetUpdateMask().GetPaths(),
+		Employee: *employee,
+	}
+}
+
 // ProtoToDepartment covert proto message DepartmentForm to entity Department.
 func ProtoToDepartment(proto *pb.DepartmentForm) *entity.Department {
 	if proto == nil {
@@ -229,9 +256,37 @@ func ProtoToDepartment(proto *pb.DepartmentForm) *entity.Department {
 		department.HeadID = &headID
 	}
 
+	if proto.CalendarId != nil {
+		calendarID := proto.GetCalendarId()
+		department.CalendarID = &calendarID
+	}
+
+	if proto.MinOnDuty != nil {
+		minOnDuty := proto.GetMinOnDuty()
+		department.MinOnDuty = &minOnDuty
+	}
+
+	if proto.MinOnDutyPercent != nil {
+		minPercent := proto.GetMinOnDutyPercent()
+		department.MinOnDutyPercent = &minPercent
+	}
+
 	return department
 }
 
+// ProtoToDepartmentPatch convert the department and update mask of proto message UpdateDepartmentRequest to entity DepartmentPatch.
+func ProtoToDepartmentPatch(req *pb.UpdateDepartmentRequest) entity.DepartmentPatch {
+	proto := req.GetDepartment()
+	if proto == nil {
+		proto = &pb.DepartmentForm{}
+	}
+
+	return entity.DepartmentPatch{
+		Fields:     req.GetUpdateMask().GetPaths(),
+		Department: *ProtoToDepartment(proto),
+	}
+}
+
 // DepartmentsToProto convert entity list Department to proto list message Department.
 func DepartmentsToProto(departments []entity.Department) []*pb.Department {
 	pbDepartments := make([]*pb.Department, 0, len(departments))
@@ -249,8 +304,9 @@ func DepartmentToProto(department *entity.Department) *pb.Department {
 	}
 
 	proto := &pb.Department{
-		Id:   department.ID,
-		Name: department.Name,
+		Id:      department.ID,
+		Name:    department.Name,
+		Version: department.Version,
 	}
 
 	if department.Description != "" {
@@ -265,6 +321,18 @@ func DepartmentToProto(department *entity.Department) *pb.Department {
 		proto.HeadId = department.HeadID
 	}
 
+	if department.CalendarID != nil {
+		proto.CalendarId = department.CalendarID
+	}
+
+	if department.MinOnDuty != nil {
+		proto.MinOnDuty = department.MinOnDuty
+	}
+
+	if department.MinOnDutyPercent != nil {
+		proto.MinOnDutyPercent = department.MinOnDutyPercent
+	}
+
 	if !department.CreatedAt.IsZero() {
 		proto.CreatedAt = timestamppb.New(department.CreatedAt)
 	}
@@ -276,6 +344,552 @@ func DepartmentToProto(department *entity.Department) *pb.Department {
 	return proto
 }
 
+// SessionsToProto convert entity list SessionInfo to proto list message SessionInfo.
+func SessionsToProto(sessions []entity.SessionInfo) []*pb.SessionInfo {
+	pbSessions := make([]*pb.SessionInfo, 0, len(sessions))
+	for _, v := range sessions {
+		pbSessions = append(pbSessions, &pb.SessionInfo{
+			Id:          v.ID,
+			CreatedAt:   timestamppb.New(v.CreatedAt),
+			RefreshedAt: timestamppb.New(v.RefreshedAt),
+			Current:     v.Current,
+		})
+	}
+
+	return pbSessions
+}
+
+// LockoutsToProto convert entity lockouts to proto lockouts.
+func LockoutsToProto(lockouts []entity.Lockout) []*pb.Lockout {
+	pbLockouts := make([]*pb.Lockout, 0, len(lockouts))
+	for _, v := range lockouts {
+		pbLockouts = append(pbLockouts, &pb.Lockout{
+			Scope:     v.Scope,
+			Value:     v.Value,
+			Failures:  v.Failures,
+			ExpiresAt: timestamppb.New(v.ExpiresAt),
+		})
+	}
+
+	return pbLockouts
+}
+
+// APIKeysToProto convert entity list APIKey to proto list message APIKey.
+func APIKeysToProto(keys []entity.APIKey) []*pb.APIKey {
+	pbKeys := make([]*pb.APIKey, 0, len(keys))
+	for _, v := range keys {
+		pbKeys = append(pbKeys, APIKeyToProto(&v))
+	}
+
+	return pbKeys
+}
+
+// APIKeyToProto convert entity APIKey to proto message APIKey.
+func APIKeyToProto(key *entity.APIKey) *pb.APIKey {
+	if key == nil {
+		return nil
+	}
+
+	proto := &pb.APIKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Owner:     key.Owner,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedBy: key.CreatedBy,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+
+	if key.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+
+	if key.LastUsedAt != nil {
+		proto.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+
+	return proto
+}
+
+// CalendarTokensToProto convert entity list CalendarToken to proto list message CalendarToken.
+func CalendarTokensToProto(tokens []entity.CalendarToken) []*pb.CalendarToken {
+	pbTokens := make([]*pb.CalendarToken, 0, len(tokens))
+	for _, v := range tokens {
+		pbTokens = append(pbTokens, CalendarTokenToProto(&v))
+	}
+
+	return pbTokens
+}
+
+// CalendarTokenToProto convert entity CalendarToken to proto message CalendarToken.
+func CalendarTokenToProto(token *entity.CalendarToken) *pb.CalendarToken {
+	if token == nil {
+		return nil
+	}
+
+	proto := &pb.CalendarToken{
+		Id:         token.ID,
+		EmployeeId: token.EmployeeID,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		CreatedAt:  timestamppb.New(token.CreatedAt),
+	}
+
+	if token.LastUsedAt != nil {
+		proto.LastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+
+	return proto
+}
+
+// ProtoToVacationRequestForm convert proto VacationRequestForm to entity.
+func ProtoToVacationRequestForm(proto *pb.VacationRequestForm) entity.VacationRequestForm {
+	form := entity.VacationRequestForm{
+		Type:    proto.GetType(),
+		Comment: proto.Comment,
+	}
+
+	if proto.GetStartDate() != nil {
+		form.StartDate = proto.GetStartDate().AsTime()
+	}
+
+	if proto.GetEndDate() != nil {
+		form.EndDate = proto.GetEndDate().AsTime()
+	}
+
+	return form
+}
+
+// VacationRequestToProto convert entity VacationRequest to proto.
+func VacationRequestToProto(request *entity.VacationRequest) *pb.VacationRequest {
+	if request == nil {
+		return nil
+	}
+
+	return &pb.VacationRequest{
+		Id:               request.ID,
+		EmployeeId:       request.EmployeeID,
+		Type:             request.Type,
+		StartDate:        timestamppb.New(request.StartDate),
+		EndDate:          timestamppb.New(request.EndDate),
+		Days:             request.Days,
+		Status:           request.Status,
+		Comment:          request.Comment,
+		ManagerId:        request.ManagerID,
+		ManagerDecidedBy: request.ManagerDecidedBy,
+		HrDecidedBy:      request.HRDecidedBy,
+		RejectReason:     request.RejectReason,
+		CreatedAt:        timestamppb.New(request.CreatedAt),
+		UpdatedAt:        timestamppb.New(request.UpdatedAt),
+	}
+}
+
+// VacationRequestsToProto convert entity VacationRequest slice to proto messages.
+func VacationRequestsToProto(requests []entity.VacationRequest) []*pb.VacationRequest {
+	protoRequests := make([]*pb.VacationRequest, 0, len(requests))
+	for i := range requests {
+		protoRequests = append(protoRequests, VacationRequestToProto(&requests[i]))
+	}
+
+	return protoRequests
+}
+
+// ProtoToLeaveAdjustment convert proto message LeaveAdjustment to entity LeaveAdjustment.
+func ProtoToLeaveAdjustment(proto *pb.LeaveAdjustment) entity.LeaveAdjustment {
+	adjustment := entity.LeaveAdjustment{
+		LeaveType: proto.GetLeaveType(),
+		Days:      proto.GetDays(),
+		Comment:   proto.GetComment(),
+	}
+
+	if proto.GetEffectiveDate() != nil {
+		effectiveDate := proto.GetEffectiveDate().AsTime()
+		adjustment.EffectiveDate = &effectiveDate
+	}
+
+	return adjustment
+}
+
+// ProtoToLeaveLedgerParams convert proto message GetLeaveLedgerRequest to entity GetLeaveLedgerParams.
+func ProtoToLeaveLedgerParams(req *pb.GetLeaveLedgerRequest) *entity.GetLeaveLedgerParams {
+	params := &entity.GetLeaveLedgerParams{
+		LeaveType: req.LeaveType,
+	}
+
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		params.From = &from
+	}
+
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		params.To = &to
+	}
+
+	return params
+}
+
+// LeaveEntryToProto convert entity LeaveEntry to proto.
+func LeaveEntryToProto(entry *entity.LeaveEntry) *pb.LeaveEntry {
+	if entry == nil {
+		return nil
+	}
+
+	return &pb.LeaveEntry{
+		Id:                entry.ID,
+		EmployeeId:        entry.EmployeeID,
+		LeaveType:         entry.LeaveType,
+		Kind:              entry.Kind,
+		Days:              entry.Days,
+		EffectiveDate:     timestamppb.New(entry.EffectiveDate),
+		Period:            entry.Period,
+		VacationRequestId: entry.VacationRequestID,
+		Comment:           entry.Comment,
+		CreatedBy:         entry.CreatedBy,
+		CreatedAt:         timestamppb.New(entry.CreatedAt),
+	}
+}
+
+// ProtoToSickLeaveForm convert proto SickLeaveForm to entity.
+func ProtoToSickLeaveForm(proto *pb.SickLeaveForm) entity.SickLeaveForm {
+	form := entity.SickLeaveForm{
+		CertificateNumber: proto.CertificateNumber,
+		Comment:           proto.Comment,
+	}
+
+	if proto.GetStartDate() != nil {
+		form.StartDate = proto.GetStartDate().AsTime()
+	}
+
+	if proto.GetEndDate() != nil {
+		endDate := proto.GetEndDate().AsTime()
+		form.EndDate = &endDate
+	}
+
+	return form
+}
+
+// ProtoToSickLeaveClose convert proto CloseSickLeaveRequest to entity.
+func ProtoToSickLeaveClose(req *pb.CloseSickLeaveRequest) entity.SickLeaveClose {
+	closing := entity.SickLeaveClose{
+		CertificateNumber: req.CertificateNumber,
+	}
+
+	if req.GetEndDate() != nil {
+		closing.EndDate = req.GetEndDate().AsTime()
+	}
+
+	return closing
+}
+
+// ProtoToSickLeavesParams convert proto GetSickLeavesRequest to entity params.
+func ProtoToSickLeavesParams(req *pb.GetSickLeavesRequest) *entity.GetSickLeavesParams {
+	params := &entity.GetSickLeavesParams{
+		DepartmentID: req.DepartmentId,
+		EmployeeID:   req.EmployeeId,
+		Open:         req.Open,
+	}
+
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		params.From = &from
+	}
+
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		params.To = &to
+	}
+
+	return params
+}
+
+// SickLeaveToProto convert entity SickLeave to proto.
+func SickLeaveToProto(leave *entity.SickLeave) *pb.SickLeave {
+	if leave == nil {
+		return nil
+	}
+
+	protoLeave := &pb.SickLeave{
+		Id:                leave.ID,
+		EmployeeId:        leave.EmployeeID,
+		StartDate:         timestamppb.New(leave.StartDate),
+		Days:              leave.Days,
+		CertificateNumber: leave.CertificateNumber,
+		Comment:           leave.Comment,
+		CreatedBy:         leave.CreatedBy,
+		ClosedBy:          leave.ClosedBy,
+		CreatedAt:         timestamppb.New(leave.CreatedAt),
+		UpdatedAt:         timestamppb.New(leave.UpdatedAt),
+	}
+
+	if leave.EndDate != nil {
+		protoLeave.EndDate = timestamppb.New(*leave.EndDate)
+	}
+
+	return protoLeave
+}
+
+// SickLeavesToProto convert entity SickLeave slice to proto messages.
+func SickLeavesToProto(leaves []entity.SickLeave) []*pb.SickLeave {
+	protoLeaves := make([]*pb.SickLeave, 0, len(leaves))
+	for i := range leaves {
+		protoLeaves = append(protoLeaves, SickLeaveToProto(&leaves[i]))
+	}
+
+	return protoLeaves
+}
+
+// ProtoToCalendarForm convert proto CalendarForm to entity.
+func ProtoToCalendarForm(proto *pb.CalendarForm) entity.CalendarForm {
+	return entity.CalendarForm{
+		Name:        proto.GetName(),
+		Description: proto.Description,
+	}
+}
+
+// ProtoToCalendarDaysParams convert proto GetCalendarDaysRequest to entity params.
+func ProtoToCalendarDaysParams(req *pb.GetCalendarDaysRequest) *entity.GetCalendarDaysParams {
+	params := &entity.GetCalendarDaysParams{}
+
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		params.From = &from
+	}
+
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		params.To = &to
+	}
+
+	return params
+}
+
+// CalendarToProto convert entity Calendar to proto.
+func CalendarToProto(calendar *entity.Calendar) *pb.Calendar {
+	if calendar == nil {
+		return nil
+	}
+
+	return &pb.Calendar{
+		Id:          calendar.ID,
+		Name:        calendar.Name,
+		Description: calendar.Description,
+		CreatedAt:   timestamppb.New(calendar.CreatedAt),
+		UpdatedAt:   timestamppb.New(calendar.UpdatedAt),
+	}
+}
+
+// CalendarsToProto convert entity Calendar slice to proto messages.
+func CalendarsToProto(calendars []entity.Calendar) []*pb.Calendar {
+	protoCalendars := make([]*pb.Calendar, 0, len(calendars))
+	for i := range calendars {
+		protoCalendars = append(protoCalendars, CalendarToProto(&calendars[i]))
+	}
+
+	return protoCalendars
+}
+
+// CalendarDaysToProto convert entity CalendarDay slice to proto messages.
+func CalendarDaysToProto(days []entity.CalendarDay) []*pb.CalendarDay {
+	protoDays := make([]*pb.CalendarDay, 0, len(days))
+	for _, day := range days {
+		protoDays = append(protoDays, &pb.CalendarDay{
+			CalendarId: day.CalendarID,
+			Date:       timestamppb.New(day.Date),
+			Kind:       day.Kind,
+			Name:       day.Name,
+		})
+	}
+
+	return protoDays
+}
+
+// WorkingDaysToProto convert entity WorkingDays to proto.
+func WorkingDaysToProto(days *entity.WorkingDays) *pb.WorkingDays {
+	if days == nil {
+		return nil
+	}
+
+	return &pb.WorkingDays{
+		EmployeeId:   days.EmployeeID,
+		CalendarId:   days.CalendarID,
+		From:         timestamppb.New(days.From),
+		To:           timestamppb.New(days.To),
+		CalendarDays: days.CalendarDays,
+		WorkingDays:  days.WorkingDays,
+		Holidays:     days.Holidays,
+	}
+}
+
+// StaffingConflictToProto convert entity StaffingConflict to proto.
+func StaffingConflictToProto(conflict entity.StaffingConflict) *pb.StaffingConflict {
+	protoConflict := &pb.StaffingConflict{
+		DepartmentId: conflict.DepartmentID,
+		Headcount:    conflict.Headcount,
+		MinOnDuty:    conflict.MinOnDuty,
+		Days:         make([]*pb.StaffingConflictDay, 0, len(conflict.Days)),
+	}
+
+	for _, day := range conflict.Days {
+		protoDay := &pb.StaffingConflictDay{
+			Date:   timestamppb.New(day.Date),
+			OnDuty: day.OnDuty,
+			Absent: make([]*pb.StaffingAbsence, 0, len(day.Absent)),
+		}
+
+		for _, absence := range day.Absent {
+			protoDay.Absent = append(protoDay.Absent, &pb.StaffingAbsence{
+				EmployeeId: absence.EmployeeID,
+				FirstName:  absence.FirstName,
+				LastName:   absence.LastName,
+				Reason:     absence.Reason,
+				StartDate:  timestamppb.New(absence.StartDate),
+				EndDate:    timestamppb.New(absence.EndDate),
+			})
+		}
+
+		protoConflict.Days = append(protoConflict.Days, protoDay)
+	}
+
+	return protoConflict
+}
+
+// LeaveHistoryToProto convert entity LeaveHistoryEntry slice to proto messages.
+func LeaveHistoryToProto(entries []entity.LeaveHistoryEntry) []*pb.LeaveEntry {
+	protoEntries := make([]*pb.LeaveEntry, 0, len(entries))
+	for i := range entries {
+		protoEntry := LeaveEntryToProto(&entries[i].LeaveEntry)
+		protoEntry.Balance = entries[i].Balance
+		protoEntries = append(protoEntries, protoEntry)
+	}
+
+	return protoEntries
+}
+
+// LeaveBalancesToProto convert entity LeaveBalances to proto.
+func LeaveBalancesToProto(balances *entity.LeaveBalances) *pb.LeaveBalances {
+	protoBalances := make([]*pb.LeaveBalance, 0, len(balances.Balances))
+	for _, balance := range balances.Balances {
+		protoBalances = append(protoBalances, &pb.LeaveBalance{
+			LeaveType: balance.LeaveType,
+			Balance:   balance.Balance,
+			Accrued:   balance.Accrued,
+			Used:      balance.Used,
+			Adjusted:  balance.Adjusted,
+			Expired:   balance.Expired,
+		})
+	}
+
+	return &pb.LeaveBalances{
+		EmployeeId: balances.EmployeeID,
+		AsOf:       timestamppb.New(balances.AsOf),
+		Balances:   protoBalances,
+	}
+}
+
+// AuditEntriesToProto convert entity AuditEntry slice to proto messages.
+func AuditEntriesToProto(entries []entity.AuditEntry) []*pb.AuditEntry {
+	protoEntries := make([]*pb.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		protoEntries = append(protoEntries, &pb.AuditEntry{
+			Id:         entry.ID,
+			ActorId:    entry.ActorID,
+			EmployeeId: entry.EmployeeID,
+			Action:     entry.Action,
+			TargetId:   entry.TargetID,
+			Allowed:    entry.Allowed,
+			CreatedAt:  timestamppb.New(entry.CreatedAt),
+		})
+	}
+
+	return protoEntries
+}
+
+// ProtoToGetEmployeesParams convert proto message GetEmployeesRequest to entity GetEmployeesParams.
+func ProtoToGetEmployeesParams(req *pb.GetEmployeesRequest) *entity.GetEmployeesParams {
+	params := &entity.GetEmployeesParams{
+		Role:         req.Role,
+		DepartmentID: req.DepartmentId,
+		Status:       req.Status,
+		ManagerID:    req.ManagerId,
+		IsActive:     req.IsActive,
+		Position:     req.Position,
+		PageToken:    req.PageToken,
+		SortBy:       req.SortBy,
+		SortOrder:    req.SortOrder,
+		IncludeTotal: req.IncludeTotal,
+	}
+
+	if req.GetHiredFrom() != nil {
+		hiredFrom := req.GetHiredFrom().AsTime()
+		params.HiredFrom = &hiredFrom
+	}
+
+	if req.GetHiredTo() != nil {
+		hiredTo := req.GetHiredTo().AsTime()
+		params.HiredTo = &hiredTo
+	}
+
+	if req.BirthdayMonth != nil {
+		month := int(req.GetBirthdayMonth())
+		params.BirthdayMonth = &month
+	}
+
+	if req.Limit != nil {
+		limit := int(req.GetLimit())
+		params.Limit = &limit
+	}
+
+	return params
+}
+
+// ProtoToAPIKeyForm convert proto message APIKeyForm to entity APIKeyForm.
+func ProtoToAPIKeyForm(proto *pb.APIKeyForm) entity.APIKeyForm {
+	form := entity.APIKeyForm{
+		Name:   proto.GetName(),
+		Owner:  proto.GetOwner(),
+		Scopes: proto.GetScopes(),
+	}
+
+	if proto.GetExpiresAt() != nil {
+		expiresAt := proto.GetExpiresAt().AsTime()
+		form.ExpiresAt = &expiresAt
+	}
+
+	return form
+}
+
+// clientIP returns the address of the caller. Requests coming through the local
+// gRPC-Gateway carry the address the gateway got the request from as the last
+// x-forwarded-for entry, which is trusted only from a loopback peer. Entries
+// before it are honoured only behind the configured trusted proxies.
+func clientIP(ctx context.Context, trustedProxies []string) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if md, mdOk := metadata.FromIncomingContext(ctx); mdOk {
+			if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+				hops := strings.Split(strings.Join(forwarded, ","), ",")
+				gatewayPeer := strings.TrimSpace(hops[len(hops)-1])
+
+				if net.ParseIP(gatewayPeer) != nil {
+					return controllers.ClientIP(gatewayPeer, hops[:len(hops)-1], trustedProxies)
+				}
+			}
+		}
+	}
+
+	return host
+}
+
 // GetUserInfoFromMetadata get authorization from metadata.
 func (s *Server) GetUserInfoFromMetadata(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -293,31 +907,232 @@ func (s *Server) GetUserInfoFromMetadata(ctx context.Context) (string, error) {
 	s.deps.Logger.InfoContext(ctx, "Request metadata",
 		slog.Any("user-agent", userAgents),
 		slog.Any("x-forwarded-for", xForwardedFor),
-		slog.Any("authorization", authorization),
 	)
 
 	if len(authorization) > 0 {
-		s.deps.Logger.InfoContext(ctx, "Authorization", slog.Any("authorization", authorization))
 		return authorization[0], nil
 	}
 
 	return "", errors.New("missing authorization")
 }
 
-// checkAuthUser check authorization.
-func (s *Server) checkAuthUser(ctx context.Context) error {
+// checkAuthUser check authorization and that the user may perform the operation.
+func (s *Server) checkAuthUser(ctx context.Context, op controllers.Operation, targetID *uint64) (*entity.Claims, error) {
 	token, err := s.GetUserInfoFromMetadata(ctx)
 	if err != nil {
 		s.deps.Logger.ErrorContext(ctx, "Error getting user info from metadata", slog.String("error", err.Error()))
-		return err
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	user, err := s.Controllers.AuthController.CheckUserToken(token)
+	if err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Unauthorized request attempt", slog.String("error", err.Error()))
+		return nil, status.Error(codes.Unauthenticated, "error getting user from token")
+	}
+
+	if err = s.Controllers.AuthController.Authorize(user, op, targetID); err != nil {
+		if errors.Is(err, controllers.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return user, nil
+}
+
+// authErrorStatus return ApiResponse status for error returned by checkAuthUser.
+func authErrorStatus(err error) int64 {
+	if status.Code(err) == codes.PermissionDenied {
+		return ForbiddenStatus
+	}
+
+	return UnauthorizedStatus
+}
+
+// idempotentRequest describes a create retried with the idempotency-key metadata.
+func idempotentRequest(ctx context.Context, op controllers.Operation, user *entity.Claims, payload any) controllers.IdempotentRequest {
+	req := controllers.IdempotentRequest{
+		Operation: op,
+		Caller:    user,
+		Payload:   payload,
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get("idempotency-key"); len(keys) > 0 {
+			req.Key = keys[0]
+		}
+	}
+
+	return req
+}
+
+// setReplayed marks a response repeated for an idempotency key.
+func (s *Server) setReplayed(ctx context.Context, replayed bool) {
+	if !replayed {
+		return
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs("idempotent-replayed", "true")); err != nil {
+		s.deps.Logger.ErrorContext(ctx, "Error setting header", slog.String("error", err.Error()))
+	}
+}
+
+// idempotencyErrorResponse return ApiResponse for the idempotency key errors,
+// nil if err is not one of them.
+func idempotencyErrorResponse(err error) (*pb.ApiResponse, error) {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidIdempotencyKey):
+		return &pb.ApiResponse{
+			Status: BadRequestStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controllers.ErrIdempotencyInProgress):
+		return &pb.ApiResponse{
+			Status: ConflictStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, controllers.ErrIdempotencyKeyReused):
+		return &pb.ApiResponse{
+			Status: UnprocessableStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, nil
+	}
+}
+
+// vacationErrorResponse return ApiResponse for error returned by the vacation controller.
+func vacationErrorResponse(err error) (*pb.ApiResponse, error) {
+	var staffing *controllers.StaffingConflictError
+
+	switch {
+	case errors.As(err, &staffing):
+		// The conflict report goes to the status details, the client gets no response with an error.
+		st, detailsErr := status.New(codes.FailedPrecondition, err.Error()).WithDetails(StaffingConflictToProto(staffing.Conflict))
+		if detailsErr != nil {
+			st = status.New(codes.FailedPrecondition, err.Error())
+		}
+
+		return &pb.ApiResponse{
+			Status: ConflictStatus,
+			Type:   "error",
+			Data:   nil,
+		}, st.Err()
+	case errors.Is(err, controllers.ErrInvalidVacationRequest):
+		return &pb.ApiResponse{
+			Status: BadRequestStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controllers.ErrNotVacationApprover):
+		return &pb.ApiResponse{
+			Status: ForbiddenStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, controllers.ErrVacationRequestNotFound):
+		return &pb.ApiResponse{
+			Status: NotFoundStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, controllers.ErrVacationOverlap), errors.Is(err, controllers.ErrVacationRequestNotPending),
+		errors.Is(err, controllers.ErrVacationStarted):
+		return &pb.ApiResponse{
+			Status: ConflictStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, controllers.ErrInsufficientVacationBalance):
+		return &pb.ApiResponse{
+			Status: UnprocessableStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
 	}
+}
 
-	if _, err = s.Controllers.AuthController.CheckUserToken(token); err != nil {
-		s.deps.Logger.ErrorContext(ctx, "Unauthorized vacation request attempt", slog.String("error", err.Error()))
-		return errors.New("error getting user from token")
+// sickLeaveErrorResponse return ApiResponse for error returned by the sick leave controller.
+func sickLeaveErrorResponse(err error) (*pb.ApiResponse, error) {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidSickLeave):
+		return &pb.ApiResponse{
+			Status: BadRequestStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controllers.ErrPermissionDenied):
+		return &pb.ApiResponse{
+			Status: ForbiddenStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, controllers.ErrEmployeeNotFound), errors.Is(err, controllers.ErrSickLeaveNotFound):
+		return &pb.ApiResponse{
+			Status: NotFoundStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, controllers.ErrSickLeaveOverlap), errors.Is(err, controllers.ErrSickLeaveClosed):
+		return &pb.ApiResponse{
+			Status: ConflictStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, controllers.ErrSickCertificateExists):
+		return &pb.ApiResponse{
+			Status: ConflictStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
 	}
+}
 
-	return nil
+// calendarErrorResponse return ApiResponse for error returned by the calendar controller.
+func calendarErrorResponse(err error) (*pb.ApiResponse, error) {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidCalendar), errors.Is(err, controllers.ErrInvalidCalendarRange):
+		return &pb.ApiResponse{
+			Status: BadRequestStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controllers.ErrEmployeeNotFound), errors.Is(err, controllers.ErrCalendarNotFound),
+		errors.Is(err, controllers.ErrCalendarDayNotFound):
+		return &pb.ApiResponse{
+			Status: NotFoundStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, controllers.ErrCalendarConflict):
+		return &pb.ApiResponse{
+			Status: ConflictStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
 }
 
 // grpcResponse return ApiResponse struct with data and error.
@@ -338,3 +1153,32 @@ func (s *Server) grpcResponse(ctx context.Context, msg protoreflect.ProtoMessage
 		Data:   data,
 	}, nil
 }
+
+func leaveErrorResponse(err error) (*pb.ApiResponse, error) {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidLeaveAdjustment), errors.Is(err, controllers.ErrInvalidLeaveFilter):
+		return &pb.ApiResponse{
+			Status: BadRequestStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controllers.ErrEmployeeNotFound):
+		return &pb.ApiResponse{
+			Status: NotFoundStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, controllers.ErrInsufficientLeaveBalance):
+		return &pb.ApiResponse{
+			Status: UnprocessableStatus,
+			Type:   "error",
+			Data:   nil,
+		}, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return &pb.ApiResponse{
+			Status: ErrorStatus,
+			Type:   "error",
+			Data:   nil,
+		}, err
+	}
+}
@@ -15,9 +15,18 @@ import (
 )
 
 const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for APIKeyFormScopes.
+const (
+	DepartmentsRead  APIKeyFormScopes = "departments:read"
+	DepartmentsWrite APIKeyFormScopes = "departments:write"
+	EmployeesRead    APIKeyFormScopes = "employees:read"
+	EmployeesWrite   APIKeyFormScopes = "employees:write"
+)
+
 // Defines values for ApiErrorResponseType.
 const (
 	ApiErrorResponseTypeError   ApiErrorResponseType = "error"
@@ -42,9 +51,52 @@ const (
 const (
 	Active    EmployeeStatus = "active"
 	Fired     EmployeeStatus = "fired"
+	Sick      EmployeeStatus = "sick"
 	Suspended EmployeeStatus = "suspended"
 )
 
+// Defines values for LeaveAdjustmentLeaveType.
+const (
+	LeaveAdjustmentLeaveTypeAnnual LeaveAdjustmentLeaveType = "annual"
+)
+
+// Defines values for StaffingConflictResponseType.
+const (
+	Error   StaffingConflictResponseType = "error"
+	Success StaffingConflictResponseType = "success"
+)
+
+// Defines values for VacationRequestFormType.
+const (
+	VacationRequestFormTypeAnnual VacationRequestFormType = "annual"
+	VacationRequestFormTypeStudy  VacationRequestFormType = "study"
+	VacationRequestFormTypeUnpaid VacationRequestFormType = "unpaid"
+)
+
+// Defines values for ClearLockoutParamsScope.
+const (
+	Email ClearLockoutParamsScope = "email"
+	Ip    ClearLockoutParamsScope = "ip"
+)
+
+// APIKeyForm defines model for APIKeyForm.
+type APIKeyForm struct {
+	// ExpiresAt Время окончания действия ключа, без него ключ бессрочный
+	ExpiresAt *time.Time `json:"expires_at"`
+
+	// Name Название ключа
+	Name string `json:"name"`
+
+	// Owner Сервис или команда, отвечающая за ключ
+	Owner string `json:"owner"`
+
+	// Scopes Разрешения ключа
+	Scopes []APIKeyFormScopes `json:"scopes"`
+}
+
+// APIKeyFormScopes defines model for APIKeyForm.Scopes.
+type APIKeyFormScopes string
+
 // ApiErrorResponse defines model for ApiErrorResponse.
 type ApiErrorResponse struct {
 	Data   ApiErrorResponse_Data `json:"data"`
@@ -70,14 +122,47 @@ type ApiResponseBase struct {
 // ApiResponseBaseType defines model for ApiResponseBase.Type.
 type ApiResponseBaseType string
 
+// CalendarForm defines model for CalendarForm.
+type CalendarForm struct {
+	// Description Описание календаря
+	Description *string `json:"description"`
+
+	// Name Название календаря, например офиса или страны
+	Name string `json:"name"`
+}
+
+// CalendarTokenForm defines model for CalendarTokenForm.
+type CalendarTokenForm struct {
+	// Name Название токена, например календарный клиент, в котором он используется
+	Name string `json:"name"`
+}
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	// NewPassword Новый пароль
+	NewPassword string `json:"new_password"`
+
+	// OldPassword Текущий пароль
+	OldPassword string `json:"old_password"`
+}
+
 // DepartmentForm defines model for DepartmentForm.
 type DepartmentForm struct {
+	// CalendarID ID производственного календаря. Без него используется календарь ближайшего вышестоящего департамента
+	CalendarID *uint64 `json:"calendar_id"`
+
 	// Description Описание департамента
 	Description *string `json:"description"`
 
 	// HeadID ID руководителя департамента
 	HeadID *uint64 `json:"head_id"`
 
+	// MinOnDuty Минимум сотрудников на работе. Отпуск, после которого на работе остается меньше, не подается и не согласуется
+	MinOnDuty *uint64 `json:"min_on_duty"`
+
+	// MinOnDutyPercent Минимум сотрудников на работе в процентах от штата, округляется вверх. Действует больший из двух минимумов
+	MinOnDutyPercent *uint64 `json:"min_on_duty_percent"`
+
 	// Name Название департамента
 	Name string `json:"name"`
 
@@ -126,7 +211,7 @@ type Employee struct {
 	// MiddleName Отчество сотрудника
 	MiddleName *string `json:"middle_name"`
 
-	// Password Password сотрудника
+	// Password Password сотрудника. Если при создании пароль не указан, генерируется временный пароль, который возвращается один раз в ответе, и сотрудник обязан сменить его при первом входе. При обновлении сотрудника пароль не передается (400), вместо этого admin и hr выдают токен сброса пароля.
 	Password *string `json:"password"`
 
 	// PersonalNumber Табельный номер (уникальный)
@@ -141,25 +226,46 @@ type Employee struct {
 	// Role Роль сотрудника
 	Role EmployeeRole `json:"role"`
 
-	// SickDays Количество больничных дней
+	// SickDays Дни больничных в текущем году, считаются по зарегистрированным больничным
 	SickDays *int `json:"sick_days,omitempty"`
 
-	// Status Статус сотрудника
+	// Status Статус сотрудника. sick ставится автоматически, пока у сотрудника открыт больничный, и не задается через PUT и PATCH
 	Status EmployeeStatus `json:"status"`
 
 	// UpdatedAt Дата последнего обновления
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
-	// VacationDays Количество дней отпуска
+	// VacationDays Остаток дней ежегодного отпуска в целых днях, считается по журналу отпусков
 	VacationDays *int `json:"vacation_days,omitempty"`
+
+	// Version Версия записи, увеличивается при каждом изменении
+	Version *uint64 `json:"version,omitempty"`
 }
 
 // EmployeeRole Роль сотрудника
 type EmployeeRole string
 
-// EmployeeStatus Статус сотрудника
+// EmployeeStatus Статус сотрудника. sick ставится автоматически, пока у сотрудника открыт больничный, и не задается через PUT и PATCH
 type EmployeeStatus string
 
+// LeaveAdjustment defines model for LeaveAdjustment.
+type LeaveAdjustment struct {
+	// Comment Причина корректировки
+	Comment string `json:"comment"`
+
+	// Days Дни, добавляемые к балансу, отрицательные списываются. Учитываются сотые доли дня
+	Days float64 `json:"days"`
+
+	// EffectiveDate Дата, с которой учитывается корректировка, по умолчанию сегодня
+	EffectiveDate *time.Time `json:"effective_date"`
+
+	// LeaveType Вид баланса
+	LeaveType LeaveAdjustmentLeaveType `json:"leave_type"`
+}
+
+// LeaveAdjustmentLeaveType Вид баланса
+type LeaveAdjustmentLeaveType string
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	// Email Email сотрудника
@@ -169,10 +275,195 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 
-// VacationRequest defines model for VacationRequest.
-type VacationRequest struct {
-	// Days Количество дней отпуска
-	Days int `json:"days"`
+// MFACodeRequest defines model for MFACodeRequest.
+type MFACodeRequest struct {
+	// Code Код из приложения-аутентификатора или код восстановления
+	Code string `json:"code"`
+}
+
+// MFAVerifyRequest defines model for MFAVerifyRequest.
+type MFAVerifyRequest struct {
+	// Code Код из приложения-аутентификатора или код восстановления
+	Code string `json:"code"`
+
+	// MfaToken Токен, полученный при входе с включенной двухфакторной аутентификацией
+	MfaToken string `json:"mfa_token"`
+}
+
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	// RefreshToken Refresh токен, полученный при входе или предыдущем обновлении
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	// NewPassword Новый пароль
+	NewPassword string `json:"new_password"`
+
+	// ResetToken Одноразовый токен сброса пароля
+	ResetToken string `json:"reset_token"`
+}
+
+// SickLeaveClose defines model for SickLeaveClose.
+type SickLeaveClose struct {
+	// CertificateNumber Номер листка нетрудоспособности, если он не был указан при регистрации
+	CertificateNumber *string `json:"certificate_number"`
+
+	// EndDate Последний день больничного, время не учитывается
+	EndDate time.Time `json:"end_date"`
+}
+
+// SickLeaveForm defines model for SickLeaveForm.
+type SickLeaveForm struct {
+	// CertificateNumber Номер листка нетрудоспособности
+	CertificateNumber *string `json:"certificate_number"`
+	Comment           *string `json:"comment"`
+
+	// EndDate Последний день больничного. Без него больничный остается открытым до закрытия
+	EndDate *time.Time `json:"end_date"`
+
+	// StartDate Первый день больничного, время не учитывается. Не может быть в будущем
+	StartDate time.Time `json:"start_date"`
+}
+
+// StaffingConflict Рабочие дни отпуска, в которые на работе остается меньше сотрудников департамента, чем его минимум
+type StaffingConflict struct {
+	Days *[]struct {
+		// Absent Коллеги в согласованном отпуске или на больничном
+		Absent *[]struct {
+			EmployeeID *uint64    `json:"employee_id,omitempty"`
+			EndDate    *time.Time `json:"end_date,omitempty"`
+			FirstName  *string    `json:"first_name,omitempty"`
+			LastName   *string    `json:"last_name,omitempty"`
+
+			// Reason Вид отпуска или sick для больничного
+			Reason    *string    `json:"reason,omitempty"`
+			StartDate *time.Time `json:"start_date,omitempty"`
+		} `json:"absent,omitempty"`
+		Date *time.Time `json:"date,omitempty"`
+
+		// OnDuty Сотрудников на работе, если отпуск будет предоставлен
+		OnDuty *uint64 `json:"on_duty,omitempty"`
+	} `json:"days,omitempty"`
+	DepartmentID *uint64 `json:"department_id,omitempty"`
+
+	// Headcount Штат департамента без уволенных и отстраненных сотрудников
+	Headcount *uint64 `json:"headcount,omitempty"`
+
+	// MinOnDuty Требуемый минимум сотрудников на работе
+	MinOnDuty *uint64 `json:"min_on_duty,omitempty"`
+}
+
+// StaffingConflictResponse defines model for StaffingConflictResponse.
+type StaffingConflictResponse struct {
+	Data struct {
+		// Conflict Рабочие дни отпуска, в которые на работе остается меньше сотрудников департамента, чем его минимум
+		Conflict *StaffingConflict `json:"conflict,omitempty"`
+	} `json:"data"`
+	Status int                          `json:"status"`
+	Type   StaffingConflictResponseType `json:"type"`
+}
+
+// StaffingConflictResponseType defines model for StaffingConflictResponse.Type.
+type StaffingConflictResponseType string
+
+// VacationDecision defines model for VacationDecision.
+type VacationDecision struct {
+	// Reason Причина отказа
+	Reason *string `json:"reason"`
+}
+
+// VacationRequestForm defines model for VacationRequestForm.
+type VacationRequestForm struct {
+	Comment *string `json:"comment"`
+
+	// EndDate Последний день отпуска, время не учитывается. Период не длиннее 366 календарных дней
+	EndDate time.Time `json:"end_date"`
+
+	// StartDate Первый день отпуска, время не учитывается
+	StartDate time.Time `json:"start_date"`
+
+	// Type Вид отпуска. Только annual списывает дни с баланса ежегодного отпуска
+	Type VacationRequestFormType `json:"type"`
+}
+
+// VacationRequestFormType Вид отпуска. Только annual списывает дни с баланса ежегодного отпуска
+type VacationRequestFormType string
+
+// GetAuditLogParams defines parameters for GetAuditLog.
+type GetAuditLogParams struct {
+	// ActorID Фильтр по сотруднику, который выполнил действие
+	ActorID *uint64 `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+
+	// EmployeeID Фильтр по сотруднику, от имени которого выполнено действие
+	EmployeeID *uint64 `form:"employee_id,omitempty" json:"employee_id,omitempty"`
+}
+
+// ClearLockoutParamsScope defines parameters for ClearLockout.
+type ClearLockoutParamsScope string
+
+// OIDCCallbackParams defines parameters for OIDCCallback.
+type OIDCCallbackParams struct {
+	Code  string `form:"code" json:"code"`
+	State string `form:"state" json:"state"`
+}
+
+// GetDepartmentAbsenceFeedParams defines parameters for GetDepartmentAbsenceFeed.
+type GetDepartmentAbsenceFeedParams struct {
+	// Token Токен календаря из POST /auth/calendar-tokens
+	Token string `form:"token" json:"token"`
+
+	// Birthdays Добавить дни рождения сотрудников
+	Birthdays *bool `form:"birthdays,omitempty" json:"birthdays,omitempty"`
+
+	// Anniversaries Добавить годовщины работы в компании по дате приема
+	Anniversaries *bool `form:"anniversaries,omitempty" json:"anniversaries,omitempty"`
+}
+
+// GetEmployeeAbsenceFeedParams defines parameters for GetEmployeeAbsenceFeed.
+type GetEmployeeAbsenceFeedParams struct {
+	// Token Токен календаря из POST /auth/calendar-tokens
+	Token string `form:"token" json:"token"`
+
+	// Birthdays Добавить дни рождения сотрудников
+	Birthdays *bool `form:"birthdays,omitempty" json:"birthdays,omitempty"`
+
+	// Anniversaries Добавить годовщины работы в компании по дате приема
+	Anniversaries *bool `form:"anniversaries,omitempty" json:"anniversaries,omitempty"`
+}
+
+// GetCalendarDaysParams defines parameters for GetCalendarDays.
+type GetCalendarDaysParams struct {
+	// From Начало периода включительно (RFC 3339 или YYYY-MM-DD), время не учитывается
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода включительно (RFC 3339 или YYYY-MM-DD), время не учитывается
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// CreateDepartmentParams defines parameters for CreateDepartment.
+type CreateDepartmentParams struct {
+	// IdempotencyKey Ключ повтора запроса. Повтор с тем же ключом и телом возвращает первый ответ и не создает запись заново
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteDepartmentParams defines parameters for DeleteDepartment.
+type DeleteDepartmentParams struct {
+	// IfMatch Версия записи из ETag, прочитанная клиентом. Без нее изменение отклоняется с 428
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchDepartmentParams defines parameters for PatchDepartment.
+type PatchDepartmentParams struct {
+	// IfMatch Версия записи из ETag, прочитанная клиентом. Без нее изменение отклоняется с 428
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateDepartmentParams defines parameters for UpdateDepartment.
+type UpdateDepartmentParams struct {
+	// IfMatch Версия записи из ETag, прочитанная клиентом. Без нее изменение отклоняется с 428
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
 // GetEmployeesParams defines parameters for GetEmployees.
@@ -183,27 +474,207 @@ type GetEmployeesParams struct {
 	// DepartmentID Фильтр по ID департамента
 	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
 
-	// Status Фильтр по статусу (active, fired, suspended)
+	// Status Фильтр по статусу (active, fired, suspended, sick). Уволенные сотрудники возвращаются только с status=fired
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// ManagerID Фильтр по ID руководителя
+	ManagerID *uint64 `form:"manager_id,omitempty" json:"manager_id,omitempty"`
+
+	// IsActive Фильтр по активности учетной записи
+	IsActive *bool `form:"is_active,omitempty" json:"is_active,omitempty"`
+
+	// HiredFrom Дата найма не раньше (RFC 3339)
+	HiredFrom *time.Time `form:"hired_from,omitempty" json:"hired_from,omitempty"`
+
+	// HiredTo Дата найма не позже (RFC 3339)
+	HiredTo *time.Time `form:"hired_to,omitempty" json:"hired_to,omitempty"`
+
+	// BirthdayMonth Фильтр по месяцу дня рождения (1-12)
+	BirthdayMonth *int `form:"birthday_month,omitempty" json:"birthday_month,omitempty"`
+
+	// Position Фильтр по должности, без учета регистра
+	Position *string `form:"position,omitempty" json:"position,omitempty"`
+
+	// Limit Размер страницы, от 1 до 500 (по умолчанию 50)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// PageToken Токен следующей страницы из next_page_token предыдущего ответа
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// SortBy Поле сортировки (id, last_name, first_name, hire_date, created_at, updated_at), по умолчанию id
+	SortBy *string `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// SortOrder Направление сортировки (asc, desc), по умолчанию asc
+	SortOrder *string `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// IncludeTotal Вернуть общее количество сотрудников по фильтрам в total_count
+	IncludeTotal *bool `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// CreateEmployeeParams defines parameters for CreateEmployee.
+type CreateEmployeeParams struct {
+	// IdempotencyKey Ключ повтора запроса. Повтор с тем же ключом и телом возвращает первый ответ и не создает запись заново
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// SearchEmployeesParams defines parameters for SearchEmployees.
+type SearchEmployeesParams struct {
+	// Q Строка поиска
+	Q string `form:"q" json:"q"`
+
+	// Limit Максимальное число результатов, от 1 до 100 (по умолчанию 20)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Translit Искать также транслитерацию запроса (Ivanov найдет Иванова и наоборот)
+	Translit *bool `form:"translit,omitempty" json:"translit,omitempty"`
+
+	// Status Фильтр по статусу (active, fired, suspended, sick). Уволенные сотрудники находятся только с status=fired
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// DeleteEmployeeParams defines parameters for DeleteEmployee.
+type DeleteEmployeeParams struct {
+	// IfMatch Версия записи из ETag, прочитанная клиентом. Без нее изменение отклоняется с 428
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchEmployeeParams defines parameters for PatchEmployee.
+type PatchEmployeeParams struct {
+	// IfMatch Версия записи из ETag, прочитанная клиентом. Без нее изменение отклоняется с 428
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateEmployeeParams defines parameters for UpdateEmployee.
+type UpdateEmployeeParams struct {
+	// IfMatch Версия записи из ETag, прочитанная клиентом. Без нее изменение отклоняется с 428
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetLeaveBalanceParams defines parameters for GetLeaveBalance.
+type GetLeaveBalanceParams struct {
+	// AsOf Дата баланса (RFC 3339), время не учитывается. По умолчанию сегодня
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty"`
+}
+
+// GetLeaveLedgerParams defines parameters for GetLeaveLedger.
+type GetLeaveLedgerParams struct {
+	// LeaveType Фильтр по виду баланса (annual)
+	LeaveType *string `form:"leave_type,omitempty" json:"leave_type,omitempty"`
+
+	// From Дата вступления в силу не раньше (RFC 3339)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Дата вступления в силу не позже (RFC 3339)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PurgeEmployeeParams defines parameters for PurgeEmployee.
+type PurgeEmployeeParams struct {
+	// IfMatch Версия записи из ETag, прочитанная клиентом. Без нее удаление отклоняется с 428
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// CountWorkingDaysParams defines parameters for CountWorkingDays.
+type CountWorkingDaysParams struct {
+	// From Начало периода включительно (RFC 3339 или YYYY-MM-DD), время не учитывается
+	From time.Time `form:"from" json:"from"`
+
+	// To Конец периода включительно (RFC 3339 или YYYY-MM-DD), время не учитывается
+	To time.Time `form:"to" json:"to"`
+}
+
+// GetSickLeavesParams defines parameters for GetSickLeaves.
+type GetSickLeavesParams struct {
+	// From Начало периода (RFC 3339)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (RFC 3339)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// DepartmentID Фильтр по департаменту сотрудника
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+
+	// EmployeeID Фильтр по сотруднику
+	EmployeeID *uint64 `form:"employee_id,omitempty" json:"employee_id,omitempty"`
+
+	// Open true — только открытые больничные, false — только закрытые
+	Open *bool `form:"open,omitempty" json:"open,omitempty"`
+}
+
+// GetVacationRequestsParams defines parameters for GetVacationRequests.
+type GetVacationRequestsParams struct {
+	// Status Фильтр по статусу (pending_manager, pending_hr, approved, rejected, cancelled)
 	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// EmployeeID Фильтр по сотруднику
+	EmployeeID *uint64 `form:"employee_id,omitempty" json:"employee_id,omitempty"`
 }
 
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = APIKeyForm
+
+// UpdateAPIKeyJSONRequestBody defines body for UpdateAPIKey for application/json ContentType.
+type UpdateAPIKeyJSONRequestBody = APIKeyForm
+
+// CreateCalendarTokenJSONRequestBody defines body for CreateCalendarToken for application/json ContentType.
+type CreateCalendarTokenJSONRequestBody = CalendarTokenForm
+
 // AuthLoginJSONRequestBody defines body for AuthLogin for application/json ContentType.
 type AuthLoginJSONRequestBody = LoginRequest
 
+// DisableMFAJSONRequestBody defines body for DisableMFA for application/json ContentType.
+type DisableMFAJSONRequestBody = MFACodeRequest
+
+// EnableMFAJSONRequestBody defines body for EnableMFA for application/json ContentType.
+type EnableMFAJSONRequestBody = MFACodeRequest
+
+// VerifyMFAJSONRequestBody defines body for VerifyMFA for application/json ContentType.
+type VerifyMFAJSONRequestBody = MFAVerifyRequest
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = ChangePasswordRequest
+
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody = ResetPasswordRequest
+
+// AuthRefreshJSONRequestBody defines body for AuthRefresh for application/json ContentType.
+type AuthRefreshJSONRequestBody = RefreshRequest
+
+// CreateCalendarJSONRequestBody defines body for CreateCalendar for application/json ContentType.
+type CreateCalendarJSONRequestBody = CalendarForm
+
 // CreateDepartmentJSONRequestBody defines body for CreateDepartment for application/json ContentType.
 type CreateDepartmentJSONRequestBody = DepartmentForm
 
+// PatchDepartmentApplicationMergePatchPlusJSONRequestBody defines body for PatchDepartment for application/merge-patch+json ContentType.
+type PatchDepartmentApplicationMergePatchPlusJSONRequestBody = DepartmentForm
+
 // UpdateDepartmentJSONRequestBody defines body for UpdateDepartment for application/json ContentType.
 type UpdateDepartmentJSONRequestBody = DepartmentForm
 
 // CreateEmployeeJSONRequestBody defines body for CreateEmployee for application/json ContentType.
 type CreateEmployeeJSONRequestBody = Employee
 
+// PatchEmployeeApplicationMergePatchPlusJSONRequestBody defines body for PatchEmployee for application/merge-patch+json ContentType.
+type PatchEmployeeApplicationMergePatchPlusJSONRequestBody = Employee
+
 // UpdateEmployeeJSONRequestBody defines body for UpdateEmployee for application/json ContentType.
 type UpdateEmployeeJSONRequestBody = Employee
 
+// AdjustLeaveJSONRequestBody defines body for AdjustLeave for application/json ContentType.
+type AdjustLeaveJSONRequestBody = LeaveAdjustment
+
+// RegisterSickLeaveJSONRequestBody defines body for RegisterSickLeave for application/json ContentType.
+type RegisterSickLeaveJSONRequestBody = SickLeaveForm
+
 // RequestVacationJSONRequestBody defines body for RequestVacation for application/json ContentType.
-type RequestVacationJSONRequestBody = VacationRequest
+type RequestVacationJSONRequestBody = VacationRequestForm
+
+// CloseSickLeaveJSONRequestBody defines body for CloseSickLeave for application/json ContentType.
+type CloseSickLeaveJSONRequestBody = SickLeaveClose
+
+// RejectVacationRequestJSONRequestBody defines body for RejectVacationRequest for application/json ContentType.
+type RejectVacationRequestJSONRequestBody = VacationDecision
 
 // Getter for additional properties for ApiErrorResponse_Data. Returns the specified
 // element and whether it was found
@@ -275,51 +746,255 @@ func (a ApiErrorResponse_Data) MarshalJSON() ([]byte, error) {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Журнал аудита
+	// (GET /audit-log)
+	GetAuditLog(w http.ResponseWriter, r *http.Request, params GetAuditLogParams)
+	// Список API ключей
+	// (GET /auth/api-keys)
+	GetAPIKeys(w http.ResponseWriter, r *http.Request)
+	// Создание API ключа
+	// (POST /auth/api-keys)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// Удаление API ключа
+	// (DELETE /auth/api-keys/{id})
+	DeleteAPIKey(w http.ResponseWriter, r *http.Request, id uint64)
+	// Обновление API ключа
+	// (PUT /auth/api-keys/{id})
+	UpdateAPIKey(w http.ResponseWriter, r *http.Request, id uint64)
+	// Список токенов календаря
+	// (GET /auth/calendar-tokens)
+	GetCalendarTokens(w http.ResponseWriter, r *http.Request)
+	// Создание токена календаря
+	// (POST /auth/calendar-tokens)
+	CreateCalendarToken(w http.ResponseWriter, r *http.Request)
+	// Отзыв токена календаря
+	// (DELETE /auth/calendar-tokens/{id})
+	DeleteCalendarToken(w http.ResponseWriter, r *http.Request, id uint64)
+	// Список блокировок входа
+	// (GET /auth/lockouts)
+	GetLockouts(w http.ResponseWriter, r *http.Request)
+	// Снятие блокировки входа
+	// (DELETE /auth/lockouts/{scope}/{value})
+	ClearLockout(w http.ResponseWriter, r *http.Request, scope ClearLockoutParamsScope, value string)
 	// Вход в систему
 	// (POST /auth/login)
 	AuthLogin(w http.ResponseWriter, r *http.Request)
 	// Выход из системы
 	// (POST /auth/logout)
 	AuthLogout(w http.ResponseWriter, r *http.Request)
+	// Отключение TOTP
+	// (POST /auth/mfa/disable)
+	DisableMFA(w http.ResponseWriter, r *http.Request)
+	// Включение TOTP
+	// (POST /auth/mfa/enable)
+	EnableMFA(w http.ResponseWriter, r *http.Request)
+	// Подключение TOTP
+	// (POST /auth/mfa/enroll)
+	EnrollMFA(w http.ResponseWriter, r *http.Request)
+	// Второй шаг входа
+	// (POST /auth/mfa/verify)
+	VerifyMFA(w http.ResponseWriter, r *http.Request)
+	// Завершение входа через IdP
+	// (GET /auth/oidc/callback)
+	OIDCCallback(w http.ResponseWriter, r *http.Request, params OIDCCallbackParams)
+	// Вход через корпоративный IdP
+	// (GET /auth/oidc/login)
+	OIDCLogin(w http.ResponseWriter, r *http.Request)
+	// Смена пароля
+	// (POST /auth/password)
+	ChangePassword(w http.ResponseWriter, r *http.Request)
+	// Сброс пароля по токену
+	// (POST /auth/password/reset)
+	ResetPassword(w http.ResponseWriter, r *http.Request)
+	// Обновление токенов
+	// (POST /auth/refresh)
+	AuthRefresh(w http.ResponseWriter, r *http.Request)
+	// Отзыв всех сессий
+	// (DELETE /auth/sessions)
+	RevokeAllSessions(w http.ResponseWriter, r *http.Request)
+	// Список сессий
+	// (GET /auth/sessions)
+	GetSessions(w http.ResponseWriter, r *http.Request)
+	// Отзыв сессии
+	// (DELETE /auth/sessions/{id})
+	RevokeSession(w http.ResponseWriter, r *http.Request, id string)
+	// Список производственных календарей
+	// (GET /calendars)
+	GetCalendars(w http.ResponseWriter, r *http.Request)
+	// Создание производственного календаря
+	// (POST /calendars)
+	CreateCalendar(w http.ResponseWriter, r *http.Request)
+	// Календарь отсутствий департамента
+	// (GET /calendars/departments/{id}.ics)
+	GetDepartmentAbsenceFeed(w http.ResponseWriter, r *http.Request, id uint64, params GetDepartmentAbsenceFeedParams)
+	// Календарь отсутствий сотрудника
+	// (GET /calendars/employees/{id}.ics)
+	GetEmployeeAbsenceFeed(w http.ResponseWriter, r *http.Request, id uint64, params GetEmployeeAbsenceFeedParams)
+	// Удаление производственного календаря
+	// (DELETE /calendars/{id})
+	DeleteCalendar(w http.ResponseWriter, r *http.Request, id uint64)
+	// Дни производственного календаря
+	// (GET /calendars/{id}/days)
+	GetCalendarDays(w http.ResponseWriter, r *http.Request, id uint64, params GetCalendarDaysParams)
+	// Импорт дней производственного календаря
+	// (POST /calendars/{id}/days/import)
+	ImportCalendarDays(w http.ResponseWriter, r *http.Request, id uint64)
+	// Удаление дня производственного календаря
+	// (DELETE /calendars/{id}/days/{date})
+	DeleteCalendarDay(w http.ResponseWriter, r *http.Request, id uint64, date time.Time)
 	// Получение списка департаментов
 	// (GET /departments)
 	GetDepartments(w http.ResponseWriter, r *http.Request)
 	// Создание департамента
 	// (POST /departments)
-	CreateDepartment(w http.ResponseWriter, r *http.Request)
+	CreateDepartment(w http.ResponseWriter, r *http.Request, params CreateDepartmentParams)
 	// Удаление департамента
 	// (DELETE /departments/{id})
-	DeleteDepartment(w http.ResponseWriter, r *http.Request, id uint64)
+	DeleteDepartment(w http.ResponseWriter, r *http.Request, id uint64, params DeleteDepartmentParams)
 	// Получение департамента по ID
 	// (GET /departments/{id})
 	GetDepartmentByID(w http.ResponseWriter, r *http.Request, id uint64)
+	// Частичное обновление департамента
+	// (PATCH /departments/{id})
+	PatchDepartment(w http.ResponseWriter, r *http.Request, id uint64, params PatchDepartmentParams)
 	// Обновление департамента
 	// (PUT /departments/{id})
-	UpdateDepartment(w http.ResponseWriter, r *http.Request, id uint64)
+	UpdateDepartment(w http.ResponseWriter, r *http.Request, id uint64, params UpdateDepartmentParams)
 	// Получение списка сотрудников
 	// (GET /employees)
 	GetEmployees(w http.ResponseWriter, r *http.Request, params GetEmployeesParams)
 	// Создание сотрудника
 	// (POST /employees)
-	CreateEmployee(w http.ResponseWriter, r *http.Request)
-	// Удаление сотрудника
+	CreateEmployee(w http.ResponseWriter, r *http.Request, params CreateEmployeeParams)
+	// Поиск сотрудников
+	// (GET /employees/search)
+	SearchEmployees(w http.ResponseWriter, r *http.Request, params SearchEmployeesParams)
+	// Увольнение сотрудника
 	// (DELETE /employees/{id})
-	DeleteEmployee(w http.ResponseWriter, r *http.Request, id uint64)
+	DeleteEmployee(w http.ResponseWriter, r *http.Request, id uint64, params DeleteEmployeeParams)
 	// Получение сотрудника по ID
 	// (GET /employees/{id})
 	GetEmployeesByID(w http.ResponseWriter, r *http.Request, id uint64)
+	// Частичное обновление сотрудника
+	// (PATCH /employees/{id})
+	PatchEmployee(w http.ResponseWriter, r *http.Request, id uint64, params PatchEmployeeParams)
 	// Обновление сотрудника
 	// (PUT /employees/{id})
-	UpdateEmployee(w http.ResponseWriter, r *http.Request, id uint64)
-	// Запрос отпуска
+	UpdateEmployee(w http.ResponseWriter, r *http.Request, id uint64, params UpdateEmployeeParams)
+	// Вход от имени сотрудника
+	// (POST /employees/{id}/impersonate)
+	Impersonate(w http.ResponseWriter, r *http.Request, id uint64)
+	// Корректировка баланса
+	// (POST /employees/{id}/leave/adjustments)
+	AdjustLeave(w http.ResponseWriter, r *http.Request, id uint64)
+	// Остатки отпуска
+	// (GET /employees/{id}/leave/balance)
+	GetLeaveBalance(w http.ResponseWriter, r *http.Request, id uint64, params GetLeaveBalanceParams)
+	// Журнал отпусков сотрудника
+	// (GET /employees/{id}/leave/ledger)
+	GetLeaveLedger(w http.ResponseWriter, r *http.Request, id uint64, params GetLeaveLedgerParams)
+	// Выдача токена сброса пароля
+	// (POST /employees/{id}/password-reset)
+	CreatePasswordReset(w http.ResponseWriter, r *http.Request, id uint64)
+	// Окончательное удаление сотрудника
+	// (POST /employees/{id}/purge)
+	PurgeEmployee(w http.ResponseWriter, r *http.Request, id uint64, params PurgeEmployeeParams)
+	// Восстановление уволенного сотрудника
+	// (POST /employees/{id}/restore)
+	RestoreEmployee(w http.ResponseWriter, r *http.Request, id uint64)
+	// Регистрация больничного
+	// (POST /employees/{id}/sick-leaves)
+	RegisterSickLeave(w http.ResponseWriter, r *http.Request, id uint64)
+	// Заявка на отпуск
 	// (POST /employees/{id}/vacation)
 	RequestVacation(w http.ResponseWriter, r *http.Request, id uint64)
+	// Количество рабочих дней сотрудника
+	// (GET /employees/{id}/working-days)
+	CountWorkingDays(w http.ResponseWriter, r *http.Request, id uint64, params CountWorkingDaysParams)
+	// Список больничных
+	// (GET /sick-leaves)
+	GetSickLeaves(w http.ResponseWriter, r *http.Request, params GetSickLeavesParams)
+	// Закрытие больничного
+	// (POST /sick-leaves/{id}/close)
+	CloseSickLeave(w http.ResponseWriter, r *http.Request, id uint64)
+	// Список заявок на отпуск
+	// (GET /vacation-requests)
+	GetVacationRequests(w http.ResponseWriter, r *http.Request, params GetVacationRequestsParams)
+	// Согласование заявки на отпуск
+	// (POST /vacation-requests/{id}/approve)
+	ApproveVacationRequest(w http.ResponseWriter, r *http.Request, id uint64)
+	// Отмена заявки на отпуск
+	// (POST /vacation-requests/{id}/cancel)
+	CancelVacationRequest(w http.ResponseWriter, r *http.Request, id uint64)
+	// Отклонение заявки на отпуск
+	// (POST /vacation-requests/{id}/reject)
+	RejectVacationRequest(w http.ResponseWriter, r *http.Request, id uint64)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Журнал аудита
+// (GET /audit-log)
+func (_ Unimplemented) GetAuditLog(w http.ResponseWriter, r *http.Request, params GetAuditLogParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список API ключей
+// (GET /auth/api-keys)
+func (_ Unimplemented) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание API ключа
+// (POST /auth/api-keys)
+func (_ Unimplemented) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление API ключа
+// (DELETE /auth/api-keys/{id})
+func (_ Unimplemented) DeleteAPIKey(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновление API ключа
+// (PUT /auth/api-keys/{id})
+func (_ Unimplemented) UpdateAPIKey(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список токенов календаря
+// (GET /auth/calendar-tokens)
+func (_ Unimplemented) GetCalendarTokens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание токена календаря
+// (POST /auth/calendar-tokens)
+func (_ Unimplemented) CreateCalendarToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отзыв токена календаря
+// (DELETE /auth/calendar-tokens/{id})
+func (_ Unimplemented) DeleteCalendarToken(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список блокировок входа
+// (GET /auth/lockouts)
+func (_ Unimplemented) GetLockouts(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Снятие блокировки входа
+// (DELETE /auth/lockouts/{scope}/{value})
+func (_ Unimplemented) ClearLockout(w http.ResponseWriter, r *http.Request, scope ClearLockoutParamsScope, value string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Вход в систему
 // (POST /auth/login)
 func (_ Unimplemented) AuthLogin(w http.ResponseWriter, r *http.Request) {
@@ -332,6 +1007,126 @@ func (_ Unimplemented) AuthLogout(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Отключение TOTP
+// (POST /auth/mfa/disable)
+func (_ Unimplemented) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Включение TOTP
+// (POST /auth/mfa/enable)
+func (_ Unimplemented) EnableMFA(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подключение TOTP
+// (POST /auth/mfa/enroll)
+func (_ Unimplemented) EnrollMFA(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Второй шаг входа
+// (POST /auth/mfa/verify)
+func (_ Unimplemented) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Завершение входа через IdP
+// (GET /auth/oidc/callback)
+func (_ Unimplemented) OIDCCallback(w http.ResponseWriter, r *http.Request, params OIDCCallbackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вход через корпоративный IdP
+// (GET /auth/oidc/login)
+func (_ Unimplemented) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Смена пароля
+// (POST /auth/password)
+func (_ Unimplemented) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сброс пароля по токену
+// (POST /auth/password/reset)
+func (_ Unimplemented) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновление токенов
+// (POST /auth/refresh)
+func (_ Unimplemented) AuthRefresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отзыв всех сессий
+// (DELETE /auth/sessions)
+func (_ Unimplemented) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список сессий
+// (GET /auth/sessions)
+func (_ Unimplemented) GetSessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отзыв сессии
+// (DELETE /auth/sessions/{id})
+func (_ Unimplemented) RevokeSession(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список производственных календарей
+// (GET /calendars)
+func (_ Unimplemented) GetCalendars(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание производственного календаря
+// (POST /calendars)
+func (_ Unimplemented) CreateCalendar(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Календарь отсутствий департамента
+// (GET /calendars/departments/{id}.ics)
+func (_ Unimplemented) GetDepartmentAbsenceFeed(w http.ResponseWriter, r *http.Request, id uint64, params GetDepartmentAbsenceFeedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Календарь отсутствий сотрудника
+// (GET /calendars/employees/{id}.ics)
+func (_ Unimplemented) GetEmployeeAbsenceFeed(w http.ResponseWriter, r *http.Request, id uint64, params GetEmployeeAbsenceFeedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление производственного календаря
+// (DELETE /calendars/{id})
+func (_ Unimplemented) DeleteCalendar(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Дни производственного календаря
+// (GET /calendars/{id}/days)
+func (_ Unimplemented) GetCalendarDays(w http.ResponseWriter, r *http.Request, id uint64, params GetCalendarDaysParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Импорт дней производственного календаря
+// (POST /calendars/{id}/days/import)
+func (_ Unimplemented) ImportCalendarDays(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление дня производственного календаря
+// (DELETE /calendars/{id}/days/{date})
+func (_ Unimplemented) DeleteCalendarDay(w http.ResponseWriter, r *http.Request, id uint64, date time.Time) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получение списка департаментов
 // (GET /departments)
 func (_ Unimplemented) GetDepartments(w http.ResponseWriter, r *http.Request) {
@@ -340,13 +1135,13 @@ func (_ Unimplemented) GetDepartments(w http.ResponseWriter, r *http.Request) {
 
 // Создание департамента
 // (POST /departments)
-func (_ Unimplemented) CreateDepartment(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateDepartment(w http.ResponseWriter, r *http.Request, params CreateDepartmentParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Удаление департамента
 // (DELETE /departments/{id})
-func (_ Unimplemented) DeleteDepartment(w http.ResponseWriter, r *http.Request, id uint64) {
+func (_ Unimplemented) DeleteDepartment(w http.ResponseWriter, r *http.Request, id uint64, params DeleteDepartmentParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -356,9 +1151,15 @@ func (_ Unimplemented) GetDepartmentByID(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Частичное обновление департамента
+// (PATCH /departments/{id})
+func (_ Unimplemented) PatchDepartment(w http.ResponseWriter, r *http.Request, id uint64, params PatchDepartmentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Обновление департамента
 // (PUT /departments/{id})
-func (_ Unimplemented) UpdateDepartment(w http.ResponseWriter, r *http.Request, id uint64) {
+func (_ Unimplemented) UpdateDepartment(w http.ResponseWriter, r *http.Request, id uint64, params UpdateDepartmentParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -370,13 +1171,19 @@ func (_ Unimplemented) GetEmployees(w http.ResponseWriter, r *http.Request, para
 
 // Создание сотрудника
 // (POST /employees)
-func (_ Unimplemented) CreateEmployee(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateEmployee(w http.ResponseWriter, r *http.Request, params CreateEmployeeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Удаление сотрудника
+// Поиск сотрудников
+// (GET /employees/search)
+func (_ Unimplemented) SearchEmployees(w http.ResponseWriter, r *http.Request, params SearchEmployeesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Увольнение сотрудника
 // (DELETE /employees/{id})
-func (_ Unimplemented) DeleteEmployee(w http.ResponseWriter, r *http.Request, id uint64) {
+func (_ Unimplemented) DeleteEmployee(w http.ResponseWriter, r *http.Request, id uint64, params DeleteEmployeeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -386,18 +1193,114 @@ func (_ Unimplemented) GetEmployeesByID(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Обновление сотрудника
+// Частичное обновление сотрудника
+// (PATCH /employees/{id})
+func (_ Unimplemented) PatchEmployee(w http.ResponseWriter, r *http.Request, id uint64, params PatchEmployeeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновление сотрудника
 // (PUT /employees/{id})
-func (_ Unimplemented) UpdateEmployee(w http.ResponseWriter, r *http.Request, id uint64) {
+func (_ Unimplemented) UpdateEmployee(w http.ResponseWriter, r *http.Request, id uint64, params UpdateEmployeeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вход от имени сотрудника
+// (POST /employees/{id}/impersonate)
+func (_ Unimplemented) Impersonate(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Корректировка баланса
+// (POST /employees/{id}/leave/adjustments)
+func (_ Unimplemented) AdjustLeave(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Остатки отпуска
+// (GET /employees/{id}/leave/balance)
+func (_ Unimplemented) GetLeaveBalance(w http.ResponseWriter, r *http.Request, id uint64, params GetLeaveBalanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал отпусков сотрудника
+// (GET /employees/{id}/leave/ledger)
+func (_ Unimplemented) GetLeaveLedger(w http.ResponseWriter, r *http.Request, id uint64, params GetLeaveLedgerParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Запрос отпуска
+// Выдача токена сброса пароля
+// (POST /employees/{id}/password-reset)
+func (_ Unimplemented) CreatePasswordReset(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Окончательное удаление сотрудника
+// (POST /employees/{id}/purge)
+func (_ Unimplemented) PurgeEmployee(w http.ResponseWriter, r *http.Request, id uint64, params PurgeEmployeeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановление уволенного сотрудника
+// (POST /employees/{id}/restore)
+func (_ Unimplemented) RestoreEmployee(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Регистрация больничного
+// (POST /employees/{id}/sick-leaves)
+func (_ Unimplemented) RegisterSickLeave(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заявка на отпуск
 // (POST /employees/{id}/vacation)
 func (_ Unimplemented) RequestVacation(w http.ResponseWriter, r *http.Request, id uint64) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Количество рабочих дней сотрудника
+// (GET /employees/{id}/working-days)
+func (_ Unimplemented) CountWorkingDays(w http.ResponseWriter, r *http.Request, id uint64, params CountWorkingDaysParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список больничных
+// (GET /sick-leaves)
+func (_ Unimplemented) GetSickLeaves(w http.ResponseWriter, r *http.Request, params GetSickLeavesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Закрытие больничного
+// (POST /sick-leaves/{id}/close)
+func (_ Unimplemented) CloseSickLeave(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список заявок на отпуск
+// (GET /vacation-requests)
+func (_ Unimplemented) GetVacationRequests(w http.ResponseWriter, r *http.Request, params GetVacationRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Согласование заявки на отпуск
+// (POST /vacation-requests/{id}/approve)
+func (_ Unimplemented) ApproveVacationRequest(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отмена заявки на отпуск
+// (POST /vacation-requests/{id}/cancel)
+func (_ Unimplemented) CancelVacationRequest(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отклонение заявки на отпуск
+// (POST /vacation-requests/{id}/reject)
+func (_ Unimplemented) RejectVacationRequest(w http.ResponseWriter, r *http.Request, id uint64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -407,17 +1310,1814 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// AuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) AuthLogin(w http.ResponseWriter, r *http.Request) {
+// GetAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditLogParams
+
+	// ------------- Optional query parameter "actor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", r.URL.Query(), &params.ActorID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "employee_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "employee_id", r.URL.Query(), &params.EmployeeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "employee_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuditLog(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPIKey(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAPIKey(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCalendarTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarTokens(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarTokens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCalendarToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateCalendarToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCalendarToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCalendarToken operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCalendarToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCalendarToken(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLockouts operation middleware
+func (siw *ServerInterfaceWrapper) GetLockouts(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLockouts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ClearLockout operation middleware
+func (siw *ServerInterfaceWrapper) ClearLockout(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope ClearLockoutParamsScope
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", chi.URLParam(r, "scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "value" -------------
+	var value string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "value", chi.URLParam(r, "value"), &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "value", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClearLockout(w, r, scope, value)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) AuthLogin(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) AuthLogout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisableMFA operation middleware
+func (siw *ServerInterfaceWrapper) DisableMFA(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisableMFA(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnableMFA operation middleware
+func (siw *ServerInterfaceWrapper) EnableMFA(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnableMFA(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnrollMFA operation middleware
+func (siw *ServerInterfaceWrapper) EnrollMFA(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnrollMFA(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyMFA operation middleware
+func (siw *ServerInterfaceWrapper) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyMFA(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OIDCCallback operation middleware
+func (siw *ServerInterfaceWrapper) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OIDCCallbackParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OIDCCallback(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OIDCLogin operation middleware
+func (siw *ServerInterfaceWrapper) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OIDCLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangePassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) ResetPassword(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetPassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthRefresh operation middleware
+func (siw *ServerInterfaceWrapper) AuthRefresh(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeAllSessions operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAllSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeSession(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCalendars operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendars(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendars(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCalendar operation middleware
+func (siw *ServerInterfaceWrapper) CreateCalendar(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCalendar(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDepartmentAbsenceFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetDepartmentAbsenceFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDepartmentAbsenceFeedParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "birthdays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "birthdays", r.URL.Query(), &params.Birthdays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "birthdays", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "anniversaries" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "anniversaries", r.URL.Query(), &params.Anniversaries)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "anniversaries", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDepartmentAbsenceFeed(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEmployeeAbsenceFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetEmployeeAbsenceFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEmployeeAbsenceFeedParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "birthdays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "birthdays", r.URL.Query(), &params.Birthdays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "birthdays", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "anniversaries" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "anniversaries", r.URL.Query(), &params.Anniversaries)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "anniversaries", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEmployeeAbsenceFeed(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCalendar operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCalendar(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCalendar(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCalendarDays operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarDays(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarDaysParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarDays(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportCalendarDays operation middleware
+func (siw *ServerInterfaceWrapper) ImportCalendarDays(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportCalendarDays(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCalendarDay operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCalendarDay(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date time.Time
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", chi.URLParam(r, "date"), &date, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCalendarDay(w, r, id, date)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDepartments operation middleware
+func (siw *ServerInterfaceWrapper) GetDepartments(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDepartments(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDepartment operation middleware
+func (siw *ServerInterfaceWrapper) CreateDepartment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDepartmentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDepartment(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDepartment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDepartment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDepartmentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDepartment(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDepartmentByID operation middleware
+func (siw *ServerInterfaceWrapper) GetDepartmentByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDepartmentByID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchDepartment operation middleware
+func (siw *ServerInterfaceWrapper) PatchDepartment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchDepartmentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchDepartment(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateDepartment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDepartment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateDepartmentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateDepartment(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEmployees operation middleware
+func (siw *ServerInterfaceWrapper) GetEmployees(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEmployeesParams
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "manager_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "manager_id", r.URL.Query(), &params.ManagerID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "manager_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", r.URL.Query(), &params.IsActive)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "is_active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "hired_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hired_from", r.URL.Query(), &params.HiredFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hired_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "hired_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hired_to", r.URL.Query(), &params.HiredTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hired_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "birthday_month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "birthday_month", r.URL.Query(), &params.BirthdayMonth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "birthday_month", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "position" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "position", r.URL.Query(), &params.Position)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "position", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEmployees(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEmployee operation middleware
+func (siw *ServerInterfaceWrapper) CreateEmployee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateEmployeeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEmployee(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchEmployees operation middleware
+func (siw *ServerInterfaceWrapper) SearchEmployees(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchEmployeesParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "translit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "translit", r.URL.Query(), &params.Translit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "translit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchEmployees(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEmployee operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEmployee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteEmployeeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEmployee(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEmployeesByID operation middleware
+func (siw *ServerInterfaceWrapper) GetEmployeesByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEmployeesByID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchEmployee operation middleware
+func (siw *ServerInterfaceWrapper) PatchEmployee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchEmployeeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchEmployee(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateEmployee operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEmployee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateEmployeeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEmployee(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Impersonate operation middleware
+func (siw *ServerInterfaceWrapper) Impersonate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Impersonate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdjustLeave operation middleware
+func (siw *ServerInterfaceWrapper) AdjustLeave(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdjustLeave(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLeaveBalance operation middleware
+func (siw *ServerInterfaceWrapper) GetLeaveBalance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeaveBalanceParams
+
+	// ------------- Optional query parameter "as_of" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "as_of", r.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "as_of", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLeaveBalance(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLeaveLedger operation middleware
+func (siw *ServerInterfaceWrapper) GetLeaveLedger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeaveLedgerParams
+
+	// ------------- Optional query parameter "leave_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "leave_type", r.URL.Query(), &params.LeaveType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leave_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthLogin(w, r)
+		siw.Handler.GetLeaveLedger(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -427,8 +3127,19 @@ func (siw *ServerInterfaceWrapper) AuthLogin(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// AuthLogout operation middleware
-func (siw *ServerInterfaceWrapper) AuthLogout(w http.ResponseWriter, r *http.Request) {
+// CreatePasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) CreatePasswordReset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -437,7 +3148,7 @@ func (siw *ServerInterfaceWrapper) AuthLogout(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthLogout(w, r)
+		siw.Handler.CreatePasswordReset(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -447,8 +3158,19 @@ func (siw *ServerInterfaceWrapper) AuthLogout(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetDepartments operation middleware
-func (siw *ServerInterfaceWrapper) GetDepartments(w http.ResponseWriter, r *http.Request) {
+// PurgeEmployee operation middleware
+func (siw *ServerInterfaceWrapper) PurgeEmployee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -456,8 +3178,32 @@ func (siw *ServerInterfaceWrapper) GetDepartments(w http.ResponseWriter, r *http
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PurgeEmployeeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDepartments(w, r)
+		siw.Handler.PurgeEmployee(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -467,17 +3213,30 @@ func (siw *ServerInterfaceWrapper) GetDepartments(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateDepartment operation middleware
-func (siw *ServerInterfaceWrapper) CreateDepartment(w http.ResponseWriter, r *http.Request) {
+// RestoreEmployee operation middleware
+func (siw *ServerInterfaceWrapper) RestoreEmployee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDepartment(w, r)
+		siw.Handler.RestoreEmployee(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -487,8 +3246,8 @@ func (siw *ServerInterfaceWrapper) CreateDepartment(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteDepartment operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDepartment(w http.ResponseWriter, r *http.Request) {
+// RegisterSickLeave operation middleware
+func (siw *ServerInterfaceWrapper) RegisterSickLeave(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -505,10 +3264,12 @@ func (siw *ServerInterfaceWrapper) DeleteDepartment(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDepartment(w, r, id)
+		siw.Handler.RegisterSickLeave(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -518,8 +3279,8 @@ func (siw *ServerInterfaceWrapper) DeleteDepartment(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetDepartmentByID operation middleware
-func (siw *ServerInterfaceWrapper) GetDepartmentByID(w http.ResponseWriter, r *http.Request) {
+// RequestVacation operation middleware
+func (siw *ServerInterfaceWrapper) RequestVacation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -536,10 +3297,12 @@ func (siw *ServerInterfaceWrapper) GetDepartmentByID(w http.ResponseWriter, r *h
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDepartmentByID(w, r, id)
+		siw.Handler.RequestVacation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -549,8 +3312,8 @@ func (siw *ServerInterfaceWrapper) GetDepartmentByID(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateDepartment operation middleware
-func (siw *ServerInterfaceWrapper) UpdateDepartment(w http.ResponseWriter, r *http.Request) {
+// CountWorkingDays operation middleware
+func (siw *ServerInterfaceWrapper) CountWorkingDays(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -567,10 +3330,45 @@ func (siw *ServerInterfaceWrapper) UpdateDepartment(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CountWorkingDaysParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateDepartment(w, r, id)
+		siw.Handler.CountWorkingDays(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -580,8 +3378,8 @@ func (siw *ServerInterfaceWrapper) UpdateDepartment(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetEmployees operation middleware
-func (siw *ServerInterfaceWrapper) GetEmployees(w http.ResponseWriter, r *http.Request) {
+// GetSickLeaves operation middleware
+func (siw *ServerInterfaceWrapper) GetSickLeaves(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -589,16 +3387,26 @@ func (siw *ServerInterfaceWrapper) GetEmployees(w http.ResponseWriter, r *http.R
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEmployeesParams
+	var params GetSickLeavesParams
 
-	// ------------- Optional query parameter "role" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
@@ -610,16 +3418,24 @@ func (siw *ServerInterfaceWrapper) GetEmployees(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "employee_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "employee_id", r.URL.Query(), &params.EmployeeID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "employee_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "open" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open", r.URL.Query(), &params.Open)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "open", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEmployees(w, r, params)
+		siw.Handler.GetSickLeaves(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -629,17 +3445,30 @@ func (siw *ServerInterfaceWrapper) GetEmployees(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEmployee operation middleware
-func (siw *ServerInterfaceWrapper) CreateEmployee(w http.ResponseWriter, r *http.Request) {
+// CloseSickLeave operation middleware
+func (siw *ServerInterfaceWrapper) CloseSickLeave(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEmployee(w, r)
+		siw.Handler.CloseSickLeave(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -649,28 +3478,40 @@ func (siw *ServerInterfaceWrapper) CreateEmployee(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteEmployee operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEmployee(w http.ResponseWriter, r *http.Request) {
+// GetVacationRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetVacationRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id uint64
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVacationRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "employee_id" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "employee_id", r.URL.Query(), &params.EmployeeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "employee_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEmployee(w, r, id)
+		siw.Handler.GetVacationRequests(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -680,8 +3521,8 @@ func (siw *ServerInterfaceWrapper) DeleteEmployee(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetEmployeesByID operation middleware
-func (siw *ServerInterfaceWrapper) GetEmployeesByID(w http.ResponseWriter, r *http.Request) {
+// ApproveVacationRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveVacationRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -701,7 +3542,7 @@ func (siw *ServerInterfaceWrapper) GetEmployeesByID(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEmployeesByID(w, r, id)
+		siw.Handler.ApproveVacationRequest(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -711,8 +3552,8 @@ func (siw *ServerInterfaceWrapper) GetEmployeesByID(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateEmployee operation middleware
-func (siw *ServerInterfaceWrapper) UpdateEmployee(w http.ResponseWriter, r *http.Request) {
+// CancelVacationRequest operation middleware
+func (siw *ServerInterfaceWrapper) CancelVacationRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -732,7 +3573,7 @@ func (siw *ServerInterfaceWrapper) UpdateEmployee(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateEmployee(w, r, id)
+		siw.Handler.CancelVacationRequest(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -742,8 +3583,8 @@ func (siw *ServerInterfaceWrapper) UpdateEmployee(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// RequestVacation operation middleware
-func (siw *ServerInterfaceWrapper) RequestVacation(w http.ResponseWriter, r *http.Request) {
+// RejectVacationRequest operation middleware
+func (siw *ServerInterfaceWrapper) RejectVacationRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -763,7 +3604,7 @@ func (siw *ServerInterfaceWrapper) RequestVacation(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RequestVacation(w, r, id)
+		siw.Handler.RejectVacationRequest(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -886,12 +3727,102 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit-log", wrapper.GetAuditLog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/api-keys", wrapper.GetAPIKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/api-keys", wrapper.CreateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/api-keys/{id}", wrapper.DeleteAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/auth/api-keys/{id}", wrapper.UpdateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/calendar-tokens", wrapper.GetCalendarTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/calendar-tokens", wrapper.CreateCalendarToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/calendar-tokens/{id}", wrapper.DeleteCalendarToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/lockouts", wrapper.GetLockouts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/lockouts/{scope}/{value}", wrapper.ClearLockout)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/login", wrapper.AuthLogin)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/logout", wrapper.AuthLogout)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa/disable", wrapper.DisableMFA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa/enable", wrapper.EnableMFA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa/enroll", wrapper.EnrollMFA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa/verify", wrapper.VerifyMFA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oidc/callback", wrapper.OIDCCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oidc/login", wrapper.OIDCLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password", wrapper.ChangePassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password/reset", wrapper.ResetPassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.AuthRefresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/sessions", wrapper.RevokeAllSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/sessions", wrapper.GetSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/sessions/{id}", wrapper.RevokeSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendars", wrapper.GetCalendars)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/calendars", wrapper.CreateCalendar)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendars/departments/{id}.ics", wrapper.GetDepartmentAbsenceFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendars/employees/{id}.ics", wrapper.GetEmployeeAbsenceFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/calendars/{id}", wrapper.DeleteCalendar)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendars/{id}/days", wrapper.GetCalendarDays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/calendars/{id}/days/import", wrapper.ImportCalendarDays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/calendars/{id}/days/{date}", wrapper.DeleteCalendarDay)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/departments", wrapper.GetDepartments)
 	})
@@ -904,6 +3835,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/departments/{id}", wrapper.GetDepartmentByID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/departments/{id}", wrapper.PatchDepartment)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/departments/{id}", wrapper.UpdateDepartment)
 	})
@@ -913,18 +3847,69 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/employees", wrapper.CreateEmployee)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/employees/search", wrapper.SearchEmployees)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/employees/{id}", wrapper.DeleteEmployee)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/employees/{id}", wrapper.GetEmployeesByID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/employees/{id}", wrapper.PatchEmployee)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/employees/{id}", wrapper.UpdateEmployee)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/employees/{id}/impersonate", wrapper.Impersonate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/employees/{id}/leave/adjustments", wrapper.AdjustLeave)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/employees/{id}/leave/balance", wrapper.GetLeaveBalance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/employees/{id}/leave/ledger", wrapper.GetLeaveLedger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/employees/{id}/password-reset", wrapper.CreatePasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/employees/{id}/purge", wrapper.PurgeEmployee)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/employees/{id}/restore", wrapper.RestoreEmployee)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/employees/{id}/sick-leaves", wrapper.RegisterSickLeave)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/employees/{id}/vacation", wrapper.RequestVacation)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/employees/{id}/working-days", wrapper.CountWorkingDays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/sick-leaves", wrapper.GetSickLeaves)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/sick-leaves/{id}/close", wrapper.CloseSickLeave)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacation-requests", wrapper.GetVacationRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vacation-requests/{id}/approve", wrapper.ApproveVacationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vacation-requests/{id}/cancel", wrapper.CancelVacationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vacation-requests/{id}/reject", wrapper.RejectVacationRequest)
+	})
 
 	return r
 }
@@ -3,12 +3,18 @@ package api
 //go:generate go tool oapi-codegen -config ../../../configs/cfg.yaml ../../../cmd/api.swagger.yaml
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"math"
+	"net"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/adamanr/employes_service/internal/controllers"
 	"github.com/adamanr/employes_service/internal/entity"
@@ -23,9 +29,17 @@ func NewServer(deps *controllers.Dependens) *Server {
 	return &Server{
 		deps: deps,
 		Controllers: &controllers.Controllers{
-			AuthController:       controllers.NewAuthController(deps),
-			DepartmentController: controllers.NewDepartmentController(deps),
-			EmployeeController:   controllers.NewEmployeeController(deps),
+			AuthController:         controllers.NewAuthController(deps),
+			APIKeyController:       controllers.NewAPIKeyController(deps),
+			AuditController:        controllers.NewAuditController(deps),
+			CalendarController:     controllers.NewCalendarController(deps),
+			CalendarFeedController: controllers.NewCalendarFeedController(deps),
+			DepartmentController:   controllers.NewDepartmentController(deps),
+			EmployeeController:     controllers.NewEmployeeController(deps),
+			IdempotencyController:  controllers.NewIdempotencyController(deps),
+			LeaveController:        controllers.NewLeaveController(deps),
+			SickLeaveController:    controllers.NewSickLeaveController(deps),
+			VacationController:     controllers.NewVacationController(deps),
 		},
 	}
 }
@@ -49,13 +63,16 @@ func (s Server) getUserFromToken(r *http.Request) (*entity.Claims, error) {
 	return claims, nil
 }
 
-// func (s Server) requireAdminOrHR(user *entity.Claims) error {
-// 	if user.Role != "admin" && user.Role != "hr" {
-// 		return errors.New("insufficient permissions")
-// 	}
+// clientIP returns the address of the caller. X-Forwarded-For is honoured only
+// when the peer is one of the configured trusted proxies.
+func (s Server) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
 
-// 	return nil
-// }
+	return controllers.ClientIP(host, r.Header.Values("X-Forwarded-For"), s.deps.Config.Server.TrustedProxies)
+}
 
 // AuthLogin authenticates a user and returns a JWT token.
 func (s Server) AuthLogin(w http.ResponseWriter, r *http.Request) {
@@ -66,9 +83,86 @@ func (s Server) AuthLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accessToken, refreshToken, err := s.Controllers.AuthController.AuthLogin(&req)
+	req.ClientIP = s.clientIP(r)
+
+	resp, err := s.Controllers.AuthController.AuthLogin(&req)
 	if err != nil {
 		s.deps.Logger.Error("Error logging in", slog.String("error", err.Error()))
+
+		var locked *controllers.LockedError
+		if errors.As(err, &locked) {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+			s.httpResponse(w, http.StatusTooManyRequests, map[string]string{"error": controllers.ErrLoginLocked.Error()}, "error")
+			return
+		}
+
+		if errors.Is(err, controllers.ErrInvalidCredentials) {
+			s.httpResponse(w, http.StatusUnauthorized, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		if errors.Is(err, controllers.ErrPasswordLoginDisabled) || errors.Is(err, controllers.ErrAccountInactive) {
+			s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to log in", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, resp, "success")
+}
+
+// OIDCLogin redirects to the login page of the company IdP.
+func (s Server) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	authURL, err := s.Controllers.AuthController.StartOIDCLogin()
+	if err != nil {
+		s.deps.Logger.Error("Error starting oidc login", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrOIDCDisabled) {
+			s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to start oidc login", "error")
+		return
+	}
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// OIDCCallback completes the IdP login and returns a JWT token pair, or an MFA
+// token if the employee has two-factor authentication enabled.
+func (s Server) OIDCCallback(w http.ResponseWriter, _ *http.Request, params OIDCCallbackParams) {
+	resp, err := s.Controllers.AuthController.FinishOIDCLogin(params.Code, params.State)
+	if err != nil {
+		s.deps.Logger.Error("Error finishing oidc login", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidOIDCState), errors.Is(err, controllers.ErrInvalidIDToken):
+			s.httpResponse(w, http.StatusUnauthorized, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrOIDCDisabled), errors.Is(err, controllers.ErrOIDCUnknownUser),
+			errors.Is(err, controllers.ErrAccountInactive):
+			s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to log in", "error")
+		}
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, resp, "success")
+}
+
+// AuthRefresh rotates the refresh token and returns a new token pair.
+func (s Server) AuthRefresh(w http.ResponseWriter, r *http.Request) {
+	var req entity.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": "Invalid request body"}, "error")
+		return
+	}
+
+	accessToken, refreshToken, err := s.Controllers.AuthController.AuthRefresh(req.RefreshToken)
+	if err != nil {
+		s.deps.Logger.Error("Error refreshing token", slog.String("error", err.Error()))
 		s.httpResponse(w, http.StatusUnauthorized, map[string]string{"error": err.Error()}, "error")
 		return
 	}
@@ -79,20 +173,76 @@ func (s Server) AuthLogin(w http.ResponseWriter, r *http.Request) {
 	}, "success")
 }
 
+// ChangePassword changes the password of the current user and returns a new token pair.
+func (s Server) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpChangePassword, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var req entity.ChangePasswordRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": "Invalid request body"}, "error")
+		return
+	}
+
+	accessToken, refreshToken, err := s.Controllers.AuthController.ChangePassword(user, &req)
+	if err != nil {
+		s.deps.Logger.Error("Error changing password", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrInvalidPassword) || errors.Is(err, controllers.ErrEmptyPassword) ||
+			errors.Is(err, controllers.ErrSamePassword) || errors.Is(err, controllers.ErrWeakPassword) {
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to change password", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, entity.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, "success")
+}
+
+// ResetPassword sets a new password using a reset token.
+func (s Server) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req entity.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": "Invalid request body"}, "error")
+		return
+	}
+
+	if err := s.Controllers.AuthController.ResetPassword(&req); err != nil {
+		s.deps.Logger.Error("Error resetting password", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrInvalidResetToken) || errors.Is(err, controllers.ErrEmptyPassword) ||
+			errors.Is(err, controllers.ErrWeakPassword) {
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to reset password", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, map[string]string{"message": "Password changed successfully"}, "success")
+}
+
 // AuthLogout make logout user.
 func (s Server) AuthLogout(w http.ResponseWriter, r *http.Request) {
-	authHeader := r.Header.Get("Authorization")
-	tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-
-	ctx := context.Background()
-	if err := s.deps.Redis.Del(ctx, "access_token:"+tokenStr).Err(); err != nil {
-		s.deps.Logger.Error("Error deleting access token from Redis", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to logout", "error")
+	user, err := s.checkAuthUser(r, controllers.OpAuthLogout, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	if err := s.deps.Redis.Del(ctx, "refresh_token:*").Err(); err != nil {
-		s.deps.Logger.Error("Error deleting refresh tokens from Redis", slog.String("error", err.Error()))
+	if err = s.Controllers.AuthController.AuthLogout(user); err != nil {
+		s.deps.Logger.Error("Error revoking session", slog.String("error", err.Error()))
 		s.httpResponse(w, http.StatusInternalServerError, "Failed to logout", "error")
 		return
 	}
@@ -100,259 +250,1727 @@ func (s Server) AuthLogout(w http.ResponseWriter, r *http.Request) {
 	s.httpResponse(w, http.StatusOK, map[string]string{"message": "Logged out successfully"}, "success")
 }
 
-// GetDepartments get all departments.
-func (s Server) GetDepartments(w http.ResponseWriter, r *http.Request) {
-	if err := s.checkAuthUser(r); err != nil {
+// GetSessions get active sessions of the current user.
+func (s Server) GetSessions(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpGetSessions, nil)
+	if err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	departments, err := s.Controllers.DepartmentController.GetDepartments()
+	sessions, err := s.Controllers.AuthController.ListSessions(user)
 	if err != nil {
-		s.deps.Logger.Error("Error getting departments", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to get departments", "error")
+		s.deps.Logger.Error("Error getting sessions", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get sessions", "error")
 		return
 	}
 
-	s.httpResponse(w, http.StatusOK, departments, "success")
+	s.httpResponse(w, http.StatusOK, sessions, "success")
 }
 
-// GetDepartmentByID get department by id.
-func (s Server) GetDepartmentByID(w http.ResponseWriter, r *http.Request, id uint64) {
-	if err := s.checkAuthUser(r); err != nil {
+// RevokeSession revoke one session of the current user.
+func (s Server) RevokeSession(w http.ResponseWriter, r *http.Request, id string) {
+	user, err := s.checkAuthUser(r, controllers.OpRevokeSession, nil)
+	if err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	department, err := s.Controllers.DepartmentController.GetDepartmentByID(id)
-	if err != nil {
-		s.deps.Logger.Error("Error getting department", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to get department", "error")
+	if err = s.Controllers.AuthController.RevokeSession(user, id); err != nil {
+		s.deps.Logger.Error("Error revoking session", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrSessionNotFound) {
+			s.httpResponse(w, http.StatusNotFound, "Session not found", "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to revoke session", "error")
 		return
 	}
 
-	s.httpResponse(w, http.StatusOK, department, "success")
+	s.httpResponse(w, http.StatusOK, map[string]string{"message": "Session revoked successfully"}, "success")
 }
 
-// CreateDepartment create new department.
-//
-//nolint:dupl // This is not duplicate!!
-func (s Server) CreateDepartment(w http.ResponseWriter, r *http.Request) {
-	if err := s.checkAuthUser(r); err != nil {
+// RevokeAllSessions revoke every session of the current user.
+func (s Server) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpRevokeAllSessions, nil)
+	if err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	var dept entity.Department
-	if err := json.NewDecoder(r.Body).Decode(&dept); err != nil {
-		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+	if err = s.Controllers.AuthController.RevokeAllSessions(user); err != nil {
+		s.deps.Logger.Error("Error revoking sessions", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to revoke sessions", "error")
 		return
 	}
 
-	department, err := s.Controllers.DepartmentController.CreateDepartment(dept)
+	s.httpResponse(w, http.StatusOK, map[string]string{"message": "Sessions revoked successfully"}, "success")
+}
+
+// GetCalendarTokens returns the calendar tokens of the current user.
+func (s Server) GetCalendarTokens(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpGetCalendarTokens, nil)
 	if err != nil {
-		s.deps.Logger.Error("Error creating department", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to create department", "error")
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	s.httpResponse(w, http.StatusCreated, department, "success")
+	tokens, err := s.Controllers.CalendarFeedController.GetCalendarTokens(user)
+	if err != nil {
+		s.deps.Logger.Error("Error getting calendar tokens", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get calendar tokens", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, tokens, "success")
 }
 
-// UpdateDepartment update department by id.
-//
-//nolint:dupl // This is not duplicate!!
-func (s Server) UpdateDepartment(w http.ResponseWriter, r *http.Request, id uint64) {
-	if err := s.checkAuthUser(r); err != nil {
+// CreateCalendarToken creates a calendar token of the current user and returns its secret once.
+func (s Server) CreateCalendarToken(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpCreateCalendarToken, nil)
+	if err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	var dept entity.Department
-	if err := json.NewDecoder(r.Body).Decode(&dept); err != nil {
+	var form entity.CalendarTokenForm
+	if err = json.NewDecoder(r.Body).Decode(&form); err != nil {
 		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
 		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
 		return
 	}
 
-	department, err := s.Controllers.DepartmentController.UpdateDepartment(dept, id)
+	token, err := s.Controllers.CalendarFeedController.CreateCalendarToken(user, form)
 	if err != nil {
-		s.deps.Logger.Error("Error updating department", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to update department", "error")
+		s.deps.Logger.Error("Error creating calendar token", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrInvalidCalendarTokenForm) {
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to create calendar token", "error")
 		return
 	}
 
-	s.httpResponse(w, http.StatusOK, department, "success")
+	s.httpResponse(w, http.StatusCreated, token, "success")
 }
 
-// DeleteDepartment delete department.
-func (s Server) DeleteDepartment(w http.ResponseWriter, r *http.Request, id uint64) {
-	if err := s.checkAuthUser(r); err != nil {
+// DeleteCalendarToken revokes a calendar token of the current user.
+func (s Server) DeleteCalendarToken(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpDeleteCalendarToken, nil)
+	if err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	if err := s.Controllers.DepartmentController.DeleteDepartment(id); err != nil {
-		s.deps.Logger.Error("Error deleting department", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to delete department", "error")
+	if err = s.Controllers.CalendarFeedController.DeleteCalendarToken(user, id); err != nil {
+		s.deps.Logger.Error("Error deleting calendar token", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrCalendarTokenNotFound) {
+			s.httpResponse(w, http.StatusNotFound, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to delete calendar token", "error")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// GetEmployees get all employees.
-func (s Server) GetEmployees(w http.ResponseWriter, r *http.Request, params GetEmployeesParams) {
-	if err := s.checkAuthUser(r); err != nil {
+// EnrollMFA generates a new TOTP secret for the current user.
+func (s Server) EnrollMFA(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpEnrollMFA, nil)
+	if err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	entityParams := entity.GetEmployeesParams(params)
-
-	employees, err := s.Controllers.EmployeeController.GetEmployees(&entityParams)
+	enrollment, err := s.Controllers.AuthController.EnrollMFA(user)
 	if err != nil {
-		s.deps.Logger.Error("Error getting employees", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to get employees", "error")
+		s.deps.Logger.Error("Error enrolling mfa", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrMFAAlreadyEnabled) {
+			s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to enroll mfa", "error")
 		return
 	}
 
-	s.httpResponse(w, http.StatusOK, employees, "success")
+	s.httpResponse(w, http.StatusOK, enrollment, "success")
 }
 
-// GetEmployeesByID get employee by id.
-func (s Server) GetEmployeesByID(w http.ResponseWriter, r *http.Request, id uint64) {
-	if err := s.checkAuthUser(r); err != nil {
+// EnableMFA confirms the TOTP secret of the current user.
+func (s Server) EnableMFA(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpEnableMFA, nil)
+	if err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	employee, err := s.Controllers.EmployeeController.GetEmployeeByID(id)
+	var req entity.MFACodeRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": "Invalid request body"}, "error")
+		return
+	}
+
+	enabled, err := s.Controllers.AuthController.EnableMFA(user, &req)
 	if err != nil {
-		s.deps.Logger.Error("Error getting employee", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to get employee", "error")
+		s.deps.Logger.Error("Error enabling mfa", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidMFACode), errors.Is(err, controllers.ErrMFANotEnrolled):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrMFAAlreadyEnabled):
+			s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to enable mfa", "error")
+		}
 		return
 	}
 
-	s.httpResponse(w, http.StatusOK, employee, "success")
+	s.httpResponse(w, http.StatusOK, enabled, "success")
 }
 
-// CreateEmployee create new employee.
-//
-//nolint:dupl // This is not duplicate!!
-func (s Server) CreateEmployee(w http.ResponseWriter, r *http.Request) {
-	if err := s.checkAuthUser(r); err != nil {
+// DisableMFA turns off TOTP of the current user.
+func (s Server) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpDisableMFA, nil)
+	if err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	var emp entity.Employee
-	if err := json.NewDecoder(r.Body).Decode(&emp); err != nil {
+	var req entity.MFACodeRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
 		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": "Invalid request body"}, "error")
+		return
+	}
+
+	if err = s.Controllers.AuthController.DisableMFA(user, &req); err != nil {
+		s.deps.Logger.Error("Error disabling mfa", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidMFACode), errors.Is(err, controllers.ErrMFANotEnrolled):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrMFARequired):
+			s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to disable mfa", "error")
+		}
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, map[string]string{"message": "Two-factor authentication disabled"}, "success")
+}
+
+// VerifyMFA exchanges the mfa token and the second factor for a token pair.
+func (s Server) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req entity.MFAVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": "Invalid request body"}, "error")
 		return
 	}
 
-	employee, err := s.Controllers.EmployeeController.CreateEmployee(emp)
+	req.ClientIP = s.clientIP(r)
+
+	accessToken, refreshToken, err := s.Controllers.AuthController.VerifyMFA(&req)
 	if err != nil {
-		s.deps.Logger.Error("Error creating employee", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to create employee", "error")
+		s.deps.Logger.Error("Error verifying mfa", slog.String("error", err.Error()))
+
+		var locked *controllers.LockedError
+		if errors.As(err, &locked) {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+			s.httpResponse(w, http.StatusTooManyRequests, map[string]string{"error": controllers.ErrLoginLocked.Error()}, "error")
+			return
+		}
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidMFACode):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrInvalidMFAToken), errors.Is(err, controllers.ErrMFANotEnrolled):
+			s.httpResponse(w, http.StatusUnauthorized, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrAccountInactive):
+			s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to verify mfa", "error")
+		}
 		return
 	}
 
-	s.httpResponse(w, http.StatusCreated, employee, "success")
+	s.httpResponse(w, http.StatusOK, entity.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, "success")
 }
 
-// RequestVacation make request to vacate employee.
-func (s Server) RequestVacation(w http.ResponseWriter, r *http.Request, id uint64) {
-	if err := s.checkAuthUser(r); err != nil {
+// GetLockouts returns the active login lockouts.
+func (s Server) GetLockouts(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetLockouts, nil); err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	var req entity.VacationRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	lockouts, err := s.Controllers.AuthController.ListLockouts()
+	if err != nil {
+		s.deps.Logger.Error("Error getting lockouts", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get lockouts", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, lockouts, "success")
+}
+
+// ClearLockout lifts the login lockout of an email or a client IP.
+func (s Server) ClearLockout(w http.ResponseWriter, r *http.Request, scope ClearLockoutParamsScope, value string) {
+	user, err := s.checkAuthUser(r, controllers.OpClearLockout, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	if err = s.Controllers.AuthController.ClearLockout(user, string(scope), value); err != nil {
+		s.deps.Logger.Error("Error clearing lockout", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidLockout):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrLockoutNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Lockout not found", "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to clear lockout", "error")
+		}
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, map[string]string{"message": "Lockout cleared successfully"}, "success")
+}
+
+// GetAPIKeys returns the API keys of other services.
+func (s Server) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetAPIKeys, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	keys, err := s.Controllers.APIKeyController.GetAPIKeys()
+	if err != nil {
+		s.deps.Logger.Error("Error getting api keys", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get api keys", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, keys, "success")
+}
+
+// CreateAPIKey creates an API key and returns its secret once.
+func (s Server) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	user, err := s.checkAuthUser(r, controllers.OpCreateAPIKey, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var form entity.APIKeyForm
+	if err = json.NewDecoder(r.Body).Decode(&form); err != nil {
 		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
 		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
 		return
 	}
 
-	updateEmp, err := s.Controllers.EmployeeController.RequestVacation(id, req.Days)
+	key, err := s.Controllers.APIKeyController.CreateAPIKey(user, form)
 	if err != nil {
-		s.deps.Logger.Error("Error updating employee", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to update employee", "error")
+		s.deps.Logger.Error("Error creating api key", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrInvalidAPIKeyForm) {
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to create api key", "error")
 		return
 	}
 
-	s.httpResponse(w, http.StatusOK, updateEmp, "success")
+	s.httpResponse(w, http.StatusCreated, key, "success")
 }
 
-// UpdateEmployee is method to update employee.
-//
-//nolint:dupl // This is not duplicate!!
-func (s Server) UpdateEmployee(w http.ResponseWriter, r *http.Request, id uint64) {
-	if err := s.checkAuthUser(r); err != nil {
+// UpdateAPIKey changes the name, owner, scopes and expiry of an API key.
+func (s Server) UpdateAPIKey(w http.ResponseWriter, r *http.Request, id uint64) {
+	if _, err := s.checkAuthUser(r, controllers.OpUpdateAPIKey, nil); err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	var emp entity.Employee
-	if err := json.NewDecoder(r.Body).Decode(&emp); err != nil {
+	var form entity.APIKeyForm
+	if err := json.NewDecoder(r.Body).Decode(&form); err != nil {
 		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
 		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
 		return
 	}
 
-	updateEmp, err := s.Controllers.EmployeeController.UpdateEmployee(id, emp)
+	key, err := s.Controllers.APIKeyController.UpdateAPIKey(id, form)
 	if err != nil {
-		s.deps.Logger.Error("Error updating employee", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to update employee", "error")
+		s.deps.Logger.Error("Error updating api key", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrInvalidAPIKeyForm):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrAPIKeyNotFound):
+			s.httpResponse(w, http.StatusNotFound, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to update api key", "error")
+		}
 		return
 	}
 
-	s.httpResponse(w, http.StatusOK, updateEmp, "success")
+	s.httpResponse(w, http.StatusOK, key, "success")
 }
 
-// DeleteEmployee implements ServerInterface.
-func (s Server) DeleteEmployee(w http.ResponseWriter, r *http.Request, id uint64) {
-	if err := s.checkAuthUser(r); err != nil {
+// DeleteAPIKey revokes an API key.
+func (s Server) DeleteAPIKey(w http.ResponseWriter, r *http.Request, id uint64) {
+	if _, err := s.checkAuthUser(r, controllers.OpDeleteAPIKey, nil); err != nil {
 		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+		s.authErrorResponse(w, err)
 		return
 	}
 
-	if err := s.Controllers.EmployeeController.DeleteEmployee(id); err != nil {
-		s.deps.Logger.Error("Error deleting employee", slog.String("error", err.Error()))
-		s.httpResponse(w, http.StatusInternalServerError, "Failed to delete employee", "error")
+	if err := s.Controllers.APIKeyController.DeleteAPIKey(id); err != nil {
+		s.deps.Logger.Error("Error deleting api key", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrAPIKeyNotFound) {
+			s.httpResponse(w, http.StatusNotFound, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to delete api key", "error")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (s Server) checkAuthUser(r *http.Request) error {
-	if _, err := s.getUserFromToken(r); err != nil {
-		s.deps.Logger.Warn("Unauthorized vacation request attempt", slog.String("error", err.Error()))
-		return errors.New("Error getting user from token")
+// GetDepartments get all departments.
+func (s Server) GetDepartments(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetDepartments, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	departments, err := s.Controllers.DepartmentController.GetDepartments()
+	if err != nil {
+		s.deps.Logger.Error("Error getting departments", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get departments", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, departments, "success")
+}
+
+// GetDepartmentByID get department by id.
+func (s Server) GetDepartmentByID(w http.ResponseWriter, r *http.Request, id uint64) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetDepartmentByID, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	department, err := s.Controllers.DepartmentController.GetDepartmentByID(id)
+	if err != nil {
+		s.deps.Logger.Error("Error getting department", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get department", "error")
+		return
 	}
 
-	// if roleErr := s.requireAdminOrHR(user); roleErr != nil {
-	// 	s.deps.Logger.Error("Error checking role", slog.String("error", roleErr.Error()))
-	// 	return errors.New("insufficient permissions")
-	// }
+	setETag(w, department.Version)
+	s.httpResponse(w, http.StatusOK, department, "success")
+}
+
+// CreateDepartment create new department.
+//
+//nolint:dupl // This is not duplicate!!
+func (s Server) CreateDepartment(w http.ResponseWriter, r *http.Request, params CreateDepartmentParams) {
+	user, err := s.checkAuthUser(r, controllers.OpCreateDepartment, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var dept entity.Department
+	if err = json.NewDecoder(r.Body).Decode(&dept); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	department, replayed, err := controllers.Idempotent(r.Context(), s.Controllers.IdempotencyController,
+		idempotentRequest(controllers.OpCreateDepartment, user, params.IdempotencyKey, dept),
+		func() (*entity.Department, error) {
+			return s.Controllers.DepartmentController.CreateDepartment(dept)
+		})
+	if err != nil {
+		s.deps.Logger.Error("Error creating department", slog.String("error", err.Error()))
+		if s.idempotencyErrorResponse(w, err) {
+			return
+		}
+
+		if errors.Is(err, controllers.ErrInvalidDepartment) {
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to create department", "error")
+		return
+	}
+
+	setReplayed(w, replayed)
+	setETag(w, department.Version)
+	s.httpResponse(w, http.StatusCreated, department, "success")
+}
+
+// UpdateDepartment update department by id.
+//
+//nolint:dupl // This is not duplicate!!
+func (s Server) UpdateDepartment(w http.ResponseWriter, r *http.Request, id uint64, params UpdateDepartmentParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpUpdateDepartment, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		s.deps.Logger.Warn("Invalid If-Match header", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		return
+	}
+
+	var dept entity.Department
+	if err := json.NewDecoder(r.Body).Decode(&dept); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	department, err := s.Controllers.DepartmentController.UpdateDepartment(dept, id, version)
+	if err != nil {
+		s.deps.Logger.Error("Error updating department", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidDepartment):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrVersionRequired):
+			s.httpResponse(w, http.StatusPreconditionRequired, "If-Match header is required", "error")
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrDepartmentNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Department not found", "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to update department", "error")
+		}
+
+		return
+	}
+
+	setETag(w, department.Version)
+	s.httpResponse(w, http.StatusOK, department, "success")
+}
+
+// PatchDepartment update the given fields of the department.
+func (s Server) PatchDepartment(w http.ResponseWriter, r *http.Request, id uint64, params PatchDepartmentParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpUpdateDepartment, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		s.deps.Logger.Warn("Invalid If-Match header", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		return
+	}
+
+	var patch entity.DepartmentPatch
+	fields, err := decodeMergePatch(r, &patch.Department)
+	if err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	patch.Fields = fields
+
+	department, err := s.Controllers.DepartmentController.PatchDepartment(id, patch, version)
+	if err != nil {
+		s.deps.Logger.Error("Error patching department", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidPatch):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrDepartmentNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Department not found", "error")
+		case errors.Is(err, controllers.ErrVersionRequired):
+			s.httpResponse(w, http.StatusPreconditionRequired, "If-Match header is required", "error")
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to update department", "error")
+		}
+
+		return
+	}
+
+	setETag(w, department.Version)
+	s.httpResponse(w, http.StatusOK, department, "success")
+}
+
+// DeleteDepartment delete department.
+func (s Server) DeleteDepartment(w http.ResponseWriter, r *http.Request, id uint64, params DeleteDepartmentParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpDeleteDepartment, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		s.deps.Logger.Warn("Invalid If-Match header", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		return
+	}
+
+	if err = s.Controllers.DepartmentController.DeleteDepartment(id, version); err != nil {
+		s.deps.Logger.Error("Error deleting department", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrVersionRequired):
+			s.httpResponse(w, http.StatusPreconditionRequired, "If-Match header is required", "error")
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to delete department", "error")
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetEmployees get all employees.
+func (s Server) GetEmployees(w http.ResponseWriter, r *http.Request, params GetEmployeesParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetEmployees, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	entityParams := entity.GetEmployeesParams(params)
+
+	page, err := s.Controllers.EmployeeController.GetEmployees(&entityParams)
+	if err != nil {
+		s.deps.Logger.Error("Error getting employees", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidEmployeesFilter),
+			errors.Is(err, controllers.ErrInvalidPageSize),
+			errors.Is(err, controllers.ErrInvalidSortField),
+			errors.Is(err, controllers.ErrInvalidSortOrder),
+			errors.Is(err, controllers.ErrInvalidPageToken):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to get employees", "error")
+		}
+
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, page, "success")
+}
+
+// SearchEmployees search employees by names, contacts and personal number.
+func (s Server) SearchEmployees(w http.ResponseWriter, r *http.Request, params SearchEmployeesParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpSearchEmployees, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	entityParams := entity.SearchEmployeesParams(params)
+
+	employees, err := s.Controllers.EmployeeController.SearchEmployees(&entityParams)
+	if err != nil {
+		s.deps.Logger.Error("Error searching employees", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrEmptySearchQuery), errors.Is(err, controllers.ErrInvalidPageSize):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to search employees", "error")
+		}
+
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, employees, "success")
+}
+
+// GetEmployeesByID get employee by id.
+func (s Server) GetEmployeesByID(w http.ResponseWriter, r *http.Request, id uint64) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetEmployeeByID, &id); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	employee, err := s.Controllers.EmployeeController.GetEmployeeByID(id)
+	if err != nil {
+		s.deps.Logger.Error("Error getting employee", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get employee", "error")
+		return
+	}
+
+	setETag(w, employee.Version)
+	s.httpResponse(w, http.StatusOK, employee, "success")
+}
+
+// CreateEmployee create new employee.
+//
+//nolint:dupl // This is not duplicate!!
+func (s Server) CreateEmployee(w http.ResponseWriter, r *http.Request, params CreateEmployeeParams) {
+	user, err := s.checkAuthUser(r, controllers.OpCreateEmployee, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var emp entity.Employee
+	if err = json.NewDecoder(r.Body).Decode(&emp); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	employee, replayed, err := controllers.Idempotent(r.Context(), s.Controllers.IdempotencyController,
+		idempotentRequest(controllers.OpCreateEmployee, user, params.IdempotencyKey, emp),
+		func() (*entity.Employee, error) {
+			return s.Controllers.EmployeeController.CreateEmployee(emp)
+		})
+	if err != nil {
+		s.deps.Logger.Error("Error creating employee", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrWeakPassword) {
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+			return
+		}
+
+		if s.idempotencyErrorResponse(w, err) {
+			return
+		}
+
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to create employee", "error")
+		return
+	}
+
+	setReplayed(w, replayed)
+	setETag(w, employee.Version)
+	s.httpResponse(w, http.StatusCreated, employee, "success")
+}
+
+// RequestVacation submits a vacation request of the employee.
+func (s Server) RequestVacation(w http.ResponseWriter, r *http.Request, id uint64) {
+	if _, err := s.checkAuthUser(r, controllers.OpRequestVacation, &id); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var form entity.VacationRequestForm
+	if err := json.NewDecoder(r.Body).Decode(&form); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	request, err := s.Controllers.VacationController.SubmitVacationRequest(id, form)
+	if err != nil {
+		s.deps.Logger.Error("Error submitting vacation request", slog.String("error", err.Error()))
+		if errors.Is(err, controllers.ErrEmployeeNotFound) {
+			s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+			return
+		}
+
+		s.vacationErrorResponse(w, err, "Failed to submit vacation request")
+		return
+	}
+
+	s.httpResponse(w, http.StatusCreated, request, "success")
+}
+
+// GetVacationRequests returns the vacation requests visible to the user.
+func (s Server) GetVacationRequests(w http.ResponseWriter, r *http.Request, params GetVacationRequestsParams) {
+	user, err := s.checkAuthUser(r, controllers.OpGetVacationRequests, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	entityParams := entity.GetVacationRequestsParams(params)
+
+	requests, err := s.Controllers.VacationController.GetVacationRequests(user, &entityParams)
+	if err != nil {
+		s.deps.Logger.Error("Error getting vacation requests", slog.String("error", err.Error()))
+		s.vacationErrorResponse(w, err, "Failed to get vacation requests")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, requests, "success")
+}
+
+// ApproveVacationRequest approves the vacation request at its current stage.
+func (s Server) ApproveVacationRequest(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpApproveVacationRequest, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	request, err := s.Controllers.VacationController.ApproveVacationRequest(user, id)
+	if err != nil {
+		s.deps.Logger.Error("Error approving vacation request", slog.String("error", err.Error()))
+		s.vacationErrorResponse(w, err, "Failed to approve vacation request")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, request, "success")
+}
+
+// RejectVacationRequest rejects the vacation request at its current stage.
+func (s Server) RejectVacationRequest(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpRejectVacationRequest, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	// The reason is optional, so is the body.
+	var decision entity.VacationDecision
+	if err = json.NewDecoder(r.Body).Decode(&decision); err != nil && !errors.Is(err, io.EOF) {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	request, err := s.Controllers.VacationController.RejectVacationRequest(user, id, decision)
+	if err != nil {
+		s.deps.Logger.Error("Error rejecting vacation request", slog.String("error", err.Error()))
+		s.vacationErrorResponse(w, err, "Failed to reject vacation request")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, request, "success")
+}
+
+// CancelVacationRequest withdraws the vacation request.
+func (s Server) CancelVacationRequest(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpCancelVacationRequest, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	request, err := s.Controllers.VacationController.CancelVacationRequest(user, id)
+	if err != nil {
+		s.deps.Logger.Error("Error cancelling vacation request", slog.String("error", err.Error()))
+		s.vacationErrorResponse(w, err, "Failed to cancel vacation request")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, request, "success")
+}
+
+// GetLeaveBalance returns the leave balances of the employee.
+func (s Server) GetLeaveBalance(w http.ResponseWriter, r *http.Request, id uint64, params GetLeaveBalanceParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetLeaveBalance, &id); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	balances, err := s.Controllers.LeaveController.GetLeaveBalance(id, params.AsOf)
+	if err != nil {
+		s.deps.Logger.Error("Error getting leave balance", slog.String("error", err.Error()))
+		s.leaveErrorResponse(w, err, "Failed to get leave balance")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, balances, "success")
+}
+
+// GetLeaveLedger returns the leave ledger entries of the employee.
+func (s Server) GetLeaveLedger(w http.ResponseWriter, r *http.Request, id uint64, params GetLeaveLedgerParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetLeaveLedger, &id); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	entityParams := entity.GetLeaveLedgerParams(params)
+
+	entries, err := s.Controllers.LeaveController.GetLeaveLedger(id, &entityParams)
+	if err != nil {
+		s.deps.Logger.Error("Error getting leave ledger", slog.String("error", err.Error()))
+		s.leaveErrorResponse(w, err, "Failed to get leave ledger")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, entries, "success")
+}
+
+// AdjustLeave posts a correction of the leave balance of the employee.
+func (s Server) AdjustLeave(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpAdjustLeave, &id)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var adjustment entity.LeaveAdjustment
+	if err = json.NewDecoder(r.Body).Decode(&adjustment); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	entry, err := s.Controllers.LeaveController.AdjustLeave(user, id, adjustment)
+	if err != nil {
+		s.deps.Logger.Error("Error adjusting leave", slog.String("error", err.Error()))
+		s.leaveErrorResponse(w, err, "Failed to adjust leave")
+		return
+	}
+
+	s.httpResponse(w, http.StatusCreated, entry, "success")
+}
+
+// RegisterSickLeave records a sick leave of the employee.
+func (s Server) RegisterSickLeave(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpRegisterSickLeave, &id)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var form entity.SickLeaveForm
+	if err = json.NewDecoder(r.Body).Decode(&form); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	leave, err := s.Controllers.SickLeaveController.RegisterSickLeave(user, id, form)
+	if err != nil {
+		s.deps.Logger.Error("Error registering sick leave", slog.String("error", err.Error()))
+		s.sickLeaveErrorResponse(w, err, "Failed to register sick leave")
+		return
+	}
+
+	s.httpResponse(w, http.StatusCreated, leave, "success")
+}
+
+// GetSickLeaves returns the sick leaves visible to the user.
+func (s Server) GetSickLeaves(w http.ResponseWriter, r *http.Request, params GetSickLeavesParams) {
+	user, err := s.checkAuthUser(r, controllers.OpGetSickLeaves, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	entityParams := entity.GetSickLeavesParams(params)
+
+	leaves, err := s.Controllers.SickLeaveController.GetSickLeaves(user, &entityParams)
+	if err != nil {
+		s.deps.Logger.Error("Error getting sick leaves", slog.String("error", err.Error()))
+		s.sickLeaveErrorResponse(w, err, "Failed to get sick leaves")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, leaves, "success")
+}
+
+// CloseSickLeave sets the last day of an open sick leave.
+func (s Server) CloseSickLeave(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpCloseSickLeave, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var closing entity.SickLeaveClose
+	if err = json.NewDecoder(r.Body).Decode(&closing); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	leave, err := s.Controllers.SickLeaveController.CloseSickLeave(user, id, closing)
+	if err != nil {
+		s.deps.Logger.Error("Error closing sick leave", slog.String("error", err.Error()))
+		s.sickLeaveErrorResponse(w, err, "Failed to close sick leave")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, leave, "success")
+}
+
+// calendarFormats maps the content types of the calendar import to the file formats.
+var calendarFormats = map[string]string{
+	"text/calendar": entity.CalendarFormatICS,
+	"text/csv":      entity.CalendarFormatCSV,
+}
+
+// CountWorkingDays returns the working days of the period by the calendar of the employee.
+func (s Server) CountWorkingDays(w http.ResponseWriter, r *http.Request, id uint64, params CountWorkingDaysParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpCountWorkingDays, &id); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	days, err := s.Controllers.CalendarController.CountWorkingDays(params.From, params.To, id)
+	if err != nil {
+		s.deps.Logger.Error("Error counting working days", slog.String("error", err.Error()))
+		s.calendarErrorResponse(w, err, "Failed to count working days")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, days, "success")
+}
+
+// GetCalendars returns the working-day calendars.
+func (s Server) GetCalendars(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetCalendars, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	calendars, err := s.Controllers.CalendarController.GetCalendars()
+	if err != nil {
+		s.deps.Logger.Error("Error getting calendars", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get calendars", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, calendars, "success")
+}
+
+// CreateCalendar creates an empty working-day calendar.
+func (s Server) CreateCalendar(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.checkAuthUser(r, controllers.OpCreateCalendar, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	var form entity.CalendarForm
+	if err := json.NewDecoder(r.Body).Decode(&form); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	calendar, err := s.Controllers.CalendarController.CreateCalendar(form)
+	if err != nil {
+		s.deps.Logger.Error("Error creating calendar", slog.String("error", err.Error()))
+		s.calendarErrorResponse(w, err, "Failed to create calendar")
+		return
+	}
+
+	s.httpResponse(w, http.StatusCreated, calendar, "success")
+}
+
+// DeleteCalendar deletes the calendar with its days.
+func (s Server) DeleteCalendar(w http.ResponseWriter, r *http.Request, id uint64) {
+	if _, err := s.checkAuthUser(r, controllers.OpDeleteCalendar, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	if err := s.Controllers.CalendarController.DeleteCalendar(id); err != nil {
+		s.deps.Logger.Error("Error deleting calendar", slog.String("error", err.Error()))
+		s.calendarErrorResponse(w, err, "Failed to delete calendar")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCalendarDays returns the holidays and transferred working days of the calendar.
+func (s Server) GetCalendarDays(w http.ResponseWriter, r *http.Request, id uint64, params GetCalendarDaysParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetCalendarDays, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	entityParams := entity.GetCalendarDaysParams(params)
+
+	days, err := s.Controllers.CalendarController.GetCalendarDays(id, &entityParams)
+	if err != nil {
+		s.deps.Logger.Error("Error getting calendar days", slog.String("error", err.Error()))
+		s.calendarErrorResponse(w, err, "Failed to get calendar days")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, days, "success")
+}
+
+// ImportCalendarDays adds the days of the .ics or CSV file in the body to the calendar.
+func (s Server) ImportCalendarDays(w http.ResponseWriter, r *http.Request, id uint64) {
+	if _, err := s.checkAuthUser(r, controllers.OpImportCalendarDays, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	mediaType, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";")
+
+	format, ok := calendarFormats[strings.ToLower(strings.TrimSpace(mediaType))]
+	if !ok {
+		s.httpResponse(w, http.StatusUnsupportedMediaType, "Content-Type must be text/calendar or text/csv", "error")
+		return
+	}
+
+	// One byte over the limit lets the controller reject a file that is too large.
+	content, err := io.ReadAll(io.LimitReader(r.Body, controllers.MaxCalendarImportSize+1))
+	if err != nil {
+		s.deps.Logger.Error("Error reading request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	result, err := s.Controllers.CalendarController.ImportCalendarDays(id, format, content)
+	if err != nil {
+		s.deps.Logger.Error("Error importing calendar days", slog.String("error", err.Error()))
+		s.calendarErrorResponse(w, err, "Failed to import calendar days")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, result, "success")
+}
+
+// DeleteCalendarDay removes the day from the calendar.
+func (s Server) DeleteCalendarDay(w http.ResponseWriter, r *http.Request, id uint64, date time.Time) {
+	if _, err := s.checkAuthUser(r, controllers.OpDeleteCalendarDay, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	if err := s.Controllers.CalendarController.DeleteCalendarDay(id, date); err != nil {
+		s.deps.Logger.Error("Error deleting calendar day", slog.String("error", err.Error()))
+		s.calendarErrorResponse(w, err, "Failed to delete calendar day")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetDepartmentAbsenceFeed returns the absences of the department employees as
+// an iCalendar file. Calendar clients cannot send the Authorization header, so
+// the request is authenticated by the calendar token in the query.
+func (s Server) GetDepartmentAbsenceFeed(w http.ResponseWriter, _ *http.Request, id uint64, params GetDepartmentAbsenceFeedParams) {
+	feed, err := s.Controllers.CalendarFeedController.DepartmentFeed(params.Token, id, calendarFeedParams(params.Birthdays, params.Anniversaries))
+	if err != nil {
+		s.deps.Logger.Error("Error getting department absence feed", slog.String("error", err.Error()))
+		s.calendarFeedErrorResponse(w, err)
+		return
+	}
+
+	s.calendarFeedResponse(w, feed)
+}
+
+// GetEmployeeAbsenceFeed returns the absences of the employee as an iCalendar
+// file, authenticated by the calendar token in the query.
+func (s Server) GetEmployeeAbsenceFeed(w http.ResponseWriter, _ *http.Request, id uint64, params GetEmployeeAbsenceFeedParams) {
+	feed, err := s.Controllers.CalendarFeedController.EmployeeFeed(params.Token, id, calendarFeedParams(params.Birthdays, params.Anniversaries))
+	if err != nil {
+		s.deps.Logger.Error("Error getting employee absence feed", slog.String("error", err.Error()))
+		s.calendarFeedErrorResponse(w, err)
+		return
+	}
+
+	s.calendarFeedResponse(w, feed)
+}
+
+// UpdateEmployee is method to update employee.
+//
+//nolint:dupl // This is not duplicate!!
+func (s Server) UpdateEmployee(w http.ResponseWriter, r *http.Request, id uint64, params UpdateEmployeeParams) {
+	user, err := s.checkAuthUser(r, controllers.OpUpdateEmployee, &id)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		s.deps.Logger.Warn("Invalid If-Match header", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		return
+	}
+
+	var emp entity.Employee
+	if err = json.NewDecoder(r.Body).Decode(&emp); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	var updateEmp *entity.Employee
+	if controllers.ManagesEmployee(user, id) {
+		updateEmp, err = s.Controllers.EmployeeController.UpdateEmployee(user, id, emp, version)
+	} else {
+		updateEmp, err = s.Controllers.EmployeeController.UpdateOwnProfile(id, emp, version)
+	}
+
+	if err != nil {
+		s.deps.Logger.Error("Error updating employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidEmployee):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrPermissionDenied):
+			s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+		case errors.Is(err, controllers.ErrEmployeeConflict):
+			s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrVersionRequired):
+			s.httpResponse(w, http.StatusPreconditionRequired, "If-Match header is required", "error")
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to update employee", "error")
+		}
+
+		return
+	}
+
+	setETag(w, updateEmp.Version)
+	s.httpResponse(w, http.StatusOK, updateEmp, "success")
+}
+
+// PatchEmployee update the given fields of the employee.
+func (s Server) PatchEmployee(w http.ResponseWriter, r *http.Request, id uint64, params PatchEmployeeParams) {
+	user, err := s.checkAuthUser(r, controllers.OpUpdateEmployee, &id)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		s.deps.Logger.Warn("Invalid If-Match header", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		return
+	}
+
+	var patch entity.EmployeePatch
+	if patch.Fields, err = decodeMergePatch(r, &patch.Employee); err != nil {
+		s.deps.Logger.Error("Error decoding request body", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	var updateEmp *entity.Employee
+	if controllers.ManagesEmployee(user, id) {
+		updateEmp, err = s.Controllers.EmployeeController.PatchEmployee(user, id, patch, version)
+	} else {
+		updateEmp, err = s.Controllers.EmployeeController.PatchOwnProfile(id, patch, version)
+	}
+
+	if err != nil {
+		s.deps.Logger.Error("Error patching employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrInvalidPatch):
+			s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrPermissionDenied):
+			s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+		case errors.Is(err, controllers.ErrEmployeeConflict):
+			s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrVersionRequired):
+			s.httpResponse(w, http.StatusPreconditionRequired, "If-Match header is required", "error")
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to update employee", "error")
+		}
+
+		return
+	}
+
+	setETag(w, updateEmp.Version)
+	s.httpResponse(w, http.StatusOK, updateEmp, "success")
+}
+
+// CreatePasswordReset issues a password reset token for the employee.
+func (s Server) CreatePasswordReset(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpResetPassword, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	reset, err := s.Controllers.AuthController.CreatePasswordReset(user, id)
+	if err != nil {
+		s.deps.Logger.Error("Error creating password reset", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrPermissionDenied):
+			s.authErrorResponse(w, err)
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to create password reset", "error")
+		}
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, reset, "success")
+}
+
+// Impersonate issues a short-lived access token of the employee to an admin.
+func (s Server) Impersonate(w http.ResponseWriter, r *http.Request, id uint64) {
+	user, err := s.checkAuthUser(r, controllers.OpImpersonate, nil)
+	if err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	token, err := s.Controllers.AuthController.Impersonate(user, id)
+	if err != nil {
+		s.deps.Logger.Error("Error impersonating employee", slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, controllers.ErrImpersonationNotAllowed):
+			s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to impersonate employee", "error")
+		}
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, token, "success")
+}
+
+// GetAuditLog returns the audit trail.
+func (s Server) GetAuditLog(w http.ResponseWriter, r *http.Request, params GetAuditLogParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpGetAuditLog, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	entityParams := entity.GetAuditLogParams(params)
+
+	entries, err := s.Controllers.AuditController.GetAuditLog(&entityParams)
+	if err != nil {
+		s.deps.Logger.Error("Error getting audit log", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get audit log", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusOK, entries, "success")
+}
+
+// DeleteEmployee implements ServerInterface.
+func (s Server) DeleteEmployee(w http.ResponseWriter, r *http.Request, id uint64, params DeleteEmployeeParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpDeleteEmployee, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		s.deps.Logger.Warn("Invalid If-Match header", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		return
+	}
+
+	if err = s.Controllers.EmployeeController.DeleteEmployee(id, version); err != nil {
+		s.deps.Logger.Error("Error deleting employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+		case errors.Is(err, controllers.ErrVersionRequired):
+			s.httpResponse(w, http.StatusPreconditionRequired, "If-Match header is required", "error")
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to delete employee", "error")
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreEmployee rehires a terminated employee.
+func (s Server) RestoreEmployee(w http.ResponseWriter, r *http.Request, id uint64) {
+	if _, err := s.checkAuthUser(r, controllers.OpRestoreEmployee, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	employee, err := s.Controllers.EmployeeController.RestoreEmployee(id)
+	if err != nil {
+		s.deps.Logger.Error("Error restoring employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+		case errors.Is(err, controllers.ErrEmployeeNotFired), errors.Is(err, controllers.ErrVersionMismatch):
+			s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to restore employee", "error")
+		}
+
+		return
+	}
+
+	setETag(w, employee.Version)
+	s.httpResponse(w, http.StatusOK, employee, "success")
+}
+
+// PurgeEmployee erases a terminated employee for good.
+func (s Server) PurgeEmployee(w http.ResponseWriter, r *http.Request, id uint64, params PurgeEmployeeParams) {
+	if _, err := s.checkAuthUser(r, controllers.OpPurgeEmployee, nil); err != nil {
+		s.deps.Logger.Error("Error checking auth", slog.String("error", err.Error()))
+		s.authErrorResponse(w, err)
+		return
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		s.deps.Logger.Warn("Invalid If-Match header", slog.String("error", err.Error()))
+		s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		return
+	}
+
+	if err = s.Controllers.EmployeeController.PurgeEmployee(id, version); err != nil {
+		s.deps.Logger.Error("Error purging employee", slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, controllers.ErrEmployeeNotFound):
+			s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+		case errors.Is(err, controllers.ErrEmployeeNotFired):
+			s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+		case errors.Is(err, controllers.ErrVersionRequired):
+			s.httpResponse(w, http.StatusPreconditionRequired, "If-Match header is required", "error")
+		case errors.Is(err, controllers.ErrVersionMismatch):
+			s.httpResponse(w, http.StatusPreconditionFailed, map[string]string{"error": err.Error()}, "error")
+		default:
+			s.httpResponse(w, http.StatusInternalServerError, "Failed to purge employee", "error")
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkAuthUser authenticates the request and checks that the user may perform the operation.
+func (s Server) checkAuthUser(r *http.Request, op controllers.Operation, targetID *uint64) (*entity.Claims, error) {
+	user, err := s.getUserFromToken(r)
+	if err != nil {
+		s.deps.Logger.Warn("Unauthorized request attempt", slog.String("error", err.Error()))
+		return nil, errors.New("error getting user from token")
+	}
+
+	if err = s.Controllers.AuthController.Authorize(user, op, targetID); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// authErrorResponse writes 403 for denied operations and 401 for everything else.
+func (s Server) authErrorResponse(w http.ResponseWriter, err error) {
+	if errors.Is(err, controllers.ErrPasswordChangeRequired) {
+		s.httpResponse(w, http.StatusForbidden, "Password change required", "error")
+		return
+	}
+
+	if errors.Is(err, controllers.ErrMFAEnrollmentRequired) {
+		s.httpResponse(w, http.StatusForbidden, "Two-factor enrollment required", "error")
+		return
+	}
+
+	if errors.Is(err, controllers.ErrImpersonationRestricted) {
+		s.httpResponse(w, http.StatusForbidden, "Not allowed while impersonating", "error")
+		return
+	}
+
+	if errors.Is(err, controllers.ErrPermissionDenied) {
+		s.httpResponse(w, http.StatusForbidden, "Forbidden", "error")
+		return
+	}
+
+	s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+}
+
+// JWKS publishes the public keys that verify issued tokens. The response is a
+// plain JWK Set, as expected by JWT libraries, not an ApiResponse.
+func (s Server) JWKS(w http.ResponseWriter, _ *http.Request) {
+	respData, err := json.Marshal(s.deps.Keys.JWKS())
+	if err != nil {
+		s.deps.Logger.Error("Error marshaling JWKS", slog.String("error", err.Error()))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err = w.Write(respData); err != nil {
+		s.deps.Logger.Error("Error writing response", slog.String("error", err.Error()))
+	}
+}
+
+// setETag sends the version of the record as a strong ETag. It must be called
+// before the response is written.
+func setETag(w http.ResponseWriter, version uint64) {
+	w.Header().Set("ETag", fmt.Sprintf("%q", strconv.FormatUint(version, 10)))
+}
+
+// ifMatchVersion returns the version sent in If-Match, 0 if the header is
+// missing. A value that is not a version of ours, such as "*", can never match
+// and is reported as ErrVersionMismatch.
+func ifMatchVersion(header *string) (uint64, error) {
+	if header == nil || strings.TrimSpace(*header) == "" {
+		return 0, nil
+	}
+
+	tag := strings.TrimPrefix(strings.TrimSpace(*header), "W/")
+
+	version, err := strconv.ParseUint(strings.Trim(tag, `"`), 10, 64)
+	if err != nil || version == 0 || version > math.MaxInt64 {
+		return 0, fmt.Errorf("%w: invalid If-Match %s", controllers.ErrVersionMismatch, *header)
+	}
+
+	return version, nil
+}
+
+// vacationErrorResponse writes the response for the errors of the vacation
+// controller, 500 with the message for the rest.
+func (s Server) vacationErrorResponse(w http.ResponseWriter, err error, message string) {
+	var staffing *controllers.StaffingConflictError
+
+	switch {
+	case errors.As(err, &staffing):
+		s.httpResponse(w, http.StatusConflict, map[string]any{"error": err.Error(), "conflict": staffing.Conflict}, "error")
+	case errors.Is(err, controllers.ErrInvalidVacationRequest):
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrNotVacationApprover):
+		s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrVacationRequestNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Vacation request not found", "error")
+	case errors.Is(err, controllers.ErrVacationOverlap), errors.Is(err, controllers.ErrVacationRequestNotPending),
+		errors.Is(err, controllers.ErrVacationStarted):
+		s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrInsufficientVacationBalance):
+		s.httpResponse(w, http.StatusUnprocessableEntity, map[string]string{"error": err.Error()}, "error")
+	default:
+		s.httpResponse(w, http.StatusInternalServerError, message, "error")
+	}
+}
+
+// leaveErrorResponse writes the response for the errors of the leave
+// controller, 500 with the message for the rest.
+func (s Server) leaveErrorResponse(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidLeaveAdjustment), errors.Is(err, controllers.ErrInvalidLeaveFilter):
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrEmployeeNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+	case errors.Is(err, controllers.ErrInsufficientLeaveBalance):
+		s.httpResponse(w, http.StatusUnprocessableEntity, map[string]string{"error": err.Error()}, "error")
+	default:
+		s.httpResponse(w, http.StatusInternalServerError, message, "error")
+	}
+}
+
+func (s Server) sickLeaveErrorResponse(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidSickLeave):
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrPermissionDenied):
+		s.httpResponse(w, http.StatusForbidden, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrEmployeeNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+	case errors.Is(err, controllers.ErrSickLeaveNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Sick leave not found", "error")
+	case errors.Is(err, controllers.ErrSickLeaveOverlap), errors.Is(err, controllers.ErrSickLeaveClosed),
+		errors.Is(err, controllers.ErrSickCertificateExists):
+		s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+	default:
+		s.httpResponse(w, http.StatusInternalServerError, message, "error")
+	}
+}
+
+func (s Server) calendarErrorResponse(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidCalendar), errors.Is(err, controllers.ErrInvalidCalendarRange):
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrEmployeeNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+	case errors.Is(err, controllers.ErrCalendarNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Calendar not found", "error")
+	case errors.Is(err, controllers.ErrCalendarDayNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Calendar day not found", "error")
+	case errors.Is(err, controllers.ErrCalendarConflict):
+		s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+	default:
+		s.httpResponse(w, http.StatusInternalServerError, message, "error")
+	}
+}
+
+// calendarFeedErrorResponse writes the response for the errors of the calendar feeds.
+func (s Server) calendarFeedErrorResponse(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidCalendarToken):
+		s.httpResponse(w, http.StatusUnauthorized, "Unauthorized", "error")
+	case errors.Is(err, controllers.ErrPermissionDenied):
+		s.httpResponse(w, http.StatusForbidden, "Forbidden", "error")
+	case errors.Is(err, controllers.ErrEmployeeNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Employee not found", "error")
+	case errors.Is(err, controllers.ErrDepartmentNotFound):
+		s.httpResponse(w, http.StatusNotFound, "Department not found", "error")
+	default:
+		s.httpResponse(w, http.StatusInternalServerError, "Failed to get calendar", "error")
+	}
+}
+
+// calendarFeedResponse writes the iCalendar file as is, not as an ApiResponse.
+// The token is in the URL, so the feed must not be kept by shared caches.
+func (s Server) calendarFeedResponse(w http.ResponseWriter, feed []byte) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(feed); err != nil {
+		s.deps.Logger.Error("Error writing response", slog.String("error", err.Error()))
+	}
+}
+
+func calendarFeedParams(birthdays, anniversaries *bool) entity.CalendarFeedParams {
+	return entity.CalendarFeedParams{
+		Birthdays:     birthdays != nil && *birthdays,
+		Anniversaries: anniversaries != nil && *anniversaries,
+	}
+}
+
+// idempotentRequest describes a create retried with the Idempotency-Key header.
+func idempotentRequest(op controllers.Operation, user *entity.Claims, key *string, payload any) controllers.IdempotentRequest {
+	req := controllers.IdempotentRequest{
+		Operation: op,
+		Caller:    user,
+		Payload:   payload,
+	}
+
+	if key != nil {
+		req.Key = *key
+	}
+
+	return req
+}
+
+// setReplayed marks a response repeated for an Idempotency-Key.
+func setReplayed(w http.ResponseWriter, replayed bool) {
+	if replayed {
+		w.Header().Set("Idempotent-Replayed", "true")
+	}
+}
+
+// idempotencyErrorResponse writes the response for the Idempotency-Key errors
+// and reports whether err was one of them.
+func (s Server) idempotencyErrorResponse(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, controllers.ErrInvalidIdempotencyKey):
+		s.httpResponse(w, http.StatusBadRequest, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrIdempotencyInProgress):
+		s.httpResponse(w, http.StatusConflict, map[string]string{"error": err.Error()}, "error")
+	case errors.Is(err, controllers.ErrIdempotencyKeyReused):
+		s.httpResponse(w, http.StatusUnprocessableEntity, map[string]string{"error": err.Error()}, "error")
+	default:
+		return false
+	}
+
+	return true
+}
+
+// decodeMergePatch decodes a JSON Merge Patch (RFC 7396) into dst and returns
+// the names of the fields present in it, null ones included.
+func decodeMergePatch(r *http.Request, dst any) ([]string, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var members map[string]json.RawMessage
+	if err = json.Unmarshal(body, &members); err != nil {
+		return nil, err
+	}
+
+	if members == nil {
+		return nil, errors.New("merge patch must be a JSON object")
+	}
+
+	if err = json.Unmarshal(body, dst); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(members))
+	for field := range members {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
 
-	return nil
+	return fields, nil
 }
 
 func (s Server) httpResponse(w http.ResponseWriter, status int, data any, respType string) {
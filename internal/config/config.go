@@ -17,6 +17,7 @@ type Config struct {
 		ReadTimeout       time.Duration `toml:"read_timeout"`
 		ReadHeaderTimeout time.Duration `toml:"read_header_timeout"`
 		JWTSecret         string        `toml:"jwt_secret"`
+		TrustedProxies    []string      `toml:"trusted_proxies"`
 	} `toml:"server"`
 	Database struct {
 		Host     string `toml:"host"`
@@ -25,12 +26,76 @@ type Config struct {
 		Database string `toml:"database"`
 	} `toml:"database"`
 	Redis struct {
-		RedisAddr       string        `toml:"redis_addr"`
-		RedisPassword   string        `toml:"redis_password"`
-		RedisDB         int           `toml:"redis_db"`
-		AccessTokenTTL  time.Duration `toml:"access_token_ttl"`
-		RefreshTokenTTL time.Duration `toml:"refresh_token_ttl"`
+		RedisAddr        string        `toml:"redis_addr"`
+		RedisPassword    string        `toml:"redis_password"`
+		RedisDB          int           `toml:"redis_db"`
+		AccessTokenTTL   time.Duration `toml:"access_token_ttl"`
+		RefreshTokenTTL  time.Duration `toml:"refresh_token_ttl"`
+		PasswordResetTTL time.Duration `toml:"password_reset_ttl"`
 	} `toml:"redis"`
+	JWT struct {
+		Algorithm        string        `toml:"algorithm"`
+		KeysDir          string        `toml:"keys_dir"`
+		RotationInterval time.Duration `toml:"rotation_interval"`
+	} `toml:"jwt"`
+	Password struct {
+		MinLength      int    `toml:"min_length"`
+		RequireUpper   bool   `toml:"require_upper"`
+		RequireLower   bool   `toml:"require_lower"`
+		RequireDigit   bool   `toml:"require_digit"`
+		RequireSpecial bool   `toml:"require_special"`
+		BannedList     string `toml:"banned_list"`
+		HistorySize    int    `toml:"history_size"`
+		Algorithm      string `toml:"algorithm"`
+		BcryptCost     int    `toml:"bcrypt_cost"`
+		Argon2Time     uint32 `toml:"argon2_time"`
+		Argon2Memory   uint32 `toml:"argon2_memory"`
+		Argon2Threads  uint8  `toml:"argon2_threads"`
+	} `toml:"password"`
+	Login struct {
+		MaxAttempts     int           `toml:"max_attempts"`
+		IPMaxAttempts   int           `toml:"ip_max_attempts"`
+		FailureWindow   time.Duration `toml:"failure_window"`
+		LockoutDuration time.Duration `toml:"lockout_duration"`
+		DelayAfter      int           `toml:"delay_after"`
+		BaseDelay       time.Duration `toml:"base_delay"`
+		MaxDelay        time.Duration `toml:"max_delay"`
+	} `toml:"login"`
+	MFA struct {
+		Issuer        string        `toml:"issuer"`
+		RequiredRoles []string      `toml:"required_roles"`
+		TokenTTL      time.Duration `toml:"token_ttl"`
+	} `toml:"mfa"`
+	Impersonation struct {
+		TokenTTL time.Duration `toml:"token_ttl"`
+	} `toml:"impersonation"`
+	OIDC struct {
+		Enabled              bool          `toml:"enabled"`
+		Issuer               string        `toml:"issuer"`
+		ClientID             string        `toml:"client_id"`
+		ClientSecret         string        `toml:"client_secret"`
+		RedirectURL          string        `toml:"redirect_url"`
+		Scopes               []string      `toml:"scopes"`
+		StateTTL             time.Duration `toml:"state_ttl"`
+		DisablePasswordLogin bool          `toml:"disable_password_login"`
+	} `toml:"oidc"`
+	Idempotency struct {
+		TTL     time.Duration `toml:"ttl"`
+		LockTTL time.Duration `toml:"lock_ttl"`
+		Secret  string        `toml:"secret"`
+	} `toml:"idempotency"`
+	Leave struct {
+		AccrualInterval time.Duration `toml:"accrual_interval"`
+		Annual          LeaveRule     `toml:"annual"`
+	} `toml:"leave"`
+}
+
+// LeaveRule is the accrual of a leave type. DaysPerYear are credited on January 1,
+// pro-rated from hire_date in the year of hiring. At the end of a year the
+// balance above CarryOverCap expires; without the cap everything is carried over.
+type LeaveRule struct {
+	DaysPerYear  float64  `toml:"days_per_year"`
+	CarryOverCap *float64 `toml:"carry_over_cap"`
 }
 
 func GetConfig(logger *slog.Logger) (*Config, error) {
@@ -47,10 +112,32 @@ func GetConfig(logger *slog.Logger) (*Config, error) {
 		return nil, tomlErr
 	}
 
-	if cfg.Server.JWTSecret == "" {
+	if cfg.JWT.KeysDir == "" && cfg.Server.JWTSecret == "" {
 		return nil, errors.New("jwt_secret is empty")
 	}
 
+	if cfg.Idempotency.Secret == "" {
+		return nil, errors.New("idempotency secret is empty")
+	}
+
+	switch cfg.JWT.Algorithm {
+	case "":
+		cfg.JWT.Algorithm = "RS256"
+	case "RS256", "EdDSA":
+	default:
+		return nil, errors.New("unsupported jwt algorithm: " + cfg.JWT.Algorithm)
+	}
+
+	switch cfg.Password.Algorithm {
+	case "", "bcrypt", "argon2id":
+	default:
+		return nil, errors.New("unsupported password algorithm: " + cfg.Password.Algorithm)
+	}
+
+	if cfg.OIDC.Enabled && (cfg.OIDC.Issuer == "" || cfg.OIDC.ClientID == "" || cfg.OIDC.RedirectURL == "") {
+		return nil, errors.New("oidc requires issuer, client_id and redirect_url")
+	}
+
 	logger.Info("Config is loaded")
 	return cfg, nil
 }
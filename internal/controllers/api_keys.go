@@ -0,0 +1,244 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	TokenTypeAPIKey = "api_key"
+
+	// APIKeyScheme is the Authorization scheme of API keys: "ApiKey <key>".
+	APIKeyScheme = "ApiKey "
+
+	apiKeyPrefix     = "esk_"
+	apiKeySize       = 32
+	apiKeyPrefixSize = 12
+)
+
+var (
+	ErrInvalidAPIKey     = errors.New("invalid api key")
+	ErrAPIKeyNotFound    = errors.New("api key not found")
+	ErrInvalidAPIKeyForm = errors.New("invalid api key request")
+)
+
+type APIKeyController struct {
+	deps *Dependens
+}
+
+func NewAPIKeyController(deps *Dependens) *APIKeyController {
+	return &APIKeyController{
+		deps: deps,
+	}
+}
+
+// GetAPIKeys returns all API keys without their secrets.
+func (c *APIKeyController) GetAPIKeys() ([]entity.APIKey, error) {
+	query := `SELECT id, name, owner, prefix, scopes, expires_at, last_used_at, created_by, created_at FROM api_keys ORDER BY id`
+
+	rows, err := c.deps.DB.Query(context.Background(), query)
+	if err != nil {
+		c.deps.Logger.Error("Error querying api keys", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.APIKey])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// CreateAPIKey generates a new key. The secret is returned only here, the
+// database keeps its SHA-256 hash.
+func (c *APIKeyController) CreateAPIKey(user *entity.Claims, form entity.APIKeyForm) (*entity.CreatedAPIKey, error) {
+	if err := validateAPIKeyForm(form); err != nil {
+		c.deps.Logger.Warn("Invalid api key form", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	secret, err := generateAPIKey()
+	if err != nil {
+		c.deps.Logger.Error("Error generating api key", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	createdBy := user.ID
+	key := entity.CreatedAPIKey{
+		APIKey: entity.APIKey{
+			Name:      form.Name,
+			Owner:     form.Owner,
+			Prefix:    secret[:apiKeyPrefixSize],
+			Scopes:    form.Scopes,
+			ExpiresAt: form.ExpiresAt,
+			CreatedBy: &createdBy,
+			CreatedAt: time.Now(),
+		},
+		Key: secret,
+	}
+
+	query := `INSERT INTO api_keys (name, owner, prefix, key_hash, scopes, expires_at, created_by, created_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+              RETURNING id`
+
+	if err = c.deps.DB.QueryRow(context.Background(), query, key.Name, key.Owner, key.Prefix, hashAPIKey(secret),
+		key.Scopes, key.ExpiresAt, createdBy, key.CreatedAt).Scan(&key.ID); err != nil {
+		c.deps.Logger.Error("Error inserting api key", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	c.deps.Logger.Info("API key created", slog.Any("api_key_id", key.ID), slog.String("name", key.Name), slog.Any("user_id", user.ID))
+
+	return &key, nil
+}
+
+// UpdateAPIKey changes the name, owner, scopes and expiry of the key. The secret
+// cannot be changed, a new key has to be created instead.
+func (c *APIKeyController) UpdateAPIKey(id uint64, form entity.APIKeyForm) (*entity.APIKey, error) {
+	if err := validateAPIKeyForm(form); err != nil {
+		c.deps.Logger.Warn("Invalid api key form", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var key entity.APIKey
+
+	query := `UPDATE api_keys SET name = $1, owner = $2, scopes = $3, expires_at = $4
+              WHERE id = $5
+              RETURNING id, name, owner, prefix, scopes, expires_at, last_used_at, created_by, created_at`
+
+	if err := c.deps.DB.QueryRow(context.Background(), query, form.Name, form.Owner, form.Scopes, form.ExpiresAt, id).Scan(
+		&key.ID, &key.Name, &key.Owner, &key.Prefix, &key.Scopes, &key.ExpiresAt, &key.LastUsedAt, &key.CreatedBy, &key.CreatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("API key not found", slog.Any("id", id))
+			return nil, ErrAPIKeyNotFound
+		}
+
+		c.deps.Logger.Error("Error updating api key", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// DeleteAPIKey revokes the key.
+func (c *APIKeyController) DeleteAPIKey(id uint64) error {
+	result, err := c.deps.DB.Exec(context.Background(), "DELETE FROM api_keys WHERE id = $1", id)
+	if err != nil {
+		c.deps.Logger.Error("Error deleting api key", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		c.deps.Logger.Warn("API key not found", slog.Any("id", id))
+		return ErrAPIKeyNotFound
+	}
+
+	c.deps.Logger.Info("API key deleted", slog.Any("api_key_id", id))
+
+	return nil
+}
+
+// Authenticate returns the claims of a valid, unexpired key and records its use.
+func (c *APIKeyController) Authenticate(secret string) (*entity.Claims, error) {
+	ctx := context.Background()
+
+	var id uint64
+	var name string
+	var scopes []string
+	var expiresAt *time.Time
+
+	query := "SELECT id, name, scopes, expires_at FROM api_keys WHERE key_hash = $1"
+	if err := c.deps.DB.QueryRow(ctx, query, hashAPIKey(secret)).Scan(&id, &name, &scopes, &expiresAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Unknown api key", slog.String("prefix", apiKeyDisplayPrefix(secret)))
+			return nil, ErrInvalidAPIKey
+		}
+
+		c.deps.Logger.Error("Error querying api key", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !now.Before(*expiresAt) {
+		c.deps.Logger.Warn("Expired api key", slog.Any("api_key_id", id))
+		return nil, ErrInvalidAPIKey
+	}
+
+	if _, err := c.deps.DB.Exec(ctx, "UPDATE api_keys SET last_used_at = $1 WHERE id = $2", now, id); err != nil {
+		c.deps.Logger.Error("Error updating api key usage", slog.String("error", err.Error()))
+	}
+
+	c.deps.Logger.Debug("API key authenticated", slog.Any("api_key_id", id), slog.String("name", name))
+
+	return &entity.Claims{
+		Type:     TokenTypeAPIKey,
+		APIKeyID: id,
+		Scopes:   scopes,
+	}, nil
+}
+
+func validateAPIKeyForm(form entity.APIKeyForm) error {
+	if strings.TrimSpace(form.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidAPIKeyForm)
+	}
+
+	if strings.TrimSpace(form.Owner) == "" {
+		return fmt.Errorf("%w: owner is required", ErrInvalidAPIKeyForm)
+	}
+
+	if len(form.Scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidAPIKeyForm)
+	}
+
+	for _, scope := range form.Scopes {
+		if !slices.Contains(allScopes, scope) {
+			return fmt.Errorf("%w: unknown scope %q", ErrInvalidAPIKeyForm, scope)
+		}
+	}
+
+	if form.ExpiresAt != nil && !form.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("%w: expires_at must be in the future", ErrInvalidAPIKeyForm)
+	}
+
+	return nil
+}
+
+func generateAPIKey() (string, error) {
+	b := make([]byte, apiKeySize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// apiKeyDisplayPrefix returns the part of the key that is safe to log.
+func apiKeyDisplayPrefix(secret string) string {
+	if len(secret) < apiKeyPrefixSize {
+		return ""
+	}
+
+	return secret[:apiKeyPrefixSize]
+}
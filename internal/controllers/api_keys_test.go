@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKeyQuery = "SELECT id, name, scopes, expires_at FROM api_keys WHERE key_hash = $1"
+
+func TestAPIKeyController_CreateAPIKey(t *testing.T) {
+	mockDB := &MockDB{}
+	controller := NewAPIKeyController(CreateTestDependencies(mockDB, &MockRedis{}))
+	admin := &entity.Claims{ID: 1, Role: entity.RoleAdmin}
+
+	var storedHash string
+	mockDB.On("QueryRow", mock.Anything, queryPrefix("INSERT INTO api_keys"),
+		"payroll", "payroll-team", mock.AnythingOfType("string"), mock.MatchedBy(func(hash string) bool {
+			storedHash = hash
+			return true
+		}), []string{ScopeEmployeesRead}, (*time.Time)(nil), uint64(1), mock.AnythingOfType("time.Time"),
+	).Return(NewMockRow([]interface{}{uint64(7)}, nil, nil))
+
+	key, err := controller.CreateAPIKey(admin, entity.APIKeyForm{Name: "payroll", Owner: "payroll-team", Scopes: []string{ScopeEmployeesRead}})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(7), key.ID)
+	assert.True(t, strings.HasPrefix(key.Key, "esk_"))
+	assert.Equal(t, key.Key[:apiKeyPrefixSize], key.Prefix)
+	assert.Equal(t, hashAPIKey(key.Key), storedHash, "only the hash is stored")
+	mockDB.AssertExpectations(t)
+}
+
+func TestValidateAPIKeyForm(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		form    entity.APIKeyForm
+		wantErr string
+	}{
+		{name: "valid", form: entity.APIKeyForm{Name: "payroll", Owner: "team", Scopes: []string{ScopeDepartmentsWrite}}},
+		{name: "missing name", form: entity.APIKeyForm{Owner: "team", Scopes: []string{ScopeDepartmentsWrite}}, wantErr: "name is required"},
+		{name: "missing owner", form: entity.APIKeyForm{Name: "payroll", Scopes: []string{ScopeDepartmentsWrite}}, wantErr: "owner is required"},
+		{name: "no scopes", form: entity.APIKeyForm{Name: "payroll", Owner: "team"}, wantErr: "at least one scope"},
+		{name: "unknown scope", form: entity.APIKeyForm{Name: "payroll", Owner: "team", Scopes: []string{"admin"}}, wantErr: `unknown scope "admin"`},
+		{
+			name:    "expired",
+			form:    entity.APIKeyForm{Name: "payroll", Owner: "team", Scopes: []string{ScopeEmployeesRead}, ExpiresAt: &past},
+			wantErr: "must be in the future",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAPIKeyForm(tt.form)
+
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrInvalidAPIKeyForm)
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthController_CheckUserToken_APIKey(t *testing.T) {
+	secret := "esk_test-secret"
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name        string
+		row         *MockRow
+		expectedErr error
+	}{
+		{
+			name: "valid key",
+			row:  NewMockRow([]interface{}{uint64(7), "payroll", []string{ScopeEmployeesRead}, (*time.Time)(nil)}, nil, nil),
+		},
+		{
+			name:        "unknown key",
+			row:         NewMockRow(nil, pgx.ErrNoRows, nil),
+			expectedErr: ErrInvalidAPIKey,
+		},
+		{
+			name:        "expired key",
+			row:         NewMockRow([]interface{}{uint64(7), "payroll", []string{ScopeEmployeesRead}, &past}, nil, nil),
+			expectedErr: ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			controller := NewAuthController(CreateTestDependencies(mockDB, mockRedis))
+
+			mockDB.On("QueryRow", mock.Anything, apiKeyQuery, hashAPIKey(secret)).Return(tt.row)
+			if tt.expectedErr == nil {
+				mockDB.On("Exec", mock.Anything, "UPDATE api_keys SET last_used_at = $1 WHERE id = $2", mock.AnythingOfType("time.Time"), uint64(7)).
+					Return(pgconn.NewCommandTag("UPDATE 1"), errors.New("usage is best effort"))
+			}
+
+			claims, err := controller.CheckUserToken("ApiKey " + secret)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, claims)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, TokenTypeAPIKey, claims.Type)
+				assert.Equal(t, uint64(7), claims.APIKeyID)
+				assert.Equal(t, []string{ScopeEmployeesRead}, claims.Scopes)
+			}
+
+			mockDB.AssertExpectations(t)
+			mockRedis.AssertNotCalled(t, "Get")
+		})
+	}
+}
+
+func TestAPIKeyController_DeleteAPIKey(t *testing.T) {
+	mockDB := &MockDB{}
+	controller := NewAPIKeyController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+	mockDB.On("Exec", mock.Anything, "DELETE FROM api_keys WHERE id = $1", uint64(7)).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+	mockDB.On("Exec", mock.Anything, "DELETE FROM api_keys WHERE id = $1", uint64(8)).Return(pgconn.NewCommandTag("DELETE 0"), nil)
+
+	assert.NoError(t, controller.DeleteAPIKey(7))
+	assert.ErrorIs(t, controller.DeleteAPIKey(8), ErrAPIKeyNotFound)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// AuditController keeps the audit trail of actions made on behalf of other
+// employees.
+type AuditController struct {
+	deps *Dependens
+}
+
+func NewAuditController(deps *Dependens) *AuditController {
+	return &AuditController{
+		deps: deps,
+	}
+}
+
+// Record appends the entry to the audit trail.
+func (c *AuditController) Record(ctx context.Context, entry entity.AuditEntry) error {
+	query := `INSERT INTO audit_log (actor_id, employee_id, action, target_id, allowed, created_at)
+              VALUES ($1, $2, $3, $4, $5, $6)`
+
+	if _, err := c.deps.DB.Exec(ctx, query, entry.ActorID, entry.EmployeeID, entry.Action, entry.TargetID, entry.Allowed, time.Now()); err != nil {
+		c.deps.Logger.Error("Error writing audit log", slog.String("error", err.Error()), slog.String("action", entry.Action))
+		return err
+	}
+
+	return nil
+}
+
+// GetAuditLog returns the entries, newest first, optionally filtered by the
+// actor or the impersonated employee.
+func (c *AuditController) GetAuditLog(params *entity.GetAuditLogParams) ([]entity.AuditEntry, error) {
+	query := "SELECT id, actor_id, employee_id, action, target_id, allowed, created_at FROM audit_log WHERE 1=1"
+	args := []any{}
+	argIdx := 1
+
+	if params != nil {
+		if params.ActorID != nil {
+			query += fmt.Sprintf(" AND actor_id = $%d", argIdx)
+			args = append(args, *params.ActorID)
+			argIdx++
+		}
+
+		if params.EmployeeID != nil {
+			query += fmt.Sprintf(" AND employee_id = $%d", argIdx)
+			args = append(args, *params.EmployeeID)
+		}
+	}
+
+	query += " ORDER BY created_at DESC, id DESC"
+
+	rows, err := c.deps.DB.Query(context.Background(), query, args...)
+	if err != nil {
+		c.deps.Logger.Error("Error querying audit log", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.AuditEntry])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -13,33 +13,71 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
-	"golang.org/x/crypto/bcrypt"
 )
 
-const TokenSize = 16
+const (
+	TokenSize = 16
+
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected")
+	ErrAccountInactive     = errors.New("account is inactive")
+)
 
 type AuthController struct {
-	deps *Dependens
+	deps     *Dependens
+	sessions *SessionStore
+	limiter  *LoginLimiter
+	apiKeys  *APIKeyController
+	oidc     *OIDCProvider
+	audit    *AuditController
 }
 
 func NewAuthController(deps *Dependens) *AuthController {
 	return &AuthController{
-		deps: deps,
+		deps:     deps,
+		sessions: NewSessionStore(deps),
+		limiter:  NewLoginLimiter(deps),
+		apiKeys:  NewAPIKeyController(deps),
+		oidc:     NewOIDCProvider(deps),
+		audit:    NewAuditController(deps),
 	}
 }
 
-func (c *AuthController) AuthLogin(req *entity.LoginRequest) (string, string, error) {
+// AuthLogin checks the credentials and opens a new session. Unknown emails and
+// wrong passwords both return ErrInvalidCredentials and count as failed attempts.
+// If the employee has TOTP enabled, only an MFA token for VerifyMFA is returned.
+func (c *AuthController) AuthLogin(req *entity.LoginRequest) (*entity.LoginResponse, error) {
+	if c.deps.Config.OIDC.DisablePasswordLogin {
+		c.deps.Logger.Warn("Password login attempt while disabled", slog.String("email", req.Email))
+		return nil, ErrPasswordLoginDisabled
+	}
+
+	ctx := context.Background()
+
+	if err := c.limiter.Check(ctx, req.Email, req.ClientIP); err != nil {
+		return nil, err
+	}
+
 	var id uint64
-	var email, password, role string
+	var email, password, role, status string
+	var mustChangePassword, isActive bool
 
-	if err := c.deps.DB.QueryRow(context.Background(), "SELECT id, email, password, role FROM employees WHERE email = $1", req.Email).Scan(&id, &email, &password, &role); err != nil {
+	query := "SELECT id, email, password, role, must_change_password, is_active, status FROM employees WHERE email = $1"
+	if err := c.deps.DB.QueryRow(ctx, query, req.Email).Scan(&id, &email, &password, &role, &mustChangePassword, &isActive, &status); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			c.deps.Logger.Warn("user with this email not found", slog.String("email", req.Email))
-			return "", "", errors.New("user with this email not found")
+			c.deps.Logger.Warn("Login with unknown email", slog.String("email", req.Email), slog.String("ip", req.ClientIP))
+			c.deps.Passwords.VerifyDummy(req.Password)
+			c.limiter.Fail(ctx, req.Email, req.ClientIP)
+			return nil, ErrInvalidCredentials
 		}
 
 		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
-		return "", "", err
+		return nil, err
 	}
 
 	emp := entity.Employee{
@@ -49,17 +87,177 @@ func (c *AuthController) AuthLogin(req *entity.LoginRequest) (string, string, er
 		Role:     role,
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(*emp.Password), []byte(req.Password)); err != nil {
-		c.deps.Logger.Warn("Invalid password", slog.String("email", req.Email))
+	match, needsRehash, err := c.deps.Passwords.Hasher.Verify(password, req.Password)
+	if err != nil {
+		c.deps.Logger.Error("Error verifying password", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !match {
+		c.deps.Logger.Warn("Invalid password", slog.String("email", req.Email), slog.String("ip", req.ClientIP))
+		c.limiter.Fail(ctx, req.Email, req.ClientIP)
+		return nil, ErrInvalidCredentials
+	}
+
+	if !accountActive(isActive, status) {
+		c.deps.Logger.Warn("Login to inactive account", slog.Any("user_id", id), slog.String("status", status))
+		return nil, ErrAccountInactive
+	}
+
+	if needsRehash {
+		c.rehashPassword(id, req.Password)
+	}
+
+	resp, err := c.openSession(ctx, emp, mustChangePassword)
+	if err != nil {
+		return nil, err
+	}
+
+	// With TOTP enabled the failed attempts are forgotten only after the second
+	// factor, so that logging in again does not reset the count of wrong codes.
+	if resp.MFAToken == "" {
+		c.limiter.Succeed(ctx, req.Email)
+	}
+
+	return resp, nil
+}
+
+// openSession issues the token pair of a new session, or only an MFA token for
+// VerifyMFA if the employee has TOTP enabled. Employees of a role with mandatory
+// MFA who have not enabled it yet get a session limited to the enrollment.
+func (c *AuthController) openSession(ctx context.Context, emp entity.Employee, mustChangePassword bool) (*entity.LoginResponse, error) {
+	mfaEnabled, err := c.mfaEnabled(ctx, *emp.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if mfaEnabled {
+		mfaToken, mfaErr := c.createMFAToken(ctx, emp)
+		if mfaErr != nil {
+			return nil, mfaErr
+		}
+
+		return &entity.LoginResponse{MFAToken: mfaToken}, nil
+	}
+
+	sessionID, err := generateTokenID(c.deps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := c.issueTokens(emp, &entity.Session{
+		ID:                     sessionID,
+		CreatedAt:              time.Now(),
+		PasswordChangeRequired: mustChangePassword,
+		MFAEnrollmentRequired:  c.mfaRequired(emp.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// rehashPassword replaces a hash made with an outdated algorithm or parameters
+// after a successful login. Failures are only logged, the login goes on.
+func (c *AuthController) rehashPassword(employeeID uint64, password string) {
+	hash, err := c.deps.Passwords.Hasher.Hash(password)
+	if err != nil {
+		c.deps.Logger.Error("Error rehashing password", slog.String("error", err.Error()))
+		return
+	}
+
+	if _, err = c.deps.DB.Exec(context.Background(), "UPDATE employees SET password = $1 WHERE id = $2", hash, employeeID); err != nil {
+		c.deps.Logger.Error("Error storing rehashed password", slog.String("error", err.Error()))
+		return
+	}
+
+	c.deps.Logger.Info("Password rehashed", slog.Any("employee_id", employeeID), slog.String("algorithm", c.deps.Passwords.Hasher.Algorithm))
+}
+
+// AuthRefresh exchanges a refresh token for a new token pair of the same session.
+// The presented refresh token is revoked, and reusing an already rotated one
+// revokes the whole session.
+func (c *AuthController) AuthRefresh(refreshToken string) (string, string, error) {
+	claims, err := c.parseToken(refreshToken)
+	if err != nil || claims.Type != TokenTypeRefresh {
+		c.deps.Logger.Warn("Invalid refresh token")
+		return "", "", ErrInvalidRefreshToken
+	}
+
+	ctx := context.Background()
+	deleted, err := c.deps.Redis.Del(ctx, "refresh_token:"+refreshToken).Result()
+	if err != nil {
+		c.deps.Logger.Error("Error deleting refresh token", slog.String("error", err.Error()))
+		return "", "", err
+	}
+
+	if deleted == 0 {
+		c.deps.Logger.Warn("Refresh token reuse detected",
+			slog.Any("user_id", claims.ID),
+			slog.String("token_id", claims.TokenID),
+		)
+
+		if err = c.sessions.Revoke(ctx, claims.TokenID); err != nil {
+			return "", "", err
+		}
+
+		return "", "", ErrRefreshTokenReused
+	}
+
+	session, err := c.sessions.Get(ctx, claims.TokenID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			c.deps.Logger.Warn("Session revoked", slog.String("token_id", claims.TokenID))
+			return "", "", ErrInvalidRefreshToken
+		}
+
+		return "", "", err
+	}
+
+	if err = c.deps.Redis.Del(ctx, "access_token:"+session.AccessToken).Err(); err != nil {
+		c.deps.Logger.Error("Error deleting access token", slog.String("error", err.Error()))
 		return "", "", err
 	}
 
-	accessToken, err := c.createToken(emp, "access")
+	var id uint64
+	var email, role, status string
+	var isActive bool
+
+	query := "SELECT id, email, role, must_change_password, is_active, status FROM employees WHERE id = $1"
+	if err = c.deps.DB.QueryRow(ctx, query, claims.ID).Scan(&id, &email, &role, &session.PasswordChangeRequired, &isActive, &status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Employee of refresh token not found", slog.Any("id", claims.ID))
+			return "", "", ErrInvalidRefreshToken
+		}
+
+		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
+		return "", "", err
+	}
+
+	if !accountActive(isActive, status) {
+		c.deps.Logger.Warn("Refresh of inactive account", slog.Any("user_id", id), slog.String("status", status))
+		if err = c.sessions.Revoke(ctx, claims.TokenID); err != nil {
+			return "", "", err
+		}
+
+		return "", "", ErrInvalidRefreshToken
+	}
+
+	return c.issueTokens(entity.Employee{ID: &id, Email: &email, Role: role}, session)
+}
+
+// issueTokens creates an access/refresh pair of the session and stores it in Redis.
+func (c *AuthController) issueTokens(emp entity.Employee, session *entity.Session) (string, string, error) {
+	accessToken, err := c.createToken(emp, TokenTypeAccess, session)
 	if err != nil {
 		return "", "", err
 	}
 
-	refreshToken, err := c.createToken(emp, "refresh")
+	refreshToken, err := c.createToken(emp, TokenTypeRefresh, session)
 	if err != nil {
 		return "", "", err
 	}
@@ -75,18 +273,89 @@ func (c *AuthController) AuthLogin(req *entity.LoginRequest) (string, string, er
 		return "", "", err
 	}
 
+	session.UserID = *emp.ID
+	session.AccessToken = accessToken
+	session.RefreshToken = refreshToken
+	session.RefreshedAt = time.Now()
+
+	if err = c.sessions.Save(ctx, session); err != nil {
+		return "", "", err
+	}
+
 	return accessToken, refreshToken, nil
 }
 
-func (c *AuthController) createToken(emp entity.Employee, tokenType string) (string, error) {
-	tokenID, err := generateTokenID(c.deps.Logger)
+// AuthLogout revokes the access and refresh tokens of the caller's current session.
+func (c *AuthController) AuthLogout(user *entity.Claims) error {
+	return c.sessions.Revoke(context.Background(), user.TokenID)
+}
+
+// ListSessions returns the active sessions of the user.
+func (c *AuthController) ListSessions(user *entity.Claims) ([]entity.SessionInfo, error) {
+	sessions, err := c.sessions.List(context.Background(), user.ID)
 	if err != nil {
-		c.deps.Logger.Error("Error generating refresh token ID", slog.String("error", err.Error()))
-		return "", err
+		return nil, err
 	}
 
+	infos := make([]entity.SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		infos = append(infos, entity.SessionInfo{
+			ID:          session.ID,
+			CreatedAt:   session.CreatedAt,
+			RefreshedAt: session.RefreshedAt,
+			Current:     session.ID == user.TokenID,
+		})
+	}
+
+	return infos, nil
+}
+
+// RevokeSession revokes one of the user's sessions.
+func (c *AuthController) RevokeSession(user *entity.Claims, sessionID string) error {
+	ctx := context.Background()
+
+	session, err := c.sessions.Get(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			c.deps.Logger.Warn("Session not found", slog.String("session_id", sessionID))
+			return ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	if session.UserID != user.ID {
+		c.deps.Logger.Warn("Session belongs to another user", slog.String("session_id", sessionID), slog.Any("user_id", user.ID))
+		return ErrSessionNotFound
+	}
+
+	return c.sessions.Revoke(ctx, sessionID)
+}
+
+// RevokeAllSessions revokes every session of the user, including the current one.
+func (c *AuthController) RevokeAllSessions(user *entity.Claims) error {
+	return c.sessions.RevokeAll(context.Background(), user.ID)
+}
+
+// ListLockouts returns the emails and client IPs that currently cannot log in.
+func (c *AuthController) ListLockouts() ([]entity.Lockout, error) {
+	return c.limiter.List(context.Background())
+}
+
+// ClearLockout lifts the lockout of an email or a client IP and resets its failed attempts.
+func (c *AuthController) ClearLockout(user *entity.Claims, scope, value string) error {
+	if err := c.limiter.Clear(context.Background(), scope, value); err != nil {
+		return err
+	}
+
+	c.deps.Logger.Info("Lockout cleared by admin", slog.Any("user_id", user.ID), slog.String("scope", scope))
+
+	return nil
+}
+
+func (c *AuthController) createToken(emp entity.Employee, tokenType string, session *entity.Session) (string, error) {
 	expiresAt := c.deps.Config.Redis.AccessTokenTTL
-	if tokenType == "refresh" {
+	if tokenType == TokenTypeRefresh {
 		expiresAt = c.deps.Config.Redis.RefreshTokenTTL
 	}
 
@@ -94,15 +363,18 @@ func (c *AuthController) createToken(emp entity.Employee, tokenType string) (str
 		ID:      *emp.ID,
 		Email:   *emp.Email,
 		Role:    emp.Role,
-		TokenID: tokenID,
+		TokenID: session.ID,
+		Type:    tokenType,
+
+		PasswordChangeRequired: session.PasswordChangeRequired,
+		MFAEnrollmentRequired:  session.MFAEnrollmentRequired,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresAt)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenStr, err := token.SignedString([]byte(c.deps.Config.Server.JWTSecret))
+	tokenStr, err := c.deps.Keys.Sign(claims)
 	if err != nil {
 		c.deps.Logger.Error("Error signing token", slog.String("error", err.Error()))
 		return "", err
@@ -111,6 +383,12 @@ func (c *AuthController) createToken(emp entity.Employee, tokenType string) (str
 	return tokenStr, nil
 }
 
+// accountActive reports whether the employee may log in: not deactivated,
+// fired or suspended.
+func accountActive(isActive bool, status string) bool {
+	return isActive && status != entity.StatusFired && status != entity.StatusSuspended
+}
+
 func generateTokenID(logger *slog.Logger) (string, error) {
 	b := make([]byte, TokenSize)
 	if _, err := rand.Read(b); err != nil {
@@ -122,6 +400,10 @@ func generateTokenID(logger *slog.Logger) (string, error) {
 }
 
 func (c *AuthController) CheckUserToken(authHeader string) (*entity.Claims, error) {
+	if secret, ok := strings.CutPrefix(authHeader, APIKeyScheme); ok {
+		return c.apiKeys.Authenticate(secret)
+	}
+
 	tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
 	if tokenStr == authHeader {
 		c.deps.Logger.Error("Invalid bearer token", slog.String("token", tokenStr))
@@ -129,14 +411,35 @@ func (c *AuthController) CheckUserToken(authHeader string) (*entity.Claims, erro
 	}
 
 	ctx := context.Background()
-	if err := c.deps.Redis.Get(ctx, "access_token:"+tokenStr).Err(); errors.Is(err, redis.Nil) {
-		c.deps.Logger.Warn("Token revoked", slog.String("token", tokenStr))
-		return nil, errors.New("token revoked")
+	if err := c.deps.Redis.Get(ctx, "access_token:"+tokenStr).Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			c.deps.Logger.Warn("Token revoked", slog.String("token", tokenStr))
+			return nil, errors.New("token revoked")
+		}
+
+		c.deps.Logger.Error("Error checking access token", slog.String("error", err.Error()))
+		return nil, err
 	}
 
-	token, err := jwt.ParseWithClaims(tokenStr, &entity.Claims{}, func(_ *jwt.Token) (any, error) {
-		return []byte(c.deps.Config.Server.JWTSecret), nil
-	})
+	claims, err := c.parseToken(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refresh and MFA tokens are signed with the same keys, only access tokens,
+	// impersonation ones included, authorize API requests.
+	if claims.Type != TokenTypeAccess {
+		c.deps.Logger.Warn("Token used as access token", slog.Any("user_id", claims.ID), slog.String("type", claims.Type))
+		return nil, errors.New("invalid token")
+	}
+
+	return claims, nil
+}
+
+// parseToken verifies the token signature with the key selected by its kid and
+// the expiration and returns its claims.
+func (c *AuthController) parseToken(tokenStr string) (*entity.Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenStr, &entity.Claims{}, c.deps.Keys.Keyfunc, jwt.WithValidMethods(c.deps.Keys.ValidMethods()))
 	if err != nil {
 		c.deps.Logger.Error("Error parsing token", slog.String("error", err.Error()))
 		return nil, errors.New("invalid token")
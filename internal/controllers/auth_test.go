@@ -18,6 +18,8 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const mfaEnabledQuery = "SELECT enabled FROM employee_mfa WHERE employee_id = $1"
+
 var LoginRequestFieldDescriptions = []pgconn.FieldDescription{
 	{Name: "email", DataTypeOID: 25},    // text (string)
 	{Name: "password", DataTypeOID: 25}, // text (string)
@@ -42,10 +44,12 @@ func TestAuthController_AuthLogin(t *testing.T) {
 				passwordStr := string(hashedPassword)
 
 				mockRow := NewMockRow([]interface{}{
-					uint64(1), "test@example.com", passwordStr, "employee",
+					uint64(1), "test@example.com", passwordStr, "employee", false, true, entity.StatusActive,
 				}, nil, LoginRequestFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), "test@example.com").Return(mockRow)
 
+				mockDB.On("QueryRow", mock.Anything, mfaEnabledQuery, uint64(1)).Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+
 				errorCmd := redis.NewStatusCmd(context.Background())
 				errorCmd.SetErr(errors.New("redis error"))
 
@@ -56,6 +60,70 @@ func TestAuthController_AuthLogin(t *testing.T) {
 			expectError:   true,
 			errorContains: "redis error",
 		},
+		{
+			name: "outdated hash is rehashed",
+			loginReq: &entity.LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+
+				mockRow := NewMockRow([]interface{}{
+					uint64(1), "test@example.com", string(hashedPassword), "employee", false, true, entity.StatusActive,
+				}, nil, LoginRequestFieldDescriptions)
+				mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), "test@example.com").Return(mockRow)
+				mockDB.On("Exec", mock.Anything, "UPDATE employees SET password = $1 WHERE id = $2", mock.MatchedBy(func(hash string) bool {
+					cost, err := bcrypt.Cost([]byte(hash))
+					return err == nil && cost == bcrypt.DefaultCost
+				}), uint64(1)).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+
+				mockDB.On("QueryRow", mock.Anything, mfaEnabledQuery, uint64(1)).Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+
+				errorCmd := redis.NewStatusCmd(context.Background())
+				errorCmd.SetErr(errors.New("redis error"))
+
+				mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+					return strings.Contains(key, "access_token:")
+				}), "valid", mock.AnythingOfType("time.Duration")).Return(errorCmd)
+			},
+			expectError:   true,
+			errorContains: "redis error",
+		},
+		{
+			name: "fired employee cannot log in",
+			loginReq: &entity.LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
+
+				mockRow := NewMockRow([]interface{}{
+					uint64(1), "test@example.com", string(hashedPassword), "employee", false, true, entity.StatusFired,
+				}, nil, LoginRequestFieldDescriptions)
+				mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), "test@example.com").Return(mockRow)
+			},
+			expectError:   true,
+			errorContains: ErrAccountInactive.Error(),
+		},
+		{
+			name: "deactivated employee cannot log in",
+			loginReq: &entity.LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
+
+				mockRow := NewMockRow([]interface{}{
+					uint64(1), "test@example.com", string(hashedPassword), "employee", false, false, entity.StatusActive,
+				}, nil, LoginRequestFieldDescriptions)
+				mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), "test@example.com").Return(mockRow)
+			},
+			expectError:   true,
+			errorContains: ErrAccountInactive.Error(),
+		},
 		{
 			name: "user not found",
 			loginReq: &entity.LoginRequest{
@@ -65,9 +133,11 @@ func TestAuthController_AuthLogin(t *testing.T) {
 			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
 				mockRow := NewMockRow(nil, pgx.ErrNoRows, LoginRequestFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), "notfound@example.com").Return(mockRow)
+				mockRedis.On("Incr", mock.Anything, "login_failures:email:notfound@example.com").Return(int64(1))
+				mockRedis.On("Expire", mock.Anything, "login_failures:email:notfound@example.com", DefaultLoginFailureWindow).Return(nil)
 			},
 			expectError:   true,
-			errorContains: "user with this email not found",
+			errorContains: ErrInvalidCredentials.Error(),
 		},
 		{
 			name: "database error",
@@ -96,8 +166,10 @@ func TestAuthController_AuthLogin(t *testing.T) {
 					uint64(1), "test@example.com", &passwordStr, "employee",
 				}, nil, LoginRequestFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), "test@example.com").Return(mockRow)
+				mockRedis.On("Incr", mock.Anything, "login_failures:email:test@example.com").Return(int64(2))
 			},
-			expectError: true,
+			expectError:   true,
+			errorContains: ErrInvalidCredentials.Error(),
 		},
 		{
 			name: "redis error on access token",
@@ -110,10 +182,12 @@ func TestAuthController_AuthLogin(t *testing.T) {
 				passwordStr := string(hashedPassword)
 
 				mockRow := NewMockRow([]interface{}{
-					uint64(1), "test@example.com", passwordStr, "employee",
+					uint64(1), "test@example.com", passwordStr, "employee", false, true, entity.StatusActive,
 				}, nil, LoginRequestFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), "test@example.com").Return(mockRow)
 
+				mockDB.On("QueryRow", mock.Anything, mfaEnabledQuery, uint64(1)).Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+
 				errorCmd := redis.NewStatusCmd(context.Background())
 				errorCmd.SetErr(errors.New("redis error"))
 				mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
@@ -131,23 +205,23 @@ func TestAuthController_AuthLogin(t *testing.T) {
 			mockRedis := &MockRedis{}
 			deps := CreateTestDependencies(mockDB, mockRedis)
 
+			mockRedis.On("TTL", mock.Anything, "login_lock:email:"+tt.loginReq.Email).Return(nil)
 			tt.setupMocks(mockDB, mockRedis)
 
 			controller := NewAuthController(deps)
 
-			accessToken, refreshToken, err := controller.AuthLogin(tt.loginReq)
+			resp, err := controller.AuthLogin(tt.loginReq)
 
 			if tt.expectError {
 				assert.Error(t, err)
 				if tt.errorContains != "" {
 					assert.Contains(t, err.Error(), tt.errorContains)
 				}
-				assert.Empty(t, accessToken)
-				assert.Empty(t, refreshToken)
+				assert.Nil(t, resp)
 			} else {
 				assert.NoError(t, err)
-				assert.NotEmpty(t, accessToken)
-				assert.NotEmpty(t, refreshToken)
+				assert.NotEmpty(t, resp.AccessToken)
+				assert.NotEmpty(t, resp.RefreshToken)
 			}
 
 			mockDB.AssertExpectations(t)
@@ -184,7 +258,7 @@ func TestAuthController_createToken(t *testing.T) {
 			deps := CreateTestDependencies(mockDB, mockRedis)
 
 			controller := NewAuthController(deps)
-			token, err := controller.createToken(tt.employee, tt.tokenType)
+			token, err := controller.createToken(tt.employee, tt.tokenType, &entity.Session{ID: "test-token-id"})
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -205,12 +279,131 @@ func TestAuthController_createToken(t *testing.T) {
 				assert.Equal(t, *tt.employee.ID, claims.ID)
 				assert.Equal(t, *tt.employee.Email, claims.Email)
 				assert.Equal(t, tt.employee.Role, claims.Role)
-				assert.NotEmpty(t, claims.TokenID)
+				assert.Equal(t, "test-token-id", claims.TokenID)
+				assert.Equal(t, tt.tokenType, claims.Type)
 			}
 		})
 	}
 }
 
+func TestAuthController_AuthRefresh(t *testing.T) {
+	employee := CreateTestEmployee()
+
+	intCmd := func(val int64) *redis.IntCmd {
+		cmd := redis.NewIntCmd(context.Background())
+		cmd.SetVal(val)
+		return cmd
+	}
+
+	tests := []struct {
+		name        string
+		tokenType   string
+		setupMocks  func(*MockDB, *MockRedis, string)
+		expectedErr error
+	}{
+		{
+			name:      "successful rotation",
+			tokenType: TokenTypeRefresh,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, token string) {
+				mockRedis.On("Del", mock.Anything, []string{"refresh_token:" + token}).Return(intCmd(1))
+				mockRedis.On("Get", mock.Anything, "session:session-id").Return(sessionCmd(entity.Session{
+					ID:           "session-id",
+					UserID:       1,
+					AccessToken:  "old-access",
+					RefreshToken: token,
+				}))
+				mockRedis.On("Del", mock.Anything, []string{"access_token:old-access"}).Return(intCmd(1))
+
+				mockRow := NewMockRow([]interface{}{uint64(1), "test@example.com", "employee", false, true, entity.StatusActive}, nil, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT id, email, role, must_change_password, is_active, status FROM employees WHERE id = $1", uint64(1)).Return(mockRow)
+
+				mockRedis.On("Set", mock.Anything, mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("time.Duration")).Return(nil)
+				mockRedis.On("SAdd", mock.Anything, "user_sessions:1", []interface{}{"session-id"}).Return(nil)
+			},
+		},
+		{
+			name:        "access token is rejected",
+			tokenType:   TokenTypeAccess,
+			setupMocks:  func(mockDB *MockDB, mockRedis *MockRedis, token string) {},
+			expectedErr: ErrInvalidRefreshToken,
+		},
+		{
+			name:      "reuse revokes session",
+			tokenType: TokenTypeRefresh,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, token string) {
+				mockRedis.On("Del", mock.Anything, []string{"refresh_token:" + token}).Return(intCmd(0))
+				mockRedis.On("Get", mock.Anything, "session:session-id").Return(sessionCmd(entity.Session{
+					ID:           "session-id",
+					UserID:       1,
+					AccessToken:  "current-access",
+					RefreshToken: "current-refresh",
+				}))
+				mockRedis.On("Del", mock.Anything, []string{
+					"access_token:current-access",
+					"refresh_token:current-refresh",
+					"session:session-id",
+				}).Return(intCmd(3))
+				mockRedis.On("SRem", mock.Anything, "user_sessions:1", []interface{}{"session-id"}).Return(nil)
+			},
+			expectedErr: ErrRefreshTokenReused,
+		},
+		{
+			name:      "revoked session",
+			tokenType: TokenTypeRefresh,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, token string) {
+				mockRedis.On("Del", mock.Anything, []string{"refresh_token:" + token}).Return(intCmd(1))
+
+				revokedCmd := redis.NewStringCmd(context.Background())
+				revokedCmd.SetErr(redis.Nil)
+				mockRedis.On("Get", mock.Anything, "session:session-id").Return(revokedCmd)
+			},
+			expectedErr: ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			deps := CreateTestDependencies(mockDB, mockRedis)
+			controller := NewAuthController(deps)
+
+			token, err := controller.createToken(employee, tt.tokenType, &entity.Session{ID: "session-id"})
+			assert.NoError(t, err)
+
+			tt.setupMocks(mockDB, mockRedis, token)
+
+			accessToken, refreshToken, err := controller.AuthRefresh(token)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, accessToken)
+				assert.Empty(t, refreshToken)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, accessToken)
+				assert.NotEmpty(t, refreshToken)
+
+				claims, parseErr := controller.parseToken(refreshToken)
+				assert.NoError(t, parseErr)
+				assert.Equal(t, "session-id", claims.TokenID)
+				assert.Equal(t, TokenTypeRefresh, claims.Type)
+			}
+
+			mockDB.AssertExpectations(t)
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_AuthRefresh_InvalidToken(t *testing.T) {
+	deps := CreateTestDependencies(&MockDB{}, &MockRedis{})
+	controller := NewAuthController(deps)
+
+	_, _, err := controller.AuthRefresh("not-a-jwt")
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+}
+
 func TestGenerateTokenID(t *testing.T) {
 	mockDB := &MockDB{}
 	mockRedis := &MockRedis{}
@@ -262,6 +455,26 @@ func TestAuthController_CheckUserToken(t *testing.T) {
 			expectError:   true,
 			errorContains: "token revoked",
 		},
+		{
+			name:       "redis unavailable",
+			authHeader: "Bearer token",
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, token string) {
+				failedCmd := redis.NewStringCmd(context.Background())
+				failedCmd.SetErr(errors.New("connection refused"))
+				mockRedis.On("Get", mock.Anything, "access_token:"+token).Return(failedCmd)
+			},
+			expectError:   true,
+			errorContains: "connection refused",
+		},
+		{
+			name:       "refresh token",
+			authHeader: "Bearer token",
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, token string) {
+				mockRedis.On("Get", mock.Anything, "access_token:"+token).Return(redis.NewStringCmd(context.Background()))
+			},
+			expectError:   true,
+			errorContains: "invalid token",
+		},
 		{
 			name:       "invalid token format",
 			authHeader: "Bearer invalid-token-format",
@@ -325,11 +538,17 @@ func TestAuthController_CheckUserToken(t *testing.T) {
 				tokenString, _ := jwtToken.SignedString([]byte("test-secret-key"))
 				actualToken = tokenString
 				tt.authHeader = "Bearer " + tokenString
-			case "valid token":
+			case "valid token", "redis unavailable", "refresh token":
+				tokenType := TokenTypeAccess
+				if tt.name == "refresh token" {
+					tokenType = TokenTypeRefresh
+				}
+
 				claims := &entity.Claims{
 					ID:      1,
 					Email:   "test@example.com",
 					Role:    "employee",
+					Type:    tokenType,
 					TokenID: "test-token-id",
 					RegisteredClaims: jwt.RegisteredClaims{
 						ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
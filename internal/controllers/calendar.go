@@ -0,0 +1,311 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	// MaxCalendarImportSize is the largest .ics or CSV file accepted by ImportCalendarDays.
+	MaxCalendarImportSize = 1 << 20
+
+	// maxWorkingDaysRange keeps CountWorkingDays from generating arbitrary long series.
+	maxWorkingDaysRange = 10 * 366
+)
+
+const calendarColumns = `id, name, description, created_at, updated_at`
+
+// calendarNameConstraint keeps calendar names unique.
+const calendarNameConstraint = "unique_calendar_name"
+
+var (
+	ErrInvalidCalendar      = errors.New("invalid calendar")
+	ErrCalendarNotFound     = errors.New("calendar not found")
+	ErrCalendarDayNotFound  = errors.New("calendar day not found")
+	ErrCalendarConflict     = errors.New("calendar name already exists")
+	ErrInvalidCalendarRange = errors.New("invalid period")
+)
+
+// CalendarController keeps the production calendars: the public holidays and
+// transferred days of each office, and counts working days by them.
+type CalendarController struct {
+	deps *Dependens
+}
+
+func NewCalendarController(deps *Dependens) *CalendarController {
+	return &CalendarController{
+		deps: deps,
+	}
+}
+
+func (c *CalendarController) GetCalendars() ([]entity.Calendar, error) {
+	rows, err := c.deps.DB.Query(context.Background(), "SELECT "+calendarColumns+" FROM calendars ORDER BY name")
+	if err != nil {
+		c.deps.Logger.Error("Error querying calendars", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	calendars, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.Calendar])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return calendars, nil
+}
+
+func (c *CalendarController) CreateCalendar(form entity.CalendarForm) (*entity.Calendar, error) {
+	name := strings.TrimSpace(form.Name)
+	if name == "" {
+		c.deps.Logger.Warn("Invalid calendar", slog.String("error", "name is required"))
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidCalendar)
+	}
+
+	query := `INSERT INTO calendars (name, description, created_at, updated_at)
+              VALUES ($1, $2, $3, $3)
+              RETURNING ` + calendarColumns
+
+	rows, err := c.deps.DB.Query(context.Background(), query, name, form.Description, time.Now())
+	if err != nil {
+		return nil, c.calendarError(err)
+	}
+	defer rows.Close()
+
+	calendar, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.Calendar])
+	if err != nil {
+		return nil, c.calendarError(err)
+	}
+
+	c.deps.Logger.Info("Calendar created", slog.Any("id", calendar.ID), slog.String("name", calendar.Name))
+
+	return &calendar, nil
+}
+
+// DeleteCalendar deletes the calendar with its days. Its departments fall back
+// to the calendar of their parent departments.
+func (c *CalendarController) DeleteCalendar(id uint64) error {
+	result, err := c.deps.DB.Exec(context.Background(), "DELETE FROM calendars WHERE id = $1", id)
+	if err != nil {
+		c.deps.Logger.Error("Error deleting calendar", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrCalendarNotFound
+	}
+
+	c.deps.Logger.Info("Calendar deleted", slog.Any("id", id))
+
+	return nil
+}
+
+// GetCalendarDays returns the holidays and transferred working days of the
+// calendar in date order.
+func (c *CalendarController) GetCalendarDays(id uint64, params *entity.GetCalendarDaysParams) ([]entity.CalendarDay, error) {
+	if err := c.checkCalendar(id); err != nil {
+		return nil, err
+	}
+
+	query := "SELECT calendar_id, date, kind, name FROM calendar_days WHERE calendar_id = $1"
+	args := []any{id}
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		query += " AND " + strings.ReplaceAll(condition, "$n", fmt.Sprintf("$%d", len(args)))
+	}
+
+	if params != nil {
+		if params.From != nil && params.To != nil && params.To.Before(*params.From) {
+			return nil, fmt.Errorf("%w: to is before from", ErrInvalidCalendarRange)
+		}
+
+		if params.From != nil {
+			add("date >= $n", dateOnly(*params.From))
+		}
+
+		if params.To != nil {
+			add("date <= $n", dateOnly(*params.To))
+		}
+	}
+
+	query += " ORDER BY date"
+
+	rows, err := c.deps.DB.Query(context.Background(), query, args...)
+	if err != nil {
+		c.deps.Logger.Error("Error querying calendar days", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	days, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.CalendarDay])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return days, nil
+}
+
+// ImportCalendarDays adds the days of an .ics or CSV file to the calendar. A
+// day already in the calendar is replaced, so a corrected file can be imported
+// again.
+func (c *CalendarController) ImportCalendarDays(id uint64, format string, content []byte) (*entity.CalendarImport, error) {
+	if len(content) > MaxCalendarImportSize {
+		return nil, fmt.Errorf("%w: file is larger than %d bytes", ErrInvalidCalendar, MaxCalendarImportSize)
+	}
+
+	var (
+		days []entity.CalendarDay
+		err  error
+	)
+
+	switch format {
+	case entity.CalendarFormatICS:
+		days, err = parseICSCalendar(content)
+	case entity.CalendarFormatCSV:
+		days, err = parseCSVCalendar(content)
+	default:
+		err = fmt.Errorf("%w: format must be %s or %s", ErrInvalidCalendar, entity.CalendarFormatICS, entity.CalendarFormatCSV)
+	}
+
+	if err != nil {
+		c.deps.Logger.Warn("Invalid calendar file", slog.Any("id", id), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err = c.checkCalendar(id); err != nil {
+		return nil, err
+	}
+
+	dates := make([]time.Time, 0, len(days))
+	kinds := make([]string, 0, len(days))
+	names := make([]*string, 0, len(days))
+
+	for _, day := range days {
+		dates = append(dates, day.Date)
+		kinds = append(kinds, day.Kind)
+		names = append(names, day.Name)
+	}
+
+	ctx := context.Background()
+	query := `INSERT INTO calendar_days (calendar_id, date, kind, name)
+              SELECT $1, * FROM unnest($2::date[], $3::varchar[], $4::varchar[])
+              ON CONFLICT (calendar_id, date) DO UPDATE SET kind = EXCLUDED.kind, name = EXCLUDED.name`
+
+	result, err := c.deps.DB.Exec(ctx, query, id, dates, kinds, names)
+	if err != nil {
+		c.deps.Logger.Error("Error importing calendar days", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err = c.deps.DB.Exec(ctx, "UPDATE calendars SET updated_at = $1 WHERE id = $2", time.Now(), id); err != nil {
+		c.deps.Logger.Warn("Error touching calendar", slog.Any("id", id), slog.String("error", err.Error()))
+	}
+
+	c.deps.Logger.Info("Calendar days imported", slog.Any("id", id), slog.String("format", format), slog.Int64("days", result.RowsAffected()))
+
+	return &entity.CalendarImport{CalendarID: id, Imported: result.RowsAffected()}, nil
+}
+
+// DeleteCalendarDay removes a day from the calendar, it becomes an ordinary
+// weekday or weekend again.
+func (c *CalendarController) DeleteCalendarDay(id uint64, date time.Time) error {
+	result, err := c.deps.DB.Exec(context.Background(), "DELETE FROM calendar_days WHERE calendar_id = $1 AND date = $2", id, dateOnly(date))
+	if err != nil {
+		c.deps.Logger.Error("Error deleting calendar day", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrCalendarDayNotFound
+	}
+
+	return nil
+}
+
+// CountWorkingDays counts the working days from from to to, both included, by
+// the calendar of the employee.
+func (c *CalendarController) CountWorkingDays(from, to time.Time, employeeID uint64) (*entity.WorkingDays, error) {
+	from, to = dateOnly(from), dateOnly(to)
+
+	if err := validateWorkingDaysRange(from, to); err != nil {
+		c.deps.Logger.Warn("Invalid working days period", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	result := entity.WorkingDays{
+		EmployeeID:   employeeID,
+		From:         from,
+		To:           to,
+		CalendarDays: uint64(to.Sub(from).Hours()/24) + 1,
+	}
+
+	query := `SELECT calendar_id, working_days(calendar_id, $2, $3),
+                     (SELECT COUNT(*) FROM calendar_days WHERE calendar_id = employee.calendar_id AND kind = $4 AND date BETWEEN $2 AND $3)
+              FROM (SELECT employee_calendar(id) AS calendar_id FROM employees WHERE id = $1) employee`
+
+	err := c.deps.DB.QueryRow(context.Background(), query, employeeID, from, to, entity.CalendarDayHoliday).
+		Scan(&result.CalendarID, &result.WorkingDays, &result.Holidays)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEmployeeNotFound
+		}
+
+		c.deps.Logger.Error("Error counting working days", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *CalendarController) checkCalendar(id uint64) error {
+	var exists bool
+	if err := c.deps.DB.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM calendars WHERE id = $1)", id).
+		Scan(&exists); err != nil {
+		c.deps.Logger.Error("Error checking calendar", slog.String("error", err.Error()))
+		return err
+	}
+
+	if !exists {
+		return ErrCalendarNotFound
+	}
+
+	return nil
+}
+
+func (c *CalendarController) calendarError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == calendarNameConstraint {
+		c.deps.Logger.Warn("Calendar name already exists")
+		return ErrCalendarConflict
+	}
+
+	c.deps.Logger.Error("Error inserting calendar", slog.String("error", err.Error()))
+
+	return err
+}
+
+func validateWorkingDaysRange(from, to time.Time) error {
+	if from.IsZero() || to.IsZero() {
+		return fmt.Errorf("%w: from and to are required", ErrInvalidCalendarRange)
+	}
+
+	if to.Before(from) {
+		return fmt.Errorf("%w: to is before from", ErrInvalidCalendarRange)
+	}
+
+	if to.Sub(from).Hours()/24 >= maxWorkingDaysRange {
+		return fmt.Errorf("%w: period is longer than %d days", ErrInvalidCalendarRange, maxWorkingDaysRange)
+	}
+
+	return nil
+}
@@ -0,0 +1,357 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	calendarTokenPrefix     = "ecal_"
+	calendarTokenSize       = 32
+	calendarTokenPrefixSize = 13
+
+	// maxCalendarTokens limits the tokens of one employee, one per calendar client is enough.
+	maxCalendarTokens = 20
+)
+
+var (
+	ErrInvalidCalendarToken     = errors.New("invalid calendar token")
+	ErrCalendarTokenNotFound    = errors.New("calendar token not found")
+	ErrInvalidCalendarTokenForm = errors.New("invalid calendar token request")
+)
+
+type CalendarFeedController struct {
+	deps *Dependens
+}
+
+func NewCalendarFeedController(deps *Dependens) *CalendarFeedController {
+	return &CalendarFeedController{
+		deps: deps,
+	}
+}
+
+// calendarFeedUser is the employee a calendar token belongs to.
+type calendarFeedUser struct {
+	ID           uint64
+	Role         string
+	DepartmentID *uint64
+}
+
+// seesPrivate reports whether the user may see the sick leaves and the birthday
+// of the employee, by the rules of GetSickLeaves: admin and hr see everyone, a
+// manager their own and those of their team, others only their own.
+func (u *calendarFeedUser) seesPrivate(employeeID uint64, managerID *uint64) bool {
+	switch {
+	case u.Role == entity.RoleAdmin || u.Role == entity.RoleHR:
+		return true
+	case u.Role == entity.RoleManager && managerID != nil && *managerID == u.ID:
+		return true
+	default:
+		return employeeID == u.ID
+	}
+}
+
+// feedAbsence is an absence with the manager of the employee, to decide who
+// may see that it is a sick leave.
+type feedAbsence struct {
+	entity.Absence
+
+	ManagerID *uint64
+}
+
+// feedEmployee is an employee with the dates of the yearly feed events.
+type feedEmployee struct {
+	ID        uint64
+	FirstName string
+	LastName  string
+	Birthday  *time.Time
+	HireDate  *time.Time
+	ManagerID *uint64
+}
+
+// GetCalendarTokens returns the calendar tokens of the user without their secrets.
+func (c *CalendarFeedController) GetCalendarTokens(user *entity.Claims) ([]entity.CalendarToken, error) {
+	query := `SELECT id, employee_id, name, prefix, last_used_at, created_at FROM calendar_feed_tokens WHERE employee_id = $1 ORDER BY id`
+
+	rows, err := c.deps.DB.Query(context.Background(), query, user.ID)
+	if err != nil {
+		c.deps.Logger.Error("Error querying calendar tokens", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.CalendarToken])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// CreateCalendarToken generates a new token of the user. The secret is returned
+// only here, the database keeps its SHA-256 hash.
+func (c *CalendarFeedController) CreateCalendarToken(user *entity.Claims, form entity.CalendarTokenForm) (*entity.CreatedCalendarToken, error) {
+	ctx := context.Background()
+
+	name := strings.TrimSpace(form.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidCalendarTokenForm)
+	}
+
+	var count int
+	if err := c.deps.DB.QueryRow(ctx, "SELECT COUNT(*) FROM calendar_feed_tokens WHERE employee_id = $1", user.ID).Scan(&count); err != nil {
+		c.deps.Logger.Error("Error counting calendar tokens", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if count >= maxCalendarTokens {
+		c.deps.Logger.Warn("Too many calendar tokens", slog.Any("employee_id", user.ID))
+		return nil, fmt.Errorf("%w: at most %d tokens, revoke unused ones first", ErrInvalidCalendarTokenForm, maxCalendarTokens)
+	}
+
+	secret, err := generateCalendarToken()
+	if err != nil {
+		c.deps.Logger.Error("Error generating calendar token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	token := entity.CreatedCalendarToken{
+		CalendarToken: entity.CalendarToken{
+			EmployeeID: user.ID,
+			Name:       name,
+			Prefix:     secret[:calendarTokenPrefixSize],
+			CreatedAt:  time.Now(),
+		},
+		Token: secret,
+	}
+
+	query := `INSERT INTO calendar_feed_tokens (employee_id, name, prefix, token_hash, created_at)
+              VALUES ($1, $2, $3, $4, $5)
+              RETURNING id`
+
+	if err = c.deps.DB.QueryRow(ctx, query, token.EmployeeID, token.Name, token.Prefix, hashAPIKey(secret), token.CreatedAt).
+		Scan(&token.ID); err != nil {
+		c.deps.Logger.Error("Error inserting calendar token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	c.deps.Logger.Info("Calendar token created", slog.Any("calendar_token_id", token.ID), slog.Any("employee_id", user.ID))
+
+	return &token, nil
+}
+
+// DeleteCalendarToken revokes a token of the user, the subscriptions using it stop updating.
+func (c *CalendarFeedController) DeleteCalendarToken(user *entity.Claims, id uint64) error {
+	result, err := c.deps.DB.Exec(context.Background(), "DELETE FROM calendar_feed_tokens WHERE id = $1 AND employee_id = $2", id, user.ID)
+	if err != nil {
+		c.deps.Logger.Error("Error deleting calendar token", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		c.deps.Logger.Warn("Calendar token not found", slog.Any("id", id), slog.Any("employee_id", user.ID))
+		return ErrCalendarTokenNotFound
+	}
+
+	c.deps.Logger.Info("Calendar token deleted", slog.Any("calendar_token_id", id), slog.Any("employee_id", user.ID))
+
+	return nil
+}
+
+// EmployeeFeed returns the absences of the employee as an iCalendar file. The
+// owner of the token sees the feed of any employee with a role allowed by
+// OpGetEmployeeAbsenceFeed, otherwise only their own and those of their team.
+func (c *CalendarFeedController) EmployeeFeed(token string, employeeID uint64, params entity.CalendarFeedParams) ([]byte, error) {
+	user, err := c.authenticate(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstName, lastName string
+	var managerID *uint64
+
+	query := "SELECT first_name, last_name, manager_id FROM employees WHERE id = $1 AND status <> $2"
+	if err = c.deps.DB.QueryRow(context.Background(), query, employeeID, entity.StatusFired).Scan(&firstName, &lastName, &managerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Employee not found", slog.Any("employee_id", employeeID))
+			return nil, ErrEmployeeNotFound
+		}
+
+		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !HasRole(user.Role, OpGetEmployeeAbsenceFeed) && user.ID != employeeID && (managerID == nil || *managerID != user.ID) {
+		c.deps.Logger.Warn("Permission denied", slog.Any("operation", OpGetEmployeeAbsenceFeed), slog.Any("user_id", user.ID))
+		return nil, ErrPermissionDenied
+	}
+
+	return c.feed(user, firstName+" "+lastName, "e.id = $1", employeeID, params)
+}
+
+// DepartmentFeed returns the absences of the department employees as an
+// iCalendar file. The owner of the token sees the feed of any department with
+// a role allowed by OpGetDepartmentAbsenceFeed, otherwise only of the
+// department they work in or head.
+func (c *CalendarFeedController) DepartmentFeed(token string, departmentID uint64, params entity.CalendarFeedParams) ([]byte, error) {
+	user, err := c.authenticate(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var name string
+	var headID *uint64
+
+	query := "SELECT name, head_id FROM departments WHERE id = $1"
+	if err = c.deps.DB.QueryRow(context.Background(), query, departmentID).Scan(&name, &headID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Department not found", slog.Any("department_id", departmentID))
+			return nil, ErrDepartmentNotFound
+		}
+
+		c.deps.Logger.Error("Error querying department", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !HasRole(user.Role, OpGetDepartmentAbsenceFeed) && (headID == nil || *headID != user.ID) &&
+		(user.DepartmentID == nil || *user.DepartmentID != departmentID) {
+		c.deps.Logger.Warn("Permission denied", slog.Any("operation", OpGetDepartmentAbsenceFeed), slog.Any("user_id", user.ID))
+		return nil, ErrPermissionDenied
+	}
+
+	return c.feed(user, name, "e.department_id = $1", departmentID, params)
+}
+
+// authenticate returns the owner of the token and records its use. Tokens of
+// employees who may not log in, deactivated, fired or suspended, do not work.
+func (c *CalendarFeedController) authenticate(token string) (*calendarFeedUser, error) {
+	ctx := context.Background()
+
+	var id uint64
+	var user calendarFeedUser
+	var isActive bool
+	var status string
+
+	query := `SELECT t.id, e.id, e.role, e.department_id, e.is_active, e.status
+              FROM calendar_feed_tokens t JOIN employees e ON e.id = t.employee_id
+              WHERE t.token_hash = $1`
+
+	if err := c.deps.DB.QueryRow(ctx, query, hashAPIKey(token)).
+		Scan(&id, &user.ID, &user.Role, &user.DepartmentID, &isActive, &status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Unknown calendar token", slog.String("prefix", calendarTokenDisplayPrefix(token)))
+			return nil, ErrInvalidCalendarToken
+		}
+
+		c.deps.Logger.Error("Error querying calendar token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !accountActive(isActive, status) {
+		c.deps.Logger.Warn("Calendar token of inactive account", slog.Any("user_id", user.ID), slog.String("status", status))
+		return nil, ErrInvalidCalendarToken
+	}
+
+	if _, err := c.deps.DB.Exec(ctx, "UPDATE calendar_feed_tokens SET last_used_at = $1 WHERE id = $2", time.Now(), id); err != nil {
+		c.deps.Logger.Error("Error updating calendar token usage", slog.String("error", err.Error()))
+	}
+
+	return &user, nil
+}
+
+// feed renders the absences of the employees matching the condition on $1,
+// from a year ago on, and their yearly events if asked for. Sick leaves the user
+// may not see are shown as absences and their birthdays are left out.
+func (c *CalendarFeedController) feed(user *calendarFeedUser, name, condition string, id uint64, params entity.CalendarFeedParams) ([]byte, error) {
+	ctx := context.Background()
+	now := time.Now()
+
+	query := `SELECT a.kind, a.id, e.id AS employee_id, e.first_name, e.last_name, a.reason, a.start_date, a.end_date, a.updated_at,
+                     e.manager_id
+              FROM (SELECT $2::varchar AS kind, id, employee_id, type AS reason, start_date, end_date, updated_at FROM vacation_requests
+                    WHERE status = $4 AND end_date >= $5
+                    UNION ALL
+                    SELECT $3::varchar, id, employee_id, '', start_date, COALESCE(end_date, CURRENT_DATE), updated_at FROM sick_leaves
+                    WHERE COALESCE(end_date, CURRENT_DATE) >= $5) a
+              JOIN employees e ON e.id = a.employee_id
+              WHERE ` + condition + ` AND e.status <> $6
+              ORDER BY a.start_date, e.id`
+
+	rows, err := c.deps.DB.Query(ctx, query, id, entity.AbsenceVacation, entity.AbsenceSickLeave, entity.VacationStatusApproved,
+		dateOnly(now.AddDate(-1, 0, 0)), entity.StatusFired)
+	if err != nil {
+		c.deps.Logger.Error("Error querying absences", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsAbsences, err := pgx.CollectRows(rows, pgx.RowToStructByName[feedAbsence])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	absences := make([]entity.Absence, 0, len(rowsAbsences))
+	for _, absence := range rowsAbsences {
+		if absence.Kind == entity.AbsenceSickLeave && !user.seesPrivate(absence.EmployeeID, absence.ManagerID) {
+			absence.Kind = entity.AbsenceOther
+		}
+
+		absences = append(absences, absence.Absence)
+	}
+
+	var employees []feedEmployee
+	if params.Birthdays || params.Anniversaries {
+		query = `SELECT e.id, e.first_name, e.last_name, e.birthday, e.hire_date, e.manager_id FROM employees e
+                 WHERE ` + condition + ` AND e.status <> $2
+                 ORDER BY e.id`
+
+		rows, err = c.deps.DB.Query(ctx, query, id, entity.StatusFired)
+		if err != nil {
+			c.deps.Logger.Error("Error querying employees", slog.String("error", err.Error()))
+			return nil, err
+		}
+		defer rows.Close()
+
+		if employees, err = pgx.CollectRows(rows, pgx.RowToStructByName[feedEmployee]); err != nil {
+			c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		for i := range employees {
+			if !user.seesPrivate(employees[i].ID, employees[i].ManagerID) {
+				employees[i].Birthday = nil
+			}
+		}
+	}
+
+	return renderAbsenceCalendar("Absences: "+name, absences, employees, params, now), nil
+}
+
+func generateCalendarToken() (string, error) {
+	b := make([]byte, calendarTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return calendarTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// calendarTokenDisplayPrefix returns the part of the token that is safe to log.
+func calendarTokenDisplayPrefix(token string) string {
+	if len(token) < calendarTokenPrefixSize {
+		return ""
+	}
+
+	return token[:calendarTokenPrefixSize]
+}
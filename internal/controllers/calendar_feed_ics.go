@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/adamanr/employes_service/internal/entity"
+)
+
+const (
+	icsProductID   = "-//employee-service//absences//EN"
+	icsUIDDomain   = "employee-service"
+	icsStampLayout = "20060102T150405Z"
+
+	// icsLineSize is the longest line of an iCalendar file in octets, longer lines are folded.
+	icsLineSize = 75
+)
+
+// renderAbsenceCalendar writes the absences as all-day events of an iCalendar
+// file (RFC 5545). Birthdays and work anniversaries of the employees are
+// yearly events; the one of February 29 falls on the last day of February.
+func renderAbsenceCalendar(name string, absences []entity.Absence, employees []feedEmployee, params entity.CalendarFeedParams, now time.Time) []byte {
+	var b strings.Builder
+
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:"+icsProductID)
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "X-WR-CALNAME:"+escapeICSText(name))
+
+	for _, absence := range absences {
+		var summary string
+
+		switch absence.Kind {
+		case entity.AbsenceSickLeave:
+			summary = fmt.Sprintf("%s %s: sick leave", absence.FirstName, absence.LastName)
+		case entity.AbsenceOther:
+			summary = fmt.Sprintf("%s %s: absent", absence.FirstName, absence.LastName)
+		default:
+			summary = fmt.Sprintf("%s %s: %s vacation", absence.FirstName, absence.LastName, absence.Reason)
+		}
+
+		writeICSEvent(&b, fmt.Sprintf("%s-%d", absence.Kind, absence.ID), absence.UpdatedAt, absence.StartDate, absence.EndDate, summary, "")
+	}
+
+	for _, employee := range employees {
+		if params.Birthdays && employee.Birthday != nil {
+			writeICSEvent(&b, fmt.Sprintf("birthday-%d", employee.ID), now, *employee.Birthday, *employee.Birthday,
+				fmt.Sprintf("%s %s: birthday", employee.FirstName, employee.LastName), yearlyRule(*employee.Birthday))
+		}
+
+		if params.Anniversaries && employee.HireDate != nil {
+			anniversary := employee.HireDate.AddDate(1, 0, 0)
+			if employee.HireDate.Month() == time.February && employee.HireDate.Day() == 29 {
+				anniversary = time.Date(employee.HireDate.Year()+1, time.February, 28, 0, 0, 0, 0, time.UTC)
+			}
+
+			writeICSEvent(&b, fmt.Sprintf("anniversary-%d", employee.ID), now, anniversary, anniversary,
+				fmt.Sprintf("%s %s: work anniversary", employee.FirstName, employee.LastName), yearlyRule(*employee.HireDate))
+		}
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+// writeICSEvent writes an all-day event from start to end, both dates included.
+// Absences do not make the subscriber busy, they are shown as free time.
+func writeICSEvent(b *strings.Builder, uid string, stamp, start, end time.Time, summary, rule string) {
+	writeICSLine(b, "BEGIN:VEVENT")
+	writeICSLine(b, "UID:"+uid+"@"+icsUIDDomain)
+	writeICSLine(b, "DTSTAMP:"+stamp.UTC().Format(icsStampLayout))
+	writeICSLine(b, "DTSTART;VALUE=DATE:"+start.Format(icsDateLayout))
+	// DTEND of an all-day event is the day after the last one.
+	writeICSLine(b, "DTEND;VALUE=DATE:"+dateOnly(end).AddDate(0, 0, 1).Format(icsDateLayout))
+
+	if rule != "" {
+		writeICSLine(b, "RRULE:"+rule)
+	}
+
+	writeICSLine(b, "SUMMARY:"+escapeICSText(summary))
+	writeICSLine(b, "TRANSP:TRANSPARENT")
+	writeICSLine(b, "END:VEVENT")
+}
+
+// yearlyRule repeats the event every year on the day of the date.
+func yearlyRule(date time.Time) string {
+	if date.Month() == time.February && date.Day() == 29 {
+		return "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=-1"
+	}
+
+	return "FREQ=YEARLY"
+}
+
+// writeICSLine writes the line ended by CRLF, folding it into continuation
+// lines that start with a space. A character is never split between lines.
+func writeICSLine(b *strings.Builder, line string) {
+	size := icsLineSize
+
+	for len(line) > size {
+		cut := size
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space of a continuation line counts towards its size.
+		size = icsLineSize - 1
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func escapeICSText(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`)
+
+	return replacer.Replace(text)
+}
@@ -0,0 +1,290 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var AbsenceFieldDescriptions = []pgconn.FieldDescription{
+	{Name: "kind", DataTypeOID: 25},         // text (string)
+	{Name: "id", DataTypeOID: 20},           // int8 (uint64)
+	{Name: "employee_id", DataTypeOID: 20},  // int8 (uint64)
+	{Name: "first_name", DataTypeOID: 25},   // text (string)
+	{Name: "last_name", DataTypeOID: 25},    // text (string)
+	{Name: "reason", DataTypeOID: 25},       // text (string)
+	{Name: "start_date", DataTypeOID: 1082}, // date
+	{Name: "end_date", DataTypeOID: 1082},   // date
+	{Name: "updated_at", DataTypeOID: 1114}, // timestamp
+	{Name: "manager_id", DataTypeOID: 20},   // int8 (nullable)
+}
+
+var FeedEmployeeFieldDescriptions = []pgconn.FieldDescription{
+	{Name: "id", DataTypeOID: 20},          // int8 (uint64)
+	{Name: "first_name", DataTypeOID: 25},  // text (string)
+	{Name: "last_name", DataTypeOID: 25},   // text (string)
+	{Name: "birthday", DataTypeOID: 1082},  // date (nullable)
+	{Name: "hire_date", DataTypeOID: 1082}, // date (nullable)
+	{Name: "manager_id", DataTypeOID: 20},  // int8 (nullable)
+}
+
+const calendarFeedToken = "ecal_test-token"
+
+var (
+	calendarTokenQuery = queryContains("FROM calendar_feed_tokens t JOIN employees e")
+	absencesQuery      = queryContains("FROM vacation_requests", "FROM sick_leaves", "ORDER BY a.start_date")
+)
+
+// expectCalendarToken mocks the lookup of calendarFeedToken owned by an active employee.
+func expectCalendarToken(mockDB *MockDB, employeeID uint64, role string, departmentID *uint64) {
+	mockDB.On("QueryRow", mock.Anything, calendarTokenQuery, hashAPIKey(calendarFeedToken)).
+		Return(NewMockRow([]interface{}{uint64(1), employeeID, role, departmentID, true, entity.StatusActive}, nil, nil)).Once()
+	mockDB.On("Exec", mock.Anything, "UPDATE calendar_feed_tokens SET last_used_at = $1 WHERE id = $2", mock.Anything, uint64(1)).
+		Return(NewMockCommandTag(1), nil).Once()
+}
+
+func TestRenderAbsenceCalendar(t *testing.T) {
+	updated := time.Date(2026, time.October, 1, 12, 30, 0, 0, time.UTC)
+	absences := []entity.Absence{
+		{Kind: entity.AbsenceVacation, ID: 4, EmployeeID: 3, FirstName: "Ivan", LastName: "Petrov", Reason: entity.VacationTypeAnnual,
+			StartDate: date(2026, time.November, 2), EndDate: date(2026, time.November, 6), UpdatedAt: updated},
+		{Kind: entity.AbsenceSickLeave, ID: 9, EmployeeID: 5, FirstName: "Anna", LastName: "Sidorova",
+			StartDate: date(2026, time.October, 12), EndDate: date(2026, time.October, 16), UpdatedAt: updated},
+	}
+	birthday := date(1992, time.February, 29)
+	hireDate := date(2020, time.March, 16)
+	employees := []feedEmployee{{ID: 3, FirstName: "Ivan", LastName: "Petrov", Birthday: &birthday, HireDate: &hireDate}}
+
+	content := string(renderAbsenceCalendar("Absences: R&D; Moscow, office", absences, employees,
+		entity.CalendarFeedParams{Birthdays: true, Anniversaries: true}, updated))
+
+	assert.True(t, strings.HasPrefix(content, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(content, "END:VCALENDAR\r\n"))
+	assert.Contains(t, content, "X-WR-CALNAME:Absences: R&D\\; Moscow\\, office\r\n")
+
+	assert.Contains(t, content, "UID:vacation-4@employee-service\r\nDTSTAMP:20261001T123000Z\r\n"+
+		"DTSTART;VALUE=DATE:20261102\r\nDTEND;VALUE=DATE:20261107\r\nSUMMARY:Ivan Petrov: annual vacation\r\n")
+	assert.Contains(t, content, "UID:sick_leave-9@employee-service")
+	assert.Contains(t, content, "SUMMARY:Anna Sidorova: sick leave\r\n")
+
+	assert.Contains(t, content, "DTSTART;VALUE=DATE:19920229\r\nDTEND;VALUE=DATE:19920301\r\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=-1\r\n")
+	assert.Contains(t, content, "DTSTART;VALUE=DATE:20210316\r\nDTEND;VALUE=DATE:20210317\r\nRRULE:FREQ=YEARLY\r\n"+
+		"SUMMARY:Ivan Petrov: work anniversary\r\n")
+
+	t.Run("long lines are folded", func(t *testing.T) {
+		name := strings.Repeat("Отдел ", 30)
+		content := string(renderAbsenceCalendar(name, nil, nil, entity.CalendarFeedParams{}, updated))
+
+		for _, line := range strings.Split(strings.TrimSuffix(content, "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), icsLineSize)
+			assert.True(t, strings.ToValidUTF8(line, "") == line, "a character is split: %q", line)
+		}
+
+		assert.Contains(t, strings.Join(unfoldICSLines(content), "\n"), "X-WR-CALNAME:"+name)
+	})
+}
+
+func TestCalendarFeedController_CreateCalendarToken(t *testing.T) {
+	user := &entity.Claims{ID: 3, Role: entity.RoleEmployee}
+	countQuery := "SELECT COUNT(*) FROM calendar_feed_tokens WHERE employee_id = $1"
+
+	t.Run("token created", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		var storedHash string
+		mockDB.On("QueryRow", mock.Anything, countQuery, uint64(3)).Return(NewMockRow([]interface{}{2}, nil, nil)).Once()
+		mockDB.On("QueryRow", mock.Anything, queryPrefix("INSERT INTO calendar_feed_tokens"), uint64(3), "Outlook",
+			mock.AnythingOfType("string"), mock.MatchedBy(func(hash string) bool {
+				storedHash = hash
+				return true
+			}), mock.AnythingOfType("time.Time"),
+		).Return(NewMockRow([]interface{}{uint64(7)}, nil, nil)).Once()
+
+		token, err := controller.CreateCalendarToken(user, entity.CalendarTokenForm{Name: " Outlook "})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(7), token.ID)
+		assert.True(t, strings.HasPrefix(token.Token, calendarTokenPrefix))
+		assert.Equal(t, token.Token[:calendarTokenPrefixSize], token.Prefix)
+		assert.Equal(t, hashAPIKey(token.Token), storedHash, "only the hash is stored")
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("too many tokens", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, countQuery, uint64(3)).Return(NewMockRow([]interface{}{maxCalendarTokens}, nil, nil)).Once()
+
+		_, err := controller.CreateCalendarToken(user, entity.CalendarTokenForm{Name: "Outlook"})
+		assert.ErrorIs(t, err, ErrInvalidCalendarTokenForm)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("name is required", func(t *testing.T) {
+		controller := NewCalendarFeedController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.CreateCalendarToken(user, entity.CalendarTokenForm{Name: " "})
+		assert.ErrorIs(t, err, ErrInvalidCalendarTokenForm)
+	})
+}
+
+func TestCalendarFeedController_DeleteCalendarToken(t *testing.T) {
+	mockDB := &MockDB{}
+	controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+	mockDB.On("Exec", mock.Anything, "DELETE FROM calendar_feed_tokens WHERE id = $1 AND employee_id = $2", uint64(7), uint64(3)).
+		Return(NewMockCommandTag(0), nil).Once()
+
+	err := controller.DeleteCalendarToken(&entity.Claims{ID: 3, Role: entity.RoleEmployee}, 7)
+	assert.ErrorIs(t, err, ErrCalendarTokenNotFound, "tokens of other employees cannot be revoked")
+	mockDB.AssertExpectations(t)
+}
+
+func TestCalendarFeedController_EmployeeFeed(t *testing.T) {
+	employeeQuery := "SELECT first_name, last_name, manager_id FROM employees WHERE id = $1 AND status <> $2"
+	absences := [][]interface{}{
+		{entity.AbsenceVacation, uint64(4), uint64(5), "Ivan", "Petrov", entity.VacationTypeAnnual,
+			date(2026, time.November, 2), date(2026, time.November, 6), time.Now(), Uint64Ptr(2)},
+		{entity.AbsenceSickLeave, uint64(9), uint64(5), "Ivan", "Petrov", "",
+			date(2026, time.October, 12), date(2026, time.October, 16), time.Now(), Uint64Ptr(2)},
+	}
+
+	t.Run("manager sees the feed of the team", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		expectCalendarToken(mockDB, 2, entity.RoleManager, nil)
+		mockDB.On("QueryRow", mock.Anything, employeeQuery, uint64(5), entity.StatusFired).
+			Return(NewMockRow([]interface{}{"Ivan", "Petrov", Uint64Ptr(2)}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, queryContains("e.id = $1"), uint64(5), entity.AbsenceVacation, entity.AbsenceSickLeave,
+			entity.VacationStatusApproved, mock.AnythingOfType("time.Time"), entity.StatusFired,
+		).Return(NewMockRows(absences, nil, AbsenceFieldDescriptions), nil).Once()
+
+		feed, err := controller.EmployeeFeed(calendarFeedToken, 5, entity.CalendarFeedParams{})
+		require.NoError(t, err)
+		assert.Contains(t, string(feed), "X-WR-CALNAME:Absences: Ivan Petrov\r\n")
+		assert.Contains(t, string(feed), "UID:vacation-4@employee-service")
+		assert.Contains(t, string(feed), "SUMMARY:Ivan Petrov: sick leave\r\n")
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("other employees are denied", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		expectCalendarToken(mockDB, 6, entity.RoleEmployee, Uint64Ptr(1))
+		mockDB.On("QueryRow", mock.Anything, employeeQuery, uint64(5), entity.StatusFired).
+			Return(NewMockRow([]interface{}{"Ivan", "Petrov", Uint64Ptr(2)}, nil, nil)).Once()
+
+		_, err := controller.EmployeeFeed(calendarFeedToken, 5, entity.CalendarFeedParams{})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("revoked token", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, calendarTokenQuery, hashAPIKey(calendarFeedToken)).
+			Return(NewMockRow(nil, pgx.ErrNoRows, nil)).Once()
+
+		_, err := controller.EmployeeFeed(calendarFeedToken, 5, entity.CalendarFeedParams{})
+		assert.ErrorIs(t, err, ErrInvalidCalendarToken)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("token of a deactivated employee", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, calendarTokenQuery, hashAPIKey(calendarFeedToken)).
+			Return(NewMockRow([]interface{}{uint64(1), uint64(5), entity.RoleEmployee, nil, false, entity.StatusActive}, nil, nil)).Once()
+
+		_, err := controller.EmployeeFeed(calendarFeedToken, 5, entity.CalendarFeedParams{})
+		assert.ErrorIs(t, err, ErrInvalidCalendarToken)
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestCalendarFeedController_DepartmentFeed(t *testing.T) {
+	departmentQuery := "SELECT name, head_id FROM departments WHERE id = $1"
+
+	t.Run("member sees the feed with birthdays", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		birthday := date(1990, time.May, 17)
+		expectCalendarToken(mockDB, 6, entity.RoleEmployee, Uint64Ptr(7))
+		mockDB.On("QueryRow", mock.Anything, departmentQuery, uint64(7)).
+			Return(NewMockRow([]interface{}{"Support", Uint64Ptr(2)}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, absencesQuery, uint64(7), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRows(nil, nil, AbsenceFieldDescriptions), nil).Once()
+		mockDB.On("Query", mock.Anything, queryContains("e.birthday, e.hire_date", "e.department_id = $1"), uint64(7), entity.StatusFired).
+			Return(NewMockRows([][]interface{}{{uint64(6), "Anna", "Sidorova", &birthday, nil, Uint64Ptr(2)}}, nil, FeedEmployeeFieldDescriptions), nil).Once()
+
+		feed, err := controller.DepartmentFeed(calendarFeedToken, 7, entity.CalendarFeedParams{Birthdays: true})
+		require.NoError(t, err)
+		assert.Contains(t, string(feed), "UID:birthday-6@employee-service")
+		assert.NotContains(t, string(feed), "anniversary")
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("sick leaves and birthdays of colleagues are hidden", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		birthday := date(1990, time.May, 17)
+		hireDate := date(2020, time.March, 16)
+		expectCalendarToken(mockDB, 6, entity.RoleEmployee, Uint64Ptr(7))
+		mockDB.On("QueryRow", mock.Anything, departmentQuery, uint64(7)).
+			Return(NewMockRow([]interface{}{"Support", Uint64Ptr(2)}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, absencesQuery, uint64(7), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRows([][]interface{}{
+				{entity.AbsenceSickLeave, uint64(9), uint64(8), "Oleg", "Smirnov", "",
+					date(2026, time.October, 12), date(2026, time.October, 16), time.Now(), Uint64Ptr(2)},
+			}, nil, AbsenceFieldDescriptions), nil).Once()
+		mockDB.On("Query", mock.Anything, queryContains("e.birthday, e.hire_date", "e.department_id = $1"), uint64(7), entity.StatusFired).
+			Return(NewMockRows([][]interface{}{{uint64(8), "Oleg", "Smirnov", &birthday, &hireDate, Uint64Ptr(2)}}, nil, FeedEmployeeFieldDescriptions), nil).Once()
+
+		feed, err := controller.DepartmentFeed(calendarFeedToken, 7, entity.CalendarFeedParams{Birthdays: true, Anniversaries: true})
+		require.NoError(t, err)
+		assert.Contains(t, string(feed), "UID:absence-9@employee-service")
+		assert.Contains(t, string(feed), "SUMMARY:Oleg Smirnov: absent\r\n")
+		assert.NotContains(t, string(feed), "sick")
+		assert.NotContains(t, string(feed), "birthday")
+		assert.Contains(t, string(feed), "UID:anniversary-8@employee-service")
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("employee of another department is denied", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		expectCalendarToken(mockDB, 6, entity.RoleEmployee, Uint64Ptr(8))
+		mockDB.On("QueryRow", mock.Anything, departmentQuery, uint64(7)).
+			Return(NewMockRow([]interface{}{"Support", Uint64Ptr(2)}, nil, nil)).Once()
+
+		_, err := controller.DepartmentFeed(calendarFeedToken, 7, entity.CalendarFeedParams{})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("department not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarFeedController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		expectCalendarToken(mockDB, 1, entity.RoleHR, nil)
+		mockDB.On("QueryRow", mock.Anything, departmentQuery, uint64(9)).Return(NewMockRow(nil, pgx.ErrNoRows, nil)).Once()
+
+		_, err := controller.DepartmentFeed(calendarFeedToken, 9, entity.CalendarFeedParams{})
+		assert.ErrorIs(t, err, ErrDepartmentNotFound)
+	})
+}
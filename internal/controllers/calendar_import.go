@@ -0,0 +1,221 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+)
+
+const (
+	// maxCalendarEventDays limits the days of one .ics event, a holiday is a few days at most.
+	maxCalendarEventDays = 31
+
+	icsDateLayout = "20060102"
+)
+
+var calendarDayKinds = []string{entity.CalendarDayHoliday, entity.CalendarDayWorking}
+
+// parseICSCalendar reads the all-day events of an iCalendar file (RFC 5545).
+// Every day of an event is a holiday, unless the event has the category
+// working, which marks a transferred working day. SUMMARY is the name of the day.
+func parseICSCalendar(content []byte) ([]entity.CalendarDay, error) {
+	var (
+		days    []entity.CalendarDay
+		event   map[string]string
+		inEvent bool
+	)
+
+	for _, line := range unfoldICSLines(string(content)) {
+		switch {
+		case strings.EqualFold(line, "BEGIN:VEVENT"):
+			event, inEvent = map[string]string{}, true
+		case strings.EqualFold(line, "END:VEVENT"):
+			if !inEvent {
+				return nil, fmt.Errorf("%w: END:VEVENT without BEGIN:VEVENT", ErrInvalidCalendar)
+			}
+
+			eventDays, err := icsEventDays(event)
+			if err != nil {
+				return nil, err
+			}
+
+			days, inEvent = append(days, eventDays...), false
+		case inEvent:
+			name, value, ok := strings.Cut(line, ":")
+			if !ok {
+				continue
+			}
+
+			// Parameters such as VALUE=DATE follow the name after a semicolon.
+			name, _, _ = strings.Cut(name, ";")
+			event[strings.ToUpper(name)] = value
+		}
+	}
+
+	return calendarDays(days)
+}
+
+// unfoldICSLines splits the content into lines, joining the continuation lines
+// that start with a space or a tab.
+func unfoldICSLines(content string) []string {
+	var lines []string
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+func icsEventDays(event map[string]string) ([]entity.CalendarDay, error) {
+	start, err := parseICSDate(event["DTSTART"])
+	if err != nil {
+		return nil, err
+	}
+
+	// DTEND of an all-day event is the day after the last one.
+	end := start
+	if value, ok := event["DTEND"]; ok && len(value) == len(icsDateLayout) {
+		if end, err = parseICSDate(value); err != nil {
+			return nil, err
+		}
+
+		end = end.AddDate(0, 0, -1)
+	}
+
+	if end.Before(start) || end.Sub(start).Hours()/24 >= maxCalendarEventDays {
+		return nil, fmt.Errorf("%w: event on %s must last 1 to %d days", ErrInvalidCalendar, start.Format(time.DateOnly), maxCalendarEventDays)
+	}
+
+	kind := entity.CalendarDayHoliday
+	for _, category := range strings.Split(event["CATEGORIES"], ",") {
+		if strings.EqualFold(strings.TrimSpace(category), entity.CalendarDayWorking) {
+			kind = entity.CalendarDayWorking
+		}
+	}
+
+	var name *string
+	if summary := unescapeICSText(event["SUMMARY"]); summary != "" {
+		name = &summary
+	}
+
+	days := make([]entity.CalendarDay, 0, int(end.Sub(start).Hours()/24)+1)
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		days = append(days, entity.CalendarDay{Date: date, Kind: kind, Name: name})
+	}
+
+	return days, nil
+}
+
+// parseICSDate reads the date of a DATE or DATE-TIME value, the time is ignored.
+func parseICSDate(value string) (time.Time, error) {
+	if len(value) < len(icsDateLayout) {
+		return time.Time{}, fmt.Errorf("%w: event without a valid DTSTART", ErrInvalidCalendar)
+	}
+
+	date, err := time.Parse(icsDateLayout, value[:len(icsDateLayout)])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid date %s", ErrInvalidCalendar, value)
+	}
+
+	return date, nil
+}
+
+func unescapeICSText(text string) string {
+	replacer := strings.NewReplacer(`\\`, `\`, `\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ")
+
+	return strings.TrimSpace(replacer.Replace(text))
+}
+
+// parseCSVCalendar reads lines of date (YYYY-MM-DD), kind and name. The kind is
+// holiday or working, holiday if empty; the name is optional. A header line
+// starting with date is skipped, the separator is a comma or a semicolon.
+func parseCSVCalendar(content []byte) ([]entity.CalendarDay, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	firstLine, _, _ := bytes.Cut(content, []byte("\n"))
+	if bytes.Contains(firstLine, []byte(";")) && !bytes.Contains(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	var days []entity.CalendarDay
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCalendar, err.Error())
+		}
+
+		value := strings.TrimSpace(strings.TrimPrefix(record[0], "\ufeff"))
+		if line == 1 && strings.EqualFold(value, "date") {
+			continue
+		}
+
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: invalid date %s", ErrInvalidCalendar, line, value)
+		}
+
+		day := entity.CalendarDay{Date: date, Kind: entity.CalendarDayHoliday}
+
+		if len(record) > 1 && strings.TrimSpace(record[1]) != "" {
+			day.Kind = strings.ToLower(strings.TrimSpace(record[1]))
+			if !slices.Contains(calendarDayKinds, day.Kind) {
+				return nil, fmt.Errorf("%w: line %d: kind must be one of %s", ErrInvalidCalendar, line, strings.Join(calendarDayKinds, ", "))
+			}
+		}
+
+		if len(record) > 2 {
+			if name := strings.TrimSpace(record[2]); name != "" {
+				day.Name = &name
+			}
+		}
+
+		days = append(days, day)
+	}
+
+	return calendarDays(days)
+}
+
+// calendarDays orders the days by date and keeps the last of the days with the
+// same date, as a day can be written only once by the import.
+func calendarDays(days []entity.CalendarDay) ([]entity.CalendarDay, error) {
+	if len(days) == 0 {
+		return nil, fmt.Errorf("%w: file has no days", ErrInvalidCalendar)
+	}
+
+	byDate := make(map[time.Time]entity.CalendarDay, len(days))
+	for _, day := range days {
+		byDate[day.Date] = day
+	}
+
+	unique := make([]entity.CalendarDay, 0, len(byDate))
+	for _, day := range byDate {
+		unique = append(unique, day)
+	}
+
+	sort.Slice(unique, func(i, j int) bool { return unique[i].Date.Before(unique[j].Date) })
+
+	return unique, nil
+}
@@ -0,0 +1,261 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var CalendarFieldDescriptions = []pgconn.FieldDescription{
+	{Name: "id", DataTypeOID: 20},           // int8 (uint64)
+	{Name: "name", DataTypeOID: 25},         // text (string)
+	{Name: "description", DataTypeOID: 25},  // text (string, nullable)
+	{Name: "created_at", DataTypeOID: 1114}, // timestamp
+	{Name: "updated_at", DataTypeOID: 1114}, // timestamp
+}
+
+const calendarExistsQuery = "SELECT EXISTS (SELECT 1 FROM calendars WHERE id = $1)"
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseICSCalendar(t *testing.T) {
+	content := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20260101",
+		"DTEND;VALUE=DATE:20260103",
+		"SUMMARY:Новогодние\\, каникулы",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20260110",
+		"SUMMARY:Рабочая суббота по",
+		"  переносу",
+		"CATEGORIES:Transfer,WORKING",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20260223T000000Z",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	days, err := parseICSCalendar([]byte(content))
+	require.NoError(t, err)
+	require.Len(t, days, 4)
+
+	assert.Equal(t, date(2026, time.January, 1), days[0].Date)
+	assert.Equal(t, date(2026, time.January, 2), days[1].Date)
+	assert.Equal(t, entity.CalendarDayHoliday, days[1].Kind)
+	assert.Equal(t, "Новогодние, каникулы", *days[1].Name)
+
+	assert.Equal(t, date(2026, time.January, 10), days[2].Date)
+	assert.Equal(t, entity.CalendarDayWorking, days[2].Kind)
+	assert.Equal(t, "Рабочая суббота по переносу", *days[2].Name)
+
+	assert.Equal(t, date(2026, time.February, 23), days[3].Date)
+	assert.Nil(t, days[3].Name)
+
+	invalid := map[string]string{
+		"event without start": "BEGIN:VEVENT\nSUMMARY:Праздник\nEND:VEVENT",
+		"event too long":      "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260101\nDTEND;VALUE=DATE:20260301\nEND:VEVENT",
+		"no events":           "BEGIN:VCALENDAR\nEND:VCALENDAR",
+	}
+
+	for name, content := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseICSCalendar([]byte(content))
+			assert.ErrorIs(t, err, ErrInvalidCalendar)
+		})
+	}
+}
+
+func TestParseCSVCalendar(t *testing.T) {
+	t.Run("comma separated with header", func(t *testing.T) {
+		content := "\ufeffdate,kind,name\n2026-05-01,holiday,Праздник весны и труда\n2026-05-09,,День Победы\n2026-11-01,working\n2026-05-01,holiday,Первое мая\n"
+
+		days, err := parseCSVCalendar([]byte(content))
+		require.NoError(t, err)
+		require.Len(t, days, 3)
+
+		assert.Equal(t, date(2026, time.May, 1), days[0].Date)
+		assert.Equal(t, "Первое мая", *days[0].Name)
+		assert.Equal(t, entity.CalendarDayHoliday, days[1].Kind)
+		assert.Equal(t, entity.CalendarDayWorking, days[2].Kind)
+		assert.Nil(t, days[2].Name)
+	})
+
+	t.Run("semicolon separated", func(t *testing.T) {
+		days, err := parseCSVCalendar([]byte("2026-06-12;holiday;День России\n"))
+		require.NoError(t, err)
+		require.Len(t, days, 1)
+		assert.Equal(t, "День России", *days[0].Name)
+	})
+
+	invalid := map[string]string{
+		"invalid date": "12.06.2026,holiday\n",
+		"unknown kind": "2026-06-12,weekend\n",
+		"no days":      "date,kind,name\n",
+	}
+
+	for name, content := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseCSVCalendar([]byte(content))
+			assert.ErrorIs(t, err, ErrInvalidCalendar)
+		})
+	}
+}
+
+func TestCalendarController_CreateCalendar(t *testing.T) {
+	insertQuery := queryContains("INSERT INTO calendars")
+
+	t.Run("calendar created", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		now := time.Now()
+		mockDB.On("Query", mock.Anything, insertQuery, "Москва", (*string)(nil), mock.Anything).
+			Return(NewMockRows([][]interface{}{{uint64(1), "Москва", nil, now, now}}, nil, CalendarFieldDescriptions), nil).Once()
+
+		calendar, err := controller.CreateCalendar(entity.CalendarForm{Name: " Москва "})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), calendar.ID)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("name already exists", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		uniqueErr := &pgconn.PgError{Code: pgUniqueViolation, ConstraintName: calendarNameConstraint}
+		mockDB.On("Query", mock.Anything, insertQuery, "Москва", mock.Anything, mock.Anything).
+			Return(NewMockRows(nil, nil, CalendarFieldDescriptions), uniqueErr).Once()
+
+		_, err := controller.CreateCalendar(entity.CalendarForm{Name: "Москва"})
+		assert.ErrorIs(t, err, ErrCalendarConflict)
+	})
+
+	t.Run("name is required", func(t *testing.T) {
+		controller := NewCalendarController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.CreateCalendar(entity.CalendarForm{Name: " "})
+		assert.ErrorIs(t, err, ErrInvalidCalendar)
+	})
+}
+
+func TestCalendarController_ImportCalendarDays(t *testing.T) {
+	content := []byte("2026-01-01,holiday,Новый год\n2026-01-10,working\n")
+	upsertQuery := queryContains("INSERT INTO calendar_days", "ON CONFLICT (calendar_id, date) DO UPDATE")
+
+	t.Run("days imported", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, calendarExistsQuery, uint64(1)).
+			Return(NewMockRow([]interface{}{true}, nil, nil)).Once()
+		mockDB.On("Exec", mock.Anything, upsertQuery, uint64(1),
+			[]time.Time{date(2026, time.January, 1), date(2026, time.January, 10)},
+			[]string{entity.CalendarDayHoliday, entity.CalendarDayWorking},
+			[]*string{StringPtr("Новый год"), nil},
+		).Return(NewMockCommandTag(2), nil).Once()
+		mockDB.On("Exec", mock.Anything, "UPDATE calendars SET updated_at = $1 WHERE id = $2", mock.Anything, uint64(1)).
+			Return(NewMockCommandTag(1), nil).Once()
+
+		result, err := controller.ImportCalendarDays(1, entity.CalendarFormatCSV, content)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Imported)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("calendar not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, calendarExistsQuery, uint64(9)).
+			Return(NewMockRow([]interface{}{false}, nil, nil)).Once()
+
+		_, err := controller.ImportCalendarDays(9, entity.CalendarFormatCSV, content)
+		assert.ErrorIs(t, err, ErrCalendarNotFound)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("invalid file is rejected before the database", func(t *testing.T) {
+		controller := NewCalendarController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.ImportCalendarDays(1, "xlsx", content)
+		assert.ErrorIs(t, err, ErrInvalidCalendar)
+
+		_, err = controller.ImportCalendarDays(1, entity.CalendarFormatICS, make([]byte, MaxCalendarImportSize+1))
+		assert.ErrorIs(t, err, ErrInvalidCalendar)
+	})
+}
+
+func TestCalendarController_CountWorkingDays(t *testing.T) {
+	from, to := date(2026, time.May, 1), date(2026, time.May, 14)
+	countQuery := queryContains("working_days(calendar_id, $2, $3)", "employee_calendar(id)")
+
+	t.Run("working days by the calendar of the employee", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, countQuery, uint64(3), from, to, entity.CalendarDayHoliday).
+			Return(NewMockRow([]interface{}{Uint64Ptr(1), uint64(8), uint64(2)}, nil, nil)).Once()
+
+		days, err := controller.CountWorkingDays(from.Add(15*time.Hour), to, 3)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(14), days.CalendarDays)
+		assert.Equal(t, uint64(8), days.WorkingDays)
+		assert.Equal(t, uint64(2), days.Holidays)
+		assert.Equal(t, uint64(1), *days.CalendarID)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewCalendarController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, countQuery, uint64(9), from, to, mock.Anything).
+			Return(NewMockRow(nil, pgx.ErrNoRows, nil)).Once()
+
+		_, err := controller.CountWorkingDays(from, to, 9)
+		assert.ErrorIs(t, err, ErrEmployeeNotFound)
+	})
+
+	invalid := []struct {
+		name     string
+		from, to time.Time
+	}{
+		{name: "missing dates"},
+		{name: "to before from", from: to, to: from},
+		{name: "period too long", from: from, to: from.AddDate(11, 0, 0)},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := NewCalendarController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+			_, err := controller.CountWorkingDays(tt.from, tt.to, 3)
+			assert.ErrorIs(t, err, ErrInvalidCalendarRange)
+		})
+	}
+}
+
+func TestCalendarController_DeleteCalendarDay(t *testing.T) {
+	mockDB := &MockDB{}
+	controller := NewCalendarController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+	mockDB.On("Exec", mock.Anything, "DELETE FROM calendar_days WHERE calendar_id = $1 AND date = $2", uint64(1), date(2026, time.May, 1)).
+		Return(NewMockCommandTag(0), nil).Once()
+
+	err := controller.DeleteCalendarDay(1, date(2026, time.May, 1).Add(12*time.Hour))
+	assert.ErrorIs(t, err, ErrCalendarDayNotFound)
+	mockDB.AssertExpectations(t)
+}
@@ -3,13 +3,32 @@ package controllers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/adamanr/employes_service/internal/entity"
 	"github.com/jackc/pgx/v5"
 )
 
+var (
+	ErrDepartmentNotFound = errors.New("department not found")
+	ErrInvalidDepartment  = errors.New("invalid department")
+)
+
+// departmentPatchFields are the fields of a department that can be patched. The
+// value tells whether the field may be cleared with null.
+var departmentPatchFields = map[string]bool{
+	"name":                false,
+	"description":         true,
+	"parent_id":           true,
+	"head_id":             true,
+	"calendar_id":         true,
+	"min_on_duty":         true,
+	"min_on_duty_percent": true,
+}
+
 type DepartmentController struct {
 	deps *Dependens
 }
@@ -21,7 +40,7 @@ func NewDepartmentController(deps *Dependens) *DepartmentController {
 }
 
 func (c *DepartmentController) GetDepartments() ([]entity.Department, error) {
-	query := `SELECT id, name, description, parent_id, head_id, created_at, updated_at FROM departments`
+	query := `SELECT id, name, description, parent_id, head_id, calendar_id, min_on_duty, min_on_duty_percent, created_at, updated_at, version FROM departments`
 
 	rows, err := c.deps.DB.Query(context.Background(), query)
 	if err != nil {
@@ -40,7 +59,7 @@ func (c *DepartmentController) GetDepartments() ([]entity.Department, error) {
 }
 
 func (c *DepartmentController) GetDepartmentByID(id uint64) (*entity.Department, error) {
-	query := `SELECT id, name, description, parent_id, head_id, created_at, updated_at FROM departments WHERE id = $1`
+	query := `SELECT id, name, description, parent_id, head_id, calendar_id, min_on_duty, min_on_duty_percent, created_at, updated_at, version FROM departments WHERE id = $1`
 
 	rows, err := c.deps.DB.Query(context.Background(), query, id)
 	if err != nil {
@@ -53,7 +72,7 @@ func (c *DepartmentController) GetDepartmentByID(id uint64) (*entity.Department,
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			c.deps.Logger.Error("Department not found", slog.Any("id", id))
-			return nil, errors.New("department not found")
+			return nil, ErrDepartmentNotFound
 		}
 
 		c.deps.Logger.Error("Error collecting row", slog.String("error", err.Error()))
@@ -66,15 +85,21 @@ func (c *DepartmentController) GetDepartmentByID(id uint64) (*entity.Department,
 func (c *DepartmentController) CreateDepartment(dept entity.Department) (*entity.Department, error) {
 	if dept.Name == "" {
 		c.deps.Logger.Warn("Name is required", slog.String("name", dept.Name))
-		return nil, errors.New("name is required")
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidDepartment)
+	}
+
+	if err := validateMinOnDuty(dept.MinOnDutyPercent); err != nil {
+		c.deps.Logger.Warn("Invalid department", slog.String("error", err.Error()))
+		return nil, err
 	}
 
 	now := time.Now()
-	query := `INSERT INTO departments (name, description, parent_id, head_id, created_at, updated_at)
-              VALUES ($1, $2, $3, $4, $5, $6)
-              RETURNING id`
+	query := `INSERT INTO departments (name, description, parent_id, head_id, calendar_id, min_on_duty, min_on_duty_percent, created_at, updated_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+              RETURNING id, version`
 
-	if err := c.deps.DB.QueryRow(context.Background(), query, dept.Name, dept.Description, dept.ParentID, dept.HeadID, now, now).Scan(&dept.ID); err != nil {
+	if err := c.deps.DB.QueryRow(context.Background(), query, dept.Name, dept.Description, dept.ParentID, dept.HeadID, dept.CalendarID,
+		dept.MinOnDuty, dept.MinOnDutyPercent, now, now).Scan(&dept.ID, &dept.Version); err != nil {
 		c.deps.Logger.Error("Error inserting department", slog.String("error", err.Error()))
 		return nil, err
 	}
@@ -85,20 +110,31 @@ func (c *DepartmentController) CreateDepartment(dept entity.Department) (*entity
 	return &dept, nil
 }
 
-func (c *DepartmentController) UpdateDepartment(dept entity.Department, id uint64) (*entity.Department, error) {
+// UpdateDepartment replaces the department if it is still at the expected version.
+func (c *DepartmentController) UpdateDepartment(dept entity.Department, id, expectedVersion uint64) (*entity.Department, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	if err := validateMinOnDuty(dept.MinOnDutyPercent); err != nil {
+		c.deps.Logger.Warn("Invalid department", slog.String("error", err.Error()))
+		return nil, err
+	}
+
 	dept.UpdatedAt = time.Now()
 
 	query := `UPDATE departments 
-              SET name = $1, description = $2, parent_id = $3, head_id = $4, updated_at = $5 
-              WHERE id = $6 
-              RETURNING id, name, description, parent_id, head_id, created_at, updated_at`
-
-	if err := c.deps.DB.QueryRow(context.Background(), query, dept.Name, dept.Description, dept.ParentID, dept.HeadID, dept.UpdatedAt, id).Scan(
-		&dept.ID, &dept.Name, &dept.Description, &dept.ParentID, &dept.HeadID, &dept.CreatedAt, &dept.UpdatedAt,
+              SET name = $1, description = $2, parent_id = $3, head_id = $4, calendar_id = $5, min_on_duty = $6, min_on_duty_percent = $7,
+                  updated_at = $8, version = version + 1 
+              WHERE id = $9 AND version = $10 
+              RETURNING id, name, description, parent_id, head_id, calendar_id, min_on_duty, min_on_duty_percent, created_at, updated_at, version`
+
+	if err := c.deps.DB.QueryRow(context.Background(), query, dept.Name, dept.Description, dept.ParentID, dept.HeadID, dept.CalendarID,
+		dept.MinOnDuty, dept.MinOnDutyPercent, dept.UpdatedAt, id, expectedVersion).Scan(
+		&dept.ID, &dept.Name, &dept.Description, &dept.ParentID, &dept.HeadID, &dept.CalendarID, &dept.MinOnDuty, &dept.MinOnDutyPercent, &dept.CreatedAt, &dept.UpdatedAt, &dept.Version,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			c.deps.Logger.Error("Department not found", slog.Any("id", id))
-			return nil, errors.New("department not found")
+			return nil, versionConflict(c.deps, "departments", id, expectedVersion, ErrDepartmentNotFound)
 		}
 
 		c.deps.Logger.Error("Error updating department", slog.String("error", err.Error()))
@@ -108,17 +144,101 @@ func (c *DepartmentController) UpdateDepartment(dept entity.Department, id uint6
 	return &dept, nil
 }
 
-func (c *DepartmentController) DeleteDepartment(id uint64) error {
-	result, err := c.deps.DB.Exec(context.Background(), "DELETE FROM departments WHERE id = $1", id)
+// DeleteDepartment deletes the department if it is still at the expected
+// version. Deleting a missing department is not an error.
+func (c *DepartmentController) DeleteDepartment(id, expectedVersion uint64) error {
+	if err := requireVersion(expectedVersion); err != nil {
+		return err
+	}
+
+	result, err := c.deps.DB.Exec(context.Background(), "DELETE FROM departments WHERE id = $1 AND version = $2", id, expectedVersion)
 	if err != nil {
 		c.deps.Logger.Error("Error deleting department", slog.String("error", err.Error()))
 		return nil
 	}
 
 	if result.RowsAffected() == 0 {
+		if err = versionConflict(c.deps, "departments", id, expectedVersion, nil); errors.Is(err, ErrVersionMismatch) {
+			return err
+		}
+
 		c.deps.Logger.Error("Department not found", slog.Any("id", id))
 		return nil
 	}
 
 	return nil
 }
+
+// PatchDepartment changes only the fields of the patch if the department is
+// still at the expected version.
+func (c *DepartmentController) PatchDepartment(id uint64, patch entity.DepartmentPatch, expectedVersion uint64) (*entity.Department, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	fields, err := patchFields(patch.Fields, departmentPatchFields)
+	if err != nil {
+		c.deps.Logger.Warn("Invalid department patch", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	dept := patch.Department
+	if slices.Contains(fields, "name") && dept.Name == "" {
+		c.deps.Logger.Warn("Invalid department patch", slog.String("error", "name is empty"))
+		return nil, fmt.Errorf("%w: name cannot be empty", ErrInvalidPatch)
+	}
+
+	if slices.Contains(fields, "min_on_duty_percent") {
+		if err = validateMinOnDuty(dept.MinOnDutyPercent); err != nil {
+			c.deps.Logger.Warn("Invalid department patch", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err.Error())
+		}
+	}
+
+	set, args := patchSet(fields, func(field string) any {
+		switch field {
+		case "name":
+			return dept.Name
+		case "description":
+			return dept.Description
+		case "parent_id":
+			return dept.ParentID
+		case "head_id":
+			return dept.HeadID
+		case "calendar_id":
+			return dept.CalendarID
+		case "min_on_duty":
+			return dept.MinOnDuty
+		case "min_on_duty_percent":
+			return dept.MinOnDutyPercent
+		}
+
+		return nil
+	})
+	args = append(args, time.Now(), id, expectedVersion)
+
+	query := fmt.Sprintf(`UPDATE departments SET %s, updated_at = $%d, version = version + 1 WHERE id = $%d AND version = $%d
+              RETURNING id, name, description, parent_id, head_id, calendar_id, min_on_duty, min_on_duty_percent, created_at, updated_at, version`, set, len(args)-2, len(args)-1, len(args))
+
+	if err = c.deps.DB.QueryRow(context.Background(), query, args...).Scan(
+		&dept.ID, &dept.Name, &dept.Description, &dept.ParentID, &dept.HeadID, &dept.CalendarID, &dept.MinOnDuty, &dept.MinOnDutyPercent, &dept.CreatedAt, &dept.UpdatedAt, &dept.Version,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, versionConflict(c.deps, "departments", id, expectedVersion, ErrDepartmentNotFound)
+		}
+
+		c.deps.Logger.Error("Error updating department", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &dept, nil
+}
+
+// validateMinOnDuty checks the share of the staff that must stay at work.
+func validateMinOnDuty(percent *uint64) error {
+	if percent != nil && *percent > 100 {
+		return fmt.Errorf("%w: min_on_duty_percent must be from 0 to 100", ErrInvalidDepartment)
+	}
+
+	return nil
+}
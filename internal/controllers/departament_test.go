@@ -13,13 +13,17 @@ import (
 )
 
 var DepartmentFieldDescriptions = []pgconn.FieldDescription{
-	{Name: "id", DataTypeOID: 20},           // int8 (uint64)
-	{Name: "name", DataTypeOID: 25},         // text (string)
-	{Name: "description", DataTypeOID: 25},  // text (string)
-	{Name: "parent_id", DataTypeOID: 20},    // int8 (uint64, nullable)
-	{Name: "head_id", DataTypeOID: 20},      // int8 (uint64, nullable)
-	{Name: "created_at", DataTypeOID: 1114}, // timestamp
-	{Name: "updated_at", DataTypeOID: 1114}, // timestamp
+	{Name: "id", DataTypeOID: 20},                  // int8 (uint64)
+	{Name: "name", DataTypeOID: 25},                // text (string)
+	{Name: "description", DataTypeOID: 25},         // text (string)
+	{Name: "parent_id", DataTypeOID: 20},           // int8 (uint64, nullable)
+	{Name: "head_id", DataTypeOID: 20},             // int8 (uint64, nullable)
+	{Name: "calendar_id", DataTypeOID: 20},         // int8 (uint64, nullable)
+	{Name: "min_on_duty", DataTypeOID: 23},         // int4 (uint64, nullable)
+	{Name: "min_on_duty_percent", DataTypeOID: 23}, // int4 (uint64, nullable)
+	{Name: "created_at", DataTypeOID: 1114},        // timestamp
+	{Name: "updated_at", DataTypeOID: 1114},        // timestamp
+	{Name: "version", DataTypeOID: 20},             // int8 (uint64)
 }
 
 func TestDepartmentController_GetDepartments(t *testing.T) {
@@ -34,8 +38,8 @@ func TestDepartmentController_GetDepartments(t *testing.T) {
 			setupMocks: func(mockDB *MockDB) {
 				now := time.Now()
 				rows := NewMockRows([][]interface{}{
-					{uint64(1), "Engineering", "Software Engineering Department", (*uint64)(nil), uint64(1), now, now},
-					{uint64(2), "HR", "Human Resources Department", (*uint64)(nil), uint64(2), now, now},
+					{uint64(1), "Engineering", "Software Engineering Department", (*uint64)(nil), uint64(1), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), now, now},
+					{uint64(2), "HR", "Human Resources Department", (*uint64)(nil), uint64(2), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), now, now},
 				}, nil, DepartmentFieldDescriptions)
 
 				mockDB.On("Query", mock.Anything, mock.AnythingOfType("string")).Return(rows, nil)
@@ -106,7 +110,7 @@ func TestDepartmentController_GetDepartmentByID(t *testing.T) {
 			setupMocks: func(mockDB *MockDB) {
 				now := time.Now()
 				rows := NewMockRows([][]interface{}{
-					{uint64(1), "Engineering", "Software Engineering Department", uint64(0), uint64(1), now, now},
+					{uint64(1), "Engineering", "Software Engineering Department", uint64(0), uint64(1), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), now, now},
 				}, nil, DepartmentFieldDescriptions)
 				mockDB.On("Query", mock.Anything, mock.AnythingOfType("string"), uint64(1)).Return(rows, nil)
 			},
@@ -189,7 +193,7 @@ func TestDepartmentController_CreateDepartment(t *testing.T) {
 				insertRow := NewMockRow([]interface{}{uint64(1)}, nil, DepartmentFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "INSERT"
-				}), "Engineering", "Software Engineering Department", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(insertRow)
+				}), "Engineering", "Software Engineering Department", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(insertRow)
 			},
 			expectError: false,
 		},
@@ -205,7 +209,7 @@ func TestDepartmentController_CreateDepartment(t *testing.T) {
 				insertRow := NewMockRow([]interface{}{uint64(2)}, nil, DepartmentFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "INSERT"
-				}), "HR", "", (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(insertRow)
+				}), "HR", "", (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(insertRow)
 			},
 			expectError: false,
 		},
@@ -229,7 +233,7 @@ func TestDepartmentController_CreateDepartment(t *testing.T) {
 				insertRow := NewMockRow(nil, errors.New("insert error"), DepartmentFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "INSERT"
-				}), "Engineering", "Software Engineering Department", (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(insertRow)
+				}), "Engineering", "Software Engineering Department", (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(insertRow)
 			},
 			expectError: true,
 		},
@@ -288,11 +292,11 @@ func TestDepartmentController_UpdateDepartment(t *testing.T) {
 				now := time.Now()
 				updateRow := NewMockRow([]interface{}{
 					uint64(1), "Updated Engineering", "Updated Software Engineering Department",
-					uint64(1), uint64(2), now, now,
+					uint64(1), uint64(2), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), now, now, uint64(2),
 				}, nil, DepartmentFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), "Updated Engineering", "Updated Software Engineering Department", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), uint64(1)).Return(updateRow)
+				}), "Updated Engineering", "Updated Software Engineering Department", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), uint64(1), uint64(1)).Return(updateRow)
 			},
 			expectError: false,
 		},
@@ -308,11 +312,11 @@ func TestDepartmentController_UpdateDepartment(t *testing.T) {
 			setupMocks: func(mockDB *MockDB) {
 				now := time.Now()
 				updateRow := NewMockRow([]interface{}{
-					uint64(2), "Updated HR", "", nil, nil, now, now,
+					uint64(2), "Updated HR", "", nil, nil, (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), now, now, uint64(2),
 				}, nil, DepartmentFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), "Updated HR", "", (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(2)).Return(updateRow)
+				}), "Updated HR", "", (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(2), uint64(1)).Return(updateRow)
 			},
 			expectError: false,
 		},
@@ -327,11 +331,31 @@ func TestDepartmentController_UpdateDepartment(t *testing.T) {
 				updateRow := NewMockRow(nil, pgx.ErrNoRows, DepartmentFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), "Updated Engineering", "Updated Software Engineering Department", (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(999)).Return(updateRow)
+				}), "Updated Engineering", "Updated Software Engineering Department", (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(999), uint64(1)).Return(updateRow)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM departments WHERE id = $1", uint64(999)).
+					Return(NewMockRow(nil, pgx.ErrNoRows, nil))
 			},
 			expectError:   true,
 			errorContains: "department not found",
 		},
+		{
+			name: "stale version",
+			department: entity.Department{
+				Name:        "Updated Engineering",
+				Description: "Updated Software Engineering Department",
+			},
+			departmentID: 1,
+			setupMocks: func(mockDB *MockDB) {
+				updateRow := NewMockRow(nil, pgx.ErrNoRows, DepartmentFieldDescriptions)
+				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
+					return query[:6] == "UPDATE"
+				}), "Updated Engineering", "Updated Software Engineering Department", (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(1), uint64(1)).Return(updateRow)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM departments WHERE id = $1", uint64(1)).
+					Return(NewMockRow([]interface{}{uint64(3)}, nil, nil))
+			},
+			expectError:   true,
+			errorContains: ErrVersionMismatch.Error(),
+		},
 		{
 			name: "database update error",
 			department: entity.Department{
@@ -343,7 +367,7 @@ func TestDepartmentController_UpdateDepartment(t *testing.T) {
 				updateRow := NewMockRow(nil, errors.New("update error"), DepartmentFieldDescriptions)
 				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), "Updated Engineering", "Updated Software Engineering Department", (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(1)).Return(updateRow)
+				}), "Updated Engineering", "Updated Software Engineering Department", (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(1), uint64(1)).Return(updateRow)
 			},
 			expectError: true,
 		},
@@ -357,7 +381,7 @@ func TestDepartmentController_UpdateDepartment(t *testing.T) {
 			tt.setupMocks(mockDB)
 
 			controller := NewDepartmentController(deps)
-			result, err := controller.UpdateDepartment(tt.department, tt.departmentID)
+			result, err := controller.UpdateDepartment(tt.department, tt.departmentID, 1)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -371,6 +395,7 @@ func TestDepartmentController_UpdateDepartment(t *testing.T) {
 				assert.Equal(t, tt.departmentID, result.ID)
 				assert.Equal(t, tt.department.Name, result.Name)
 				assert.Equal(t, tt.department.Description, result.Description)
+				assert.Equal(t, uint64(2), result.Version)
 			}
 
 			mockDB.AssertExpectations(t)
@@ -378,6 +403,89 @@ func TestDepartmentController_UpdateDepartment(t *testing.T) {
 	}
 }
 
+func TestDepartmentController_PatchDepartment(t *testing.T) {
+	t.Run("changes only the given fields", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewDepartmentController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		now := time.Now()
+		mockDB.On("QueryRow", mock.Anything,
+			queryPrefix("UPDATE departments SET head_id = $1, updated_at = $2, version = version + 1 WHERE id = $3 AND version = $4"),
+			(*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(1), uint64(4),
+		).Return(NewMockRow([]interface{}{uint64(1), "Engineering", "Software", nil, nil, (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), now, now, uint64(5)}, nil, DepartmentFieldDescriptions))
+
+		dept, err := controller.PatchDepartment(1, entity.DepartmentPatch{Fields: []string{"head_id"}}, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, "Engineering", dept.Name)
+		assert.Equal(t, uint64(5), dept.Version)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewDepartmentController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, queryPrefix("UPDATE departments"), "Sales", mock.Anything, uint64(9), uint64(1)).
+			Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+		mockDB.On("QueryRow", mock.Anything, "SELECT version FROM departments WHERE id = $1", uint64(9)).
+			Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+
+		_, err := controller.PatchDepartment(9, entity.DepartmentPatch{
+			Fields:     []string{"name"},
+			Department: entity.Department{Name: "Sales"},
+		}, 1)
+		assert.ErrorIs(t, err, ErrDepartmentNotFound)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewDepartmentController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, queryPrefix("UPDATE departments"), "Sales", mock.Anything, uint64(9), uint64(1)).
+			Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+		mockDB.On("QueryRow", mock.Anything, "SELECT version FROM departments WHERE id = $1", uint64(9)).
+			Return(NewMockRow([]interface{}{uint64(2)}, nil, nil))
+
+		_, err := controller.PatchDepartment(9, entity.DepartmentPatch{
+			Fields:     []string{"name"},
+			Department: entity.Department{Name: "Sales"},
+		}, 1)
+		assert.ErrorIs(t, err, ErrVersionMismatch)
+	})
+
+	t.Run("version required", func(t *testing.T) {
+		controller := NewDepartmentController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.PatchDepartment(1, entity.DepartmentPatch{Fields: []string{"head_id"}}, 0)
+		assert.ErrorIs(t, err, ErrVersionRequired)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		controller := NewDepartmentController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.PatchDepartment(1, entity.DepartmentPatch{Fields: []string{"name"}}, 1)
+		assert.ErrorIs(t, err, ErrInvalidPatch)
+	})
+
+	t.Run("read-only field", func(t *testing.T) {
+		controller := NewDepartmentController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.PatchDepartment(1, entity.DepartmentPatch{Fields: []string{"id"}}, 1)
+		assert.ErrorIs(t, err, ErrInvalidPatch)
+	})
+
+	t.Run("minimum share over 100 percent", func(t *testing.T) {
+		controller := NewDepartmentController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		patch := entity.DepartmentPatch{Fields: []string{"min_on_duty_percent"}, Department: entity.Department{MinOnDutyPercent: Uint64Ptr(120)}}
+		_, err := controller.PatchDepartment(1, patch, 1)
+		assert.ErrorIs(t, err, ErrInvalidPatch)
+
+		_, err = controller.CreateDepartment(entity.Department{Name: "Support", MinOnDutyPercent: Uint64Ptr(120)})
+		assert.ErrorIs(t, err, ErrInvalidDepartment)
+	})
+}
+
 func TestDepartmentController_DeleteDepartment(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -392,7 +500,7 @@ func TestDepartmentController_DeleteDepartment(t *testing.T) {
 			setupMocks: func(mockDB *MockDB) {
 				commandTag := NewMockCommandTag(1)
 
-				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1", uint64(1)).Return(commandTag, nil)
+				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1 AND version = $2", uint64(1), uint64(1)).Return(commandTag, nil)
 			},
 			expectError: false,
 		},
@@ -401,7 +509,9 @@ func TestDepartmentController_DeleteDepartment(t *testing.T) {
 			departmentID: 999,
 			setupMocks: func(mockDB *MockDB) {
 				commandTag := NewMockCommandTag(0)
-				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1", uint64(999)).Return(commandTag, nil)
+				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1 AND version = $2", uint64(999), uint64(1)).Return(commandTag, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM departments WHERE id = $1", uint64(999)).
+					Return(NewMockRow(nil, pgx.ErrNoRows, nil))
 			},
 			expectError: false,
 		},
@@ -410,10 +520,22 @@ func TestDepartmentController_DeleteDepartment(t *testing.T) {
 			departmentID: 1,
 			setupMocks: func(mockDB *MockDB) {
 				commandTag := NewMockCommandTag(0)
-				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1", uint64(1)).Return(commandTag, errors.New("db error"))
+				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1 AND version = $2", uint64(1), uint64(1)).Return(commandTag, errors.New("db error"))
 			},
 			expectError: false,
 		},
+		{
+			name:         "stale version",
+			departmentID: 1,
+			setupMocks: func(mockDB *MockDB) {
+				commandTag := NewMockCommandTag(0)
+				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1 AND version = $2", uint64(1), uint64(1)).Return(commandTag, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM departments WHERE id = $1", uint64(1)).
+					Return(NewMockRow([]interface{}{uint64(2)}, nil, nil))
+			},
+			expectError:   true,
+			errorContains: ErrVersionMismatch.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -424,7 +546,7 @@ func TestDepartmentController_DeleteDepartment(t *testing.T) {
 			tt.setupMocks(mockDB)
 
 			controller := NewDepartmentController(deps)
-			err := controller.DeleteDepartment(tt.departmentID)
+			err := controller.DeleteDepartment(tt.departmentID, 1)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -453,7 +575,7 @@ func TestDepartmentController_DeleteDepartment_ExpectedBehavior(t *testing.T) {
 			departmentID: 1,
 			setupMocks: func(mockDB *MockDB) {
 				commandTag := NewMockCommandTag(1)
-				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1", uint64(1)).Return(commandTag, nil)
+				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1 AND version = $2", uint64(1), uint64(1)).Return(commandTag, nil)
 			},
 			expectError: false,
 		},
@@ -462,7 +584,9 @@ func TestDepartmentController_DeleteDepartment_ExpectedBehavior(t *testing.T) {
 			departmentID: 999,
 			setupMocks: func(mockDB *MockDB) {
 				commandTag := NewMockCommandTag(0)
-				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1", uint64(999)).Return(commandTag, nil)
+				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1 AND version = $2", uint64(999), uint64(1)).Return(commandTag, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM departments WHERE id = $1", uint64(999)).
+					Return(NewMockRow(nil, pgx.ErrNoRows, nil))
 			},
 			expectError: false,
 		},
@@ -471,7 +595,7 @@ func TestDepartmentController_DeleteDepartment_ExpectedBehavior(t *testing.T) {
 			departmentID: 1,
 			setupMocks: func(mockDB *MockDB) {
 				commandTag := NewMockCommandTag(0)
-				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1", uint64(1)).Return(commandTag, errors.New("db error"))
+				mockDB.On("Exec", mock.Anything, "DELETE FROM departments WHERE id = $1 AND version = $2", uint64(1), uint64(1)).Return(commandTag, errors.New("db error"))
 			},
 			expectError: false,
 		},
@@ -485,7 +609,7 @@ func TestDepartmentController_DeleteDepartment_ExpectedBehavior(t *testing.T) {
 			tt.setupMocks(mockDB)
 
 			controller := NewDepartmentController(deps)
-			err := controller.DeleteDepartment(tt.departmentID)
+			err := controller.DeleteDepartment(tt.departmentID, 1)
 
 			assert.NoError(t, err)
 
@@ -512,7 +636,7 @@ func TestDepartmentController_EdgeCases(t *testing.T) {
 
 		now := time.Now()
 		rows := NewMockRows([][]interface{}{
-			{uint64(1), "Engineering", "Software Engineering Department", uint64(0), uint64(1), now, now},
+			{uint64(1), "Engineering", "Software Engineering Department", uint64(0), uint64(1), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), now, now},
 		}, nil, DepartmentFieldDescriptions)
 		mockDB.On("Query", mock.Anything, mock.AnythingOfType("string")).Return(rows, nil)
 
@@ -543,7 +667,7 @@ func TestDepartmentController_EdgeCases(t *testing.T) {
 		insertRow := NewMockRow([]interface{}{uint64(1)}, nil, DepartmentFieldDescriptions)
 		mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 			return query[:6] == "INSERT"
-		}), longName, "Test Department with very long name", (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(insertRow)
+		}), longName, "Test Department with very long name", (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(insertRow)
 
 		controller := NewDepartmentController(deps)
 		result, err := controller.CreateDepartment(department)
@@ -566,14 +690,14 @@ func TestDepartmentController_EdgeCases(t *testing.T) {
 
 		now := time.Now()
 		updateRow := NewMockRow([]interface{}{
-			uint64(1), "Engineering", "Software Engineering Department", nil, nil, now, now,
+			uint64(1), "Engineering", "Software Engineering Department", nil, nil, (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), now, now, uint64(2),
 		}, nil, DepartmentFieldDescriptions)
 		mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
 			return query[:6] == "UPDATE"
-		}), "Engineering", "Software Engineering Department", (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(1)).Return(updateRow)
+		}), "Engineering", "Software Engineering Department", (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil), mock.AnythingOfType("time.Time"), uint64(1), uint64(1)).Return(updateRow)
 
 		controller := NewDepartmentController(deps)
-		result, err := controller.UpdateDepartment(department, 1)
+		result, err := controller.UpdateDepartment(department, 1, 1)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -12,9 +12,17 @@ import (
 )
 
 type Controllers struct {
-	AuthController       *AuthController
-	DepartmentController *DepartmentController
-	EmployeeController   *EmployeeController
+	AuthController         *AuthController
+	APIKeyController       *APIKeyController
+	AuditController        *AuditController
+	CalendarController     *CalendarController
+	CalendarFeedController *CalendarFeedController
+	DepartmentController   *DepartmentController
+	EmployeeController     *EmployeeController
+	IdempotencyController  *IdempotencyController
+	LeaveController        *LeaveController
+	SickLeaveController    *SickLeaveController
+	VacationController     *VacationController
 }
 
 type Dependens struct {
@@ -25,9 +33,18 @@ type Dependens struct {
 	}
 	Redis interface {
 		Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+		SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.BoolCmd
 		Get(ctx context.Context, key string) *redis.StringCmd
 		Del(ctx context.Context, keys ...string) *redis.IntCmd
+		Incr(ctx context.Context, key string) *redis.IntCmd
+		Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+		TTL(ctx context.Context, key string) *redis.DurationCmd
+		SAdd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+		SRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+		SMembers(ctx context.Context, key string) *redis.StringSliceCmd
 	}
-	Logger *slog.Logger
-	Config *config.Config
+	Keys      *KeyManager
+	Passwords *PasswordManager
+	Logger    *slog.Logger
+	Config    *config.Config
 }
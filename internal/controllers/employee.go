@@ -5,48 +5,115 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/adamanr/employes_service/internal/entity"
 	"github.com/jackc/pgx/v5"
-	"golang.org/x/crypto/bcrypt"
 )
 
+// employeeColumns are the columns of employees mapped to entity.Employee. The
+// vacation days are the whole days of the current leave balance, the sick days
+// are the days of sick leave in the current year.
+const employeeColumns = `id, first_name, last_name, middle_name, phone, personal_number, email, password, role,
+	is_active, department_id, position, manager_id, hire_date, fire_date, birthday, address,
+	` + employeeLeaveColumns + `, status, created_at, updated_at, version`
+
+const employeeLeaveColumns = `FLOOR(leave_balance(id, 'annual'))::BIGINT AS vacation_days,
+	sick_leave_days(id, date_trunc('year', CURRENT_DATE)::date, CURRENT_DATE) AS sick_days`
+
+var (
+	ErrInvalidEmployeesFilter = errors.New("invalid employees filter")
+	ErrEmployeeConflict       = errors.New("email or personal number already exists")
+	ErrEmployeeNotFired       = errors.New("employee is not fired")
+	ErrInvalidEmployee        = errors.New("invalid employee data")
+
+	// ErrPasswordNotEditable is returned when the password is sent with an update.
+	// Admin and hr issue a password reset instead, so that the employee has to
+	// change the password they know.
+	ErrPasswordNotEditable = fmt.Errorf("%w: password is set through a password reset", ErrInvalidEmployee)
+)
+
+// employeePatchFields are the fields of an employee that can be patched. The
+// value tells whether the field may be cleared with null.
+var employeePatchFields = map[string]bool{
+	"first_name":      false,
+	"last_name":       false,
+	"middle_name":     true,
+	"phone":           true,
+	"personal_number": true,
+	"email":           false,
+	"role":            false,
+	"is_active":       false,
+	"department_id":   false,
+	"position":        true,
+	"manager_id":      true,
+	"hire_date":       false,
+	"fire_date":       true,
+	"birthday":        true,
+	"address":         true,
+	"status":          false,
+}
+
+// manualStatuses are the statuses set by admin and hr. The sick status follows
+// the open sick leaves of the employee and is set by SyncStatuses only.
+var manualStatuses = []string{entity.StatusActive, entity.StatusFired, entity.StatusSuspended}
+
+// ownProfileFields are the fields employees may patch on their own record. The
+// rest is managed by admin and hr. The password is not patched at all: it is
+// changed through ChangePassword or set through a password reset.
+var ownProfileFields = []string{"first_name", "last_name", "middle_name", "phone", "email", "birthday", "address"}
+
 type EmployeeController struct {
-	deps *Dependens
+	deps     *Dependens
+	sessions *SessionStore
 }
 
 func NewEmployeeController(deps *Dependens) *EmployeeController {
 	return &EmployeeController{
-		deps: deps,
+		deps:     deps,
+		sessions: NewSessionStore(deps),
 	}
 }
 
-func (c *EmployeeController) GetEmployees(params *entity.GetEmployeesParams) ([]entity.Employee, error) {
-	query := "SELECT * FROM employees WHERE 1=1"
-	args := []any{}
-	argIdx := 1
+// GetEmployees returns one page of the employees matching the filters, ordered
+// by the sort field with id as the tie breaker.
+func (c *EmployeeController) GetEmployees(params *entity.GetEmployeesParams) (*entity.EmployeePage, error) {
+	page, err := newEmployeesPage(params)
+	if err != nil {
+		c.deps.Logger.Warn("Invalid employees page", slog.String("error", err.Error()))
+		return nil, err
+	}
 
-	if params != nil {
-		if params.Role != nil {
-			query += fmt.Sprintf(" AND role = $%d", argIdx)
-			args = append(args, *params.Role)
-			argIdx++
-		}
+	where, args, err := employeesFilter(params)
+	if err != nil {
+		c.deps.Logger.Warn("Invalid employees filter", slog.String("error", err.Error()))
+		return nil, err
+	}
 
-		if params.DepartmentID != nil {
-			query += fmt.Sprintf(" AND department_id = $%d", argIdx)
-			args = append(args, *params.DepartmentID)
-			argIdx++
-		}
+	ctx := context.Background()
+	result := &entity.EmployeePage{}
 
-		if params.Status != nil {
-			query += fmt.Sprintf(" AND status = $%d", argIdx)
-			args = append(args, *params.Status)
+	if params != nil && params.IncludeTotal != nil && *params.IncludeTotal {
+		var total int64
+		if err = c.deps.DB.QueryRow(ctx, "SELECT count(*) FROM employees"+where, args...).Scan(&total); err != nil {
+			c.deps.Logger.Error("Error counting employees", slog.String("error", err.Error()))
+			return nil, err
 		}
+
+		result.TotalCount = &total
+	}
+
+	keyset, keysetArgs, err := page.where(len(args) + 1)
+	if err != nil {
+		return nil, err
 	}
 
-	rows, err := c.deps.DB.Query(context.Background(), query, args...)
+	// One extra row tells whether there is a next page.
+	query := "SELECT " + employeeColumns + " FROM employees" + where + keyset + page.orderBy() + fmt.Sprintf(" LIMIT %d", page.limit+1)
+	args = append(args, keysetArgs...)
+
+	rows, err := c.deps.DB.Query(ctx, query, args...)
 	if err != nil {
 		c.deps.Logger.Error("Error querying employees", slog.String("error", err.Error()))
 		return nil, err
@@ -59,15 +126,87 @@ func (c *EmployeeController) GetEmployees(params *entity.GetEmployeesParams) ([]
 		return nil, err
 	}
 
+	if len(employees) > page.limit {
+		employees = employees[:page.limit]
+		result.NextPageToken = page.nextToken(&employees[len(employees)-1])
+	}
+
 	for i := range employees {
 		employees[i].Password = nil
 	}
 
-	return employees, nil
+	result.Employees = employees
+
+	return result, nil
+}
+
+// employeesFilter returns the WHERE clause of the employee listing and its arguments.
+func employeesFilter(params *entity.GetEmployeesParams) (string, []any, error) {
+	where := " WHERE 1=1"
+	args := []any{}
+
+	if params == nil {
+		params = &entity.GetEmployeesParams{}
+	}
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		where += " AND " + fmt.Sprintf(condition, len(args))
+	}
+
+	if params.Role != nil {
+		add("role = $%d", *params.Role)
+	}
+
+	if params.DepartmentID != nil {
+		add("department_id = $%d", *params.DepartmentID)
+	}
+
+	// Terminated employees are kept for the history, but are listed only when
+	// asked for by status.
+	if params.Status != nil {
+		add("status = $%d", *params.Status)
+	} else {
+		where += " AND status <> '" + entity.StatusFired + "'"
+	}
+
+	if params.ManagerID != nil {
+		add("manager_id = $%d", *params.ManagerID)
+	}
+
+	if params.IsActive != nil {
+		add("is_active = $%d", *params.IsActive)
+	}
+
+	if params.HiredFrom != nil && params.HiredTo != nil && params.HiredFrom.After(*params.HiredTo) {
+		return "", nil, fmt.Errorf("%w: hired_from is after hired_to", ErrInvalidEmployeesFilter)
+	}
+
+	if params.HiredFrom != nil {
+		add("hire_date >= $%d", *params.HiredFrom)
+	}
+
+	if params.HiredTo != nil {
+		add("hire_date <= $%d", *params.HiredTo)
+	}
+
+	if params.BirthdayMonth != nil {
+		if *params.BirthdayMonth < 1 || *params.BirthdayMonth > 12 {
+			return "", nil, fmt.Errorf("%w: birthday_month must be between 1 and 12", ErrInvalidEmployeesFilter)
+		}
+
+		add("EXTRACT(MONTH FROM birthday) = $%d", *params.BirthdayMonth)
+	}
+
+	if params.Position != nil {
+		add("lower(position) = lower($%d)", *params.Position)
+	}
+
+	return where, args, nil
 }
 
 func (c *EmployeeController) GetEmployeeByID(id uint64) (*entity.Employee, error) {
-	rows, err := c.deps.DB.Query(context.Background(), "SELECT * FROM employees WHERE id = $1", id)
+	rows, err := c.deps.DB.Query(context.Background(), "SELECT "+employeeColumns+" FROM employees WHERE id = $1", id)
 	if err != nil {
 		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
 		return nil, err
@@ -96,24 +235,33 @@ func (c *EmployeeController) CreateEmployee(emp entity.Employee) (*entity.Employ
 		return nil, errors.New("required fields: first_name, last_name, email")
 	}
 
+	ctx := context.Background()
+
+	var temporaryPassword *string
+	var hashPassword string
+	var err error
+
 	if emp.Password == nil || *emp.Password == "" {
-		defaultPassword := "default123"
-		emp.Password = &defaultPassword
-	}
+		password, genErr := generateTemporaryPassword(c.deps.Logger)
+		if genErr != nil {
+			return nil, genErr
+		}
 
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(*emp.Password), bcrypt.DefaultCost)
-	if err != nil {
-		c.deps.Logger.Error("Error hashing password", slog.String("error", err.Error()))
+		temporaryPassword = &password
+		if hashPassword, err = c.deps.Passwords.Hasher.Hash(password); err != nil {
+			c.deps.Logger.Error("Error hashing password", slog.String("error", err.Error()))
+			return nil, err
+		}
+	} else if hashPassword, err = hashNewPassword(ctx, c.deps, nil, *emp.Password); err != nil {
 		return nil, err
 	}
 
-	hashPassword := string(passwordHash)
 	emp.Password = &hashPassword
 
 	query := `SELECT COUNT(*) FROM employees WHERE email = $1 OR (personal_number IS NOT NULL AND personal_number = $2)`
 
 	var exists int
-	if err = c.deps.DB.QueryRow(context.Background(), query, emp.Email, emp.PersonalNumber).Scan(&exists); err != nil {
+	if err = c.deps.DB.QueryRow(ctx, query, emp.Email, emp.PersonalNumber).Scan(&exists); err != nil {
 		c.deps.Logger.Error("Error checking uniqueness", slog.String("error", err.Error()))
 		return nil, err
 	}
@@ -126,56 +274,214 @@ func (c *EmployeeController) CreateEmployee(emp entity.Employee) (*entity.Employ
 	c.deps.Logger.Info("Employee created", slog.Any("emp", emp.IsActive))
 
 	now := time.Now()
-	query = `INSERT INTO employees (first_name, last_name, middle_name, phone, personal_number, email, password, role, is_active, department_id, position, manager_id, hire_date, fire_date, birthday, address, vacation_days, sick_days, status, created_at, updated_at)
-              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
-              RETURNING id, first_name, last_name, email, password, role, status, department_id, manager_id, position, address, phone, personal_number, middle_name, birthday, hire_date, fire_date, is_active, vacation_days, sick_days, created_at, updated_at`
+	query = `INSERT INTO employees (first_name, last_name, middle_name, phone, personal_number, email, password, role, is_active, department_id, position, manager_id, hire_date, fire_date, birthday, address, status, created_at, updated_at, must_change_password)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+              RETURNING id, first_name, last_name, email, password, role, status, department_id, manager_id, position, address, phone, personal_number, middle_name, birthday, hire_date, fire_date, is_active, ` + employeeLeaveColumns + `, created_at, updated_at, version`
 
 	var result entity.Employee
-	if err = c.deps.DB.QueryRow(context.Background(), query,
+	if err = c.deps.DB.QueryRow(ctx, query,
 		emp.FirstName, emp.LastName, emp.MiddleName, emp.Phone, emp.PersonalNumber,
 		emp.Email, emp.Password, emp.Role, emp.IsActive, emp.DepartmentID, emp.Position,
 		emp.ManagerID, emp.HireDate, emp.FireDate, emp.Birthday, emp.Address,
-		emp.VacationDays, emp.SickDays, emp.Status, now, now,
+		emp.Status, now, now, temporaryPassword != nil,
 	).Scan(
 		&result.ID, &result.FirstName, &result.LastName, &result.Email, &result.Password,
 		&result.Role, &result.Status, &result.DepartmentID, &result.ManagerID, &result.Position,
 		&result.Address, &result.Phone, &result.PersonalNumber, &result.MiddleName, &result.Birthday,
 		&result.HireDate, &result.FireDate, &result.IsActive, &result.VacationDays, &result.SickDays,
-		&result.CreatedAt, &result.UpdatedAt,
+		&result.CreatedAt, &result.UpdatedAt, &result.Version,
 	); err != nil {
 		c.deps.Logger.Error("Error inserting employee", slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	result.Password = nil
+	if result.ID != nil {
+		if err = recordPasswordHistory(ctx, c.deps, *result.ID, hashPassword); err != nil {
+			return nil, err
+		}
+	}
+
+	// The generated password is returned once so that it can be handed over to
+	// the employee, who has to change it on the first login.
+	result.Password = temporaryPassword
 	return &result, nil
 }
 
-func (c *EmployeeController) RequestVacation(id uint64, days uint64) (*entity.Employee, error) {
-	if days <= 0 {
-		c.deps.Logger.Error("Invalid vacation days", slog.Any("days", days))
-		return nil, errors.New("invalid vacation days")
+// UpdateEmployee replaces the employee on behalf of admin or hr if it is still
+// at the expected version. Only admin may change an admin or grant the admin role.
+// The password is kept, a new one is set through a password reset.
+func (c *EmployeeController) UpdateEmployee(user *entity.Claims, id uint64, emp entity.Employee, expectedVersion uint64) (*entity.Employee, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
 	}
 
-	now := time.Now()
-	query := `UPDATE employees 
-              SET vacation_days = vacation_days + $1, updated_at = $2 
-              WHERE id = $3 
-              RETURNING *`
+	if err := c.checkRoleChange(user, id, emp.Role); err != nil {
+		return nil, err
+	}
+
+	return c.updateEmployee(id, emp, expectedVersion)
+}
+
+func (c *EmployeeController) updateEmployee(id uint64, emp entity.Employee, expectedVersion uint64) (*entity.Employee, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	if emp.FirstName == "" || emp.LastName == "" || emp.Email == nil || *emp.Email == "" {
+		c.deps.Logger.Error("Invalid employee data", slog.String("error", "First name, last name, email are required"))
+		return nil, ErrInvalidEmployee
+	}
+
+	if emp.Password != nil {
+		c.deps.Logger.Warn("Password sent with employee update", slog.Any("id", id))
+		return nil, ErrPasswordNotEditable
+	}
+
+	if err := c.validateRoleStatus(id, emp.Role, emp.Status); err != nil {
+		c.deps.Logger.Warn("Invalid employee data", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	//nolint:goconst // Nothing.
+	query := `SELECT COUNT(*) FROM employees WHERE (email = $1 OR (personal_number IS NOT NULL AND personal_number = $2)) AND id != $3`
 
-	rows, err := c.deps.DB.Query(context.Background(), query, days, now, id)
+	var exists int
+	if err := c.deps.DB.QueryRow(context.Background(), query, emp.Email, emp.PersonalNumber, id).Scan(&exists); err != nil {
+		c.deps.Logger.Error("Error checking uniqueness", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if exists > 0 {
+		c.deps.Logger.Warn("Email or personal number already exists", slog.String("email", *emp.Email))
+		return nil, ErrEmployeeConflict
+	}
+
+	updatedEmp, err := c.updateEmployeeInDB(&emp, id, expectedVersion)
 	if err != nil {
-		c.deps.Logger.Error("Error updating vacation days", slog.String("error", err.Error()))
+		c.deps.Logger.Error("Error updating employee", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if updatedEmp.IsActive != nil && !accountActive(*updatedEmp.IsActive, updatedEmp.Status) {
+		if err = c.revokeSessions(id, "deactivated"); err != nil {
+			return nil, err
+		}
+	}
+
+	return &updatedEmp, nil
+}
+
+// UpdateOwnProfile updates the employee on behalf of the employee themselves.
+// Fields managed by admin and hr are kept from the stored record.
+func (c *EmployeeController) UpdateOwnProfile(id uint64, emp entity.Employee, expectedVersion uint64) (*entity.Employee, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	current, err := c.GetEmployeeByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	emp.Role = current.Role
+	emp.Status = current.Status
+	emp.IsActive = current.IsActive
+	emp.DepartmentID = current.DepartmentID
+	emp.ManagerID = current.ManagerID
+	emp.Position = current.Position
+	emp.PersonalNumber = current.PersonalNumber
+	emp.HireDate = current.HireDate
+	emp.FireDate = current.FireDate
+	// The password is changed through ChangePassword, which checks the old one.
+	emp.Password = nil
+
+	return c.updateEmployee(id, emp, expectedVersion)
+}
+
+// PatchEmployee changes only the fields of the patch on behalf of admin or hr.
+// Only admin may change an admin or grant the admin role.
+func (c *EmployeeController) PatchEmployee(user *entity.Claims, id uint64, patch entity.EmployeePatch, expectedVersion uint64) (*entity.Employee, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	var newRole string
+	if slices.Contains(patch.Fields, "role") {
+		newRole = patch.Employee.Role
+	}
+
+	if err := c.checkRoleChange(user, id, newRole); err != nil {
+		return nil, err
+	}
+
+	return c.patchEmployee(id, patch, expectedVersion)
+}
+
+// patchEmployee changes only the fields of the patch. Only these fields are
+// validated. The patch is applied only if the employee is still at the expected
+// version.
+func (c *EmployeeController) patchEmployee(id uint64, patch entity.EmployeePatch, expectedVersion uint64) (*entity.Employee, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	fields, err := patchFields(patch.Fields, employeePatchFields)
+	if err != nil {
+		c.deps.Logger.Warn("Invalid employee patch", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	emp := patch.Employee
+	if err = validateEmployeePatch(fields, &emp); err != nil {
+		c.deps.Logger.Warn("Invalid employee patch", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	if slices.Contains(fields, "email") || slices.Contains(fields, "personal_number") {
+		var email, personalNumber *string
+		if slices.Contains(fields, "email") {
+			email = emp.Email
+		}
+
+		if slices.Contains(fields, "personal_number") {
+			personalNumber = emp.PersonalNumber
+		}
+
+		query := `SELECT COUNT(*) FROM employees WHERE (email = $1 OR (personal_number IS NOT NULL AND personal_number = $2)) AND id != $3`
+
+		var exists int
+		if err = c.deps.DB.QueryRow(ctx, query, email, personalNumber, id).Scan(&exists); err != nil {
+			c.deps.Logger.Error("Error checking uniqueness", slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		if exists > 0 {
+			c.deps.Logger.Warn("Email or personal number already exists", slog.Any("id", id))
+			return nil, ErrEmployeeConflict
+		}
+	}
+
+	set, args := patchSet(fields, func(field string) any {
+		return employeeFieldValue(&emp, field)
+	})
+	args = append(args, time.Now(), id, expectedVersion)
+
+	query := fmt.Sprintf("UPDATE employees SET %s, updated_at = $%d, version = version + 1 WHERE id = $%d AND version = $%d RETURNING %s",
+		set, len(args)-2, len(args)-1, len(args), employeeColumns)
+
+	rows, err := c.deps.DB.Query(ctx, query, args...)
+	if err != nil {
+		c.deps.Logger.Error("Error updating employee", slog.String("error", err.Error()))
 		return nil, err
 	}
 	defer rows.Close()
 
-	var updatedEmp entity.Employee
-	updatedEmp, err = pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.Employee])
+	updatedEmp, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.Employee])
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			c.deps.Logger.Error("Employee not found", slog.String("error", err.Error()))
-			return nil, errors.New("employee not found")
+			return nil, versionConflict(c.deps, "employees", id, expectedVersion, ErrEmployeeNotFound)
 		}
 
 		c.deps.Logger.Error("Error collecting row", slog.String("error", err.Error()))
@@ -184,88 +490,195 @@ func (c *EmployeeController) RequestVacation(id uint64, days uint64) (*entity.Em
 
 	updatedEmp.Password = nil
 
+	if updatedEmp.IsActive != nil && !accountActive(*updatedEmp.IsActive, updatedEmp.Status) &&
+		(slices.Contains(fields, "is_active") || slices.Contains(fields, "status")) {
+		if err = c.revokeSessions(id, "deactivated"); err != nil {
+			return nil, err
+		}
+	}
+
+	c.deps.Logger.Info("Employee patched", slog.Any("id", id), slog.Any("fields", fields))
+
 	return &updatedEmp, nil
 }
 
-// GetPasswordHash is method to get password for update employee.
-func (c *EmployeeController) getPasswordHash(newPassword *string, employeeID uint64) (string, error) {
-	if newPassword != nil {
-		hash, err := bcrypt.GenerateFromPassword([]byte(*newPassword), bcrypt.DefaultCost)
-		if err != nil {
-			c.deps.Logger.Error("Error generating password hash", slog.String("error", err.Error()))
-			return "", err
+// PatchOwnProfile patches the employee on behalf of the employee themselves,
+// who may change only the ownProfileFields.
+func (c *EmployeeController) PatchOwnProfile(id uint64, patch entity.EmployeePatch, expectedVersion uint64) (*entity.Employee, error) {
+	for _, field := range patch.Fields {
+		if !slices.Contains(ownProfileFields, field) {
+			c.deps.Logger.Warn("Field is not editable by the employee", slog.Any("id", id), slog.String("field", field))
+			return nil, fmt.Errorf("%w: field %s is managed by hr", ErrPermissionDenied, field)
 		}
+	}
+
+	return c.patchEmployee(id, patch, expectedVersion)
+}
+
+// checkRoleChange checks that the user may change the employee and give them
+// the new role, empty if the role is kept.
+func (c *EmployeeController) checkRoleChange(user *entity.Claims, id uint64, newRole string) error {
+	if user.Type != TokenTypeAPIKey && user.Role == entity.RoleAdmin {
+		return nil
+	}
+
+	if !MayManageRole(user, "", newRole) {
+		c.deps.Logger.Warn("Admin role grant denied", slog.Any("user_id", user.ID), slog.Any("employee_id", id))
+		return fmt.Errorf("%w: only admin may grant the admin role", ErrPermissionDenied)
+	}
+
+	var role string
+	if err := c.deps.DB.QueryRow(context.Background(), "SELECT role FROM employees WHERE id = $1", id).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Employee not found", slog.Any("id", id))
+			return ErrEmployeeNotFound
+		}
+
+		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
+		return err
+	}
 
-		hashStr := string(hash)
-		return hashStr, nil
+	if !MayManageRole(user, role, newRole) {
+		c.deps.Logger.Warn("Admin change denied", slog.Any("user_id", user.ID), slog.Any("employee_id", id))
+		return fmt.Errorf("%w: only admin may change an admin", ErrPermissionDenied)
 	}
 
-	query := `SELECT password FROM employees WHERE id = $1`
-	var currentHash string
-	err := c.deps.DB.QueryRow(context.Background(), query, employeeID).Scan(&currentHash)
-	return currentHash, err
+	return nil
 }
 
-func (c *EmployeeController) UpdateEmployee(id uint64, emp entity.Employee) (*entity.Employee, error) {
-	if emp.FirstName == "" || emp.LastName == "" || emp.Email == nil || *emp.Email == "" {
-		c.deps.Logger.Error("Invalid employee data", slog.String("error", "First name, last name, email are required"))
-		return nil, errors.New("invalid employee data")
+func validateEmployeePatch(fields []string, emp *entity.Employee) error {
+	for _, field := range fields {
+		value := employeeFieldValue(emp, field)
+		if !employeePatchFields[field] && isNilValue(value) {
+			return fmt.Errorf("%w: %s cannot be null", ErrInvalidPatch, field)
+		}
+
+		switch field {
+		case "first_name", "last_name":
+			if value == "" {
+				return fmt.Errorf("%w: %s cannot be empty", ErrInvalidPatch, field)
+			}
+		case "email":
+			if *emp.Email == "" {
+				return fmt.Errorf("%w: email cannot be empty", ErrInvalidPatch)
+			}
+		case "role":
+			if !slices.Contains(allRoles, emp.Role) {
+				return fmt.Errorf("%w: unknown role %s", ErrInvalidPatch, emp.Role)
+			}
+		case "status":
+			if err := validateStatus(emp.Status); err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+			}
+		}
 	}
 
-	passwordHash, err := c.getPasswordHash(emp.Password, id)
-	if err != nil {
+	return nil
+}
+
+// validateRoleStatus checks the role and the status of a replaced employee. A
+// sick employee may be replaced with the sick status kept, which SyncStatuses
+// clears once the sick leave is closed.
+func (c *EmployeeController) validateRoleStatus(id uint64, role, status string) error {
+	if !slices.Contains(allRoles, role) {
+		return fmt.Errorf("%w: unknown role %s", ErrInvalidEmployee, role)
+	}
+
+	if status != entity.StatusSick {
+		if err := validateStatus(status); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidEmployee, err)
+		}
+
+		return nil
+	}
+
+	var current string
+	if err := c.deps.DB.QueryRow(context.Background(), "SELECT status FROM employees WHERE id = $1", id).Scan(&current); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			c.deps.Logger.Error("Employee not found", slog.String("error", err.Error()))
-			return nil, errors.New("employee not found")
+			return ErrEmployeeNotFound
 		}
 
-		c.deps.Logger.Error("Error getting password", slog.String("error", err.Error()))
-		return nil, err
+		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
+		return err
 	}
 
-	emp.Password = &passwordHash
+	if current != entity.StatusSick {
+		return fmt.Errorf("%w: %w", ErrInvalidEmployee, validateStatus(status))
+	}
 
-	//nolint:goconst // Nothing.
-	query := `SELECT COUNT(*) FROM employees WHERE (email = $1 OR (personal_number IS NOT NULL AND personal_number = $2)) AND id != $3`
+	return nil
+}
 
-	var exists int
-	if err = c.deps.DB.QueryRow(context.Background(), query, emp.Email, emp.PersonalNumber, id).Scan(&exists); err != nil {
-		c.deps.Logger.Error("Error checking uniqueness", slog.String("error", err.Error()))
-		return nil, err
+// validateStatus checks that admin and hr may set the status.
+func validateStatus(status string) error {
+	if status == entity.StatusSick {
+		return errors.New("status sick is set by the sick leaves")
 	}
 
-	if exists > 0 {
-		c.deps.Logger.Warn("Email or personal number already exists", slog.String("email", *emp.Email))
-		return nil, errors.New("email or personal number already exists")
+	if !slices.Contains(manualStatuses, status) {
+		return fmt.Errorf("unknown status %s", status)
 	}
 
-	updatedEmp, err := c.updateEmployeeInDB(&emp, id)
-	if err != nil {
-		c.deps.Logger.Error("Error updating employee", slog.String("error", err.Error()))
-		return nil, err
+	return nil
+}
+
+// employeeFieldValue returns the value of the employee field named as in JSON.
+func employeeFieldValue(emp *entity.Employee, field string) any {
+	switch field {
+	case "first_name":
+		return emp.FirstName
+	case "last_name":
+		return emp.LastName
+	case "middle_name":
+		return emp.MiddleName
+	case "phone":
+		return emp.Phone
+	case "personal_number":
+		return emp.PersonalNumber
+	case "email":
+		return emp.Email
+	case "role":
+		return emp.Role
+	case "is_active":
+		return emp.IsActive
+	case "department_id":
+		return emp.DepartmentID
+	case "position":
+		return emp.Position
+	case "manager_id":
+		return emp.ManagerID
+	case "hire_date":
+		return emp.HireDate
+	case "fire_date":
+		return emp.FireDate
+	case "birthday":
+		return emp.Birthday
+	case "address":
+		return emp.Address
+	case "status":
+		return emp.Status
 	}
 
-	return &updatedEmp, nil
+	return nil
 }
 
-func (c *EmployeeController) updateEmployeeInDB(emp *entity.Employee, id uint64) (entity.Employee, error) {
+func (c *EmployeeController) updateEmployeeInDB(emp *entity.Employee, id, expectedVersion uint64) (entity.Employee, error) {
 	now := time.Now()
 	emp.UpdatedAt = &now
 
 	query := `UPDATE employees 
               SET first_name = $1, last_name = $2, middle_name = $3, phone = $4, personal_number = $5, 
-                  email = $6, password = $7, role = $8, is_active = $9, department_id = $10, 
-                  position = $11, manager_id = $12, hire_date = $13, fire_date = $14, 
-                  birthday = $15, address = $16, vacation_days = $17, sick_days = $18, 
-                  status = $19, updated_at = $20 
-              WHERE id = $21 
-              RETURNING *`
+                  email = $6, role = $7, is_active = $8, department_id = $9, 
+                  position = $10, manager_id = $11, hire_date = $12, fire_date = $13, 
+                  birthday = $14, address = $15, status = $16, updated_at = $17, version = version + 1 
+              WHERE id = $18 AND version = $19 
+              RETURNING ` + employeeColumns
 
 	rows, err := c.deps.DB.Query(context.Background(), query,
 		emp.FirstName, emp.LastName, emp.MiddleName, emp.Phone, emp.PersonalNumber,
-		*emp.Email, emp.Password, emp.Role, emp.IsActive, emp.DepartmentID,
+		*emp.Email, emp.Role, emp.IsActive, emp.DepartmentID,
 		emp.Position, emp.ManagerID, emp.HireDate, emp.FireDate, emp.Birthday,
-		emp.Address, emp.VacationDays, emp.SickDays, emp.Status, emp.UpdatedAt, id)
+		emp.Address, emp.Status, emp.UpdatedAt, id, expectedVersion)
 	if err != nil {
 		c.deps.Logger.Error("Error updating employee", slog.String("error", err.Error()))
 		return entity.Employee{}, fmt.Errorf("failed to update employee: %w", err)
@@ -275,8 +688,7 @@ func (c *EmployeeController) updateEmployeeInDB(emp *entity.Employee, id uint64)
 	updatedEmp, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.Employee])
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			c.deps.Logger.Error("Employee not found", slog.String("error", err.Error()))
-			return entity.Employee{}, errors.New("employee not found")
+			return entity.Employee{}, versionConflict(c.deps, "employees", id, expectedVersion, ErrEmployeeNotFound)
 		}
 
 		c.deps.Logger.Error("Error collecting row", slog.String("error", err.Error()))
@@ -288,17 +700,132 @@ func (c *EmployeeController) updateEmployeeInDB(emp *entity.Employee, id uint64)
 	return updatedEmp, nil
 }
 
-func (c *EmployeeController) DeleteEmployee(id uint64) error {
-	result, err := c.deps.DB.Exec(context.Background(), "DELETE FROM employees WHERE id = $1", id)
+// DeleteEmployee terminates the employee if it is still at the expected
+// version: the record is kept for the HR history with status fired, the account
+// is deactivated and the fire date is set, unless the employee is already
+// fired. Terminated employees are hidden from the listing by default.
+func (c *EmployeeController) DeleteEmployee(id, expectedVersion uint64) error {
+	if err := requireVersion(expectedVersion); err != nil {
+		return err
+	}
+
+	query := `UPDATE employees
+              SET status = $1, is_active = false, fire_date = CASE WHEN status = $1 THEN fire_date ELSE $2 END,
+                  updated_at = $2, version = version + 1
+              WHERE id = $3 AND version = $4`
+
+	result, err := c.deps.DB.Exec(context.Background(), query, entity.StatusFired, time.Now(), id, expectedVersion)
+	if err != nil {
+		c.deps.Logger.Error("Error terminating employee", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return versionConflict(c.deps, "employees", id, expectedVersion, ErrEmployeeNotFound)
+	}
+
+	c.deps.Logger.Info("Employee terminated", slog.Any("id", id))
+
+	return c.revokeSessions(id, "terminated")
+}
+
+// RestoreEmployee rehires a terminated employee: the status becomes active, the
+// account is activated again and the fire date is cleared.
+func (c *EmployeeController) RestoreEmployee(id uint64) (*entity.Employee, error) {
+	query := `UPDATE employees
+              SET status = $1, is_active = true, fire_date = NULL, updated_at = $2, version = version + 1
+              WHERE id = $3 AND status = $4
+              RETURNING ` + employeeColumns
+
+	rows, err := c.deps.DB.Query(context.Background(), query, entity.StatusActive, time.Now(), id, entity.StatusFired)
+	if err != nil {
+		c.deps.Logger.Error("Error restoring employee", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	employee, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.Employee])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			if err = c.checkFired(id); err != nil {
+				return nil, err
+			}
+
+			// The employee was fired again after the update looked at it.
+			return nil, ErrVersionMismatch
+		}
+
+		c.deps.Logger.Error("Error collecting row", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	c.deps.Logger.Info("Employee restored", slog.Any("id", id))
+
+	employee.Password = nil
+
+	return &employee, nil
+}
+
+// PurgeEmployee erases a terminated employee from the database for good. The
+// employee has to be terminated first and still be at the expected version.
+// References to the employee as a manager or a department head are cleared.
+func (c *EmployeeController) PurgeEmployee(id, expectedVersion uint64) error {
+	if err := requireVersion(expectedVersion); err != nil {
+		return err
+	}
+
+	result, err := c.deps.DB.Exec(context.Background(), "DELETE FROM employees WHERE id = $1 AND version = $2 AND status = $3",
+		id, expectedVersion, entity.StatusFired)
 	if err != nil {
-		c.deps.Logger.Error("Error deleting employee", slog.String("error", err.Error()))
+		c.deps.Logger.Error("Error purging employee", slog.String("error", err.Error()))
 		return err
 	}
 
 	if result.RowsAffected() == 0 {
-		c.deps.Logger.Warn("Employee not found", slog.Any("id", id))
-		return errors.New("employee not found")
+		if err = c.checkFired(id); err != nil {
+			return err
+		}
+
+		return versionConflict(c.deps, "employees", id, expectedVersion, ErrEmployeeNotFound)
 	}
 
+	c.deps.Logger.Info("Employee purged", slog.Any("id", id))
+
+	return c.revokeSessions(id, "purged")
+}
+
+// checkFired returns ErrEmployeeNotFound if the employee is missing and
+// ErrEmployeeNotFired if it is not terminated.
+func (c *EmployeeController) checkFired(id uint64) error {
+	var status string
+
+	err := c.deps.DB.QueryRow(context.Background(), "SELECT status FROM employees WHERE id = $1", id).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrEmployeeNotFound
+		}
+
+		c.deps.Logger.Error("Error getting employee status", slog.String("error", err.Error()))
+		return err
+	}
+
+	if status != entity.StatusFired {
+		c.deps.Logger.Warn("Employee is not fired", slog.Any("id", id), slog.String("status", status))
+		return ErrEmployeeNotFired
+	}
+
+	return nil
+}
+
+// revokeSessions revokes every session of the employee, so that the access
+// tokens stop working right away instead of at their expiration.
+func (c *EmployeeController) revokeSessions(id uint64, reason string) error {
+	if err := c.sessions.RevokeAll(context.Background(), id); err != nil {
+		c.deps.Logger.Error("Error revoking sessions", slog.String("error", err.Error()), slog.Any("user_id", id))
+		return err
+	}
+
+	c.deps.Logger.Info("Sessions revoked", slog.Any("user_id", id), slog.String("reason", reason))
+
 	return nil
 }
@@ -2,14 +2,17 @@ package controllers
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/adamanr/employes_service/internal/entity"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 var EmployeeFieldDescriptions = []pgconn.FieldDescription{
@@ -35,6 +38,7 @@ var EmployeeFieldDescriptions = []pgconn.FieldDescription{
 	{Name: "sick_days", DataTypeOID: 20},       // int8 (uint64, nullable)
 	{Name: "created_at", DataTypeOID: 1114},    // timestamp (nullable)
 	{Name: "updated_at", DataTypeOID: 1114},    // timestamp (nullable)
+	{Name: "version", DataTypeOID: 20},         // int8 (uint64)
 }
 
 func TestEmployeeController_GetEmployees(t *testing.T) {
@@ -286,15 +290,16 @@ func TestEmployeeController_GetEmployees(t *testing.T) {
 			tt.setupMocks(mockDB)
 
 			controller := NewEmployeeController(deps)
-			employees, err := controller.GetEmployees(tt.params)
+			page, err := controller.GetEmployees(tt.params)
 
 			if tt.expectError {
 				assert.Error(t, err)
-				assert.Nil(t, employees)
+				assert.Nil(t, page)
 			} else {
 				assert.NoError(t, err)
-				assert.Len(t, employees, tt.expectedLen)
-				for _, emp := range employees {
+				assert.Len(t, page.Employees, tt.expectedLen)
+				assert.Empty(t, page.NextPageToken)
+				for _, emp := range page.Employees {
 					assert.Nil(t, emp.Password)
 				}
 			}
@@ -304,6 +309,204 @@ func TestEmployeeController_GetEmployees(t *testing.T) {
 	}
 }
 
+func employeeListRow(id uint64, lastName string, hired time.Time) []interface{} {
+	return []interface{}{
+		Uint64Ptr(id), "John", lastName, StringPtr("john@example.com"), StringPtr("hashedpassword"),
+		"employee", "active", Uint64Ptr(1), nil, nil, nil, nil, nil, nil, nil,
+		TimePtr(hired), nil, BoolPtr(true), Uint64Ptr(28), Uint64Ptr(0), TimePtr(hired), TimePtr(hired), uint64(2),
+	}
+}
+
+// adminUser is the admin on whose behalf the employees are managed.
+var adminUser = &entity.Claims{ID: 1, Role: entity.RoleAdmin}
+
+func queryContains(parts ...string) interface{} {
+	return mock.MatchedBy(func(query string) bool {
+		for _, part := range parts {
+			if !strings.Contains(query, part) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+func TestEmployeeController_GetEmployees_Pagination(t *testing.T) {
+	hired := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("next page token", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, queryContains("ORDER BY last_name ASC, id ASC LIMIT 3")).
+			Return(NewMockRows([][]interface{}{
+				employeeListRow(4, "Adams", hired),
+				employeeListRow(2, "Doe", hired),
+				employeeListRow(7, "Doe", hired),
+			}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		first, err := controller.GetEmployees(&entity.GetEmployeesParams{Limit: IntPtr(2), SortBy: StringPtr("last_name")})
+		require.NoError(t, err)
+		require.Len(t, first.Employees, 2)
+		require.NotEmpty(t, first.NextPageToken)
+		assert.Nil(t, first.TotalCount)
+
+		mockDB.On("Query", mock.Anything, queryContains("AND (last_name, id) > ($1, $2) ORDER BY last_name ASC, id ASC LIMIT 3"), "Doe", uint64(2)).
+			Return(NewMockRows([][]interface{}{
+				employeeListRow(7, "Doe", hired),
+			}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		second, err := controller.GetEmployees(&entity.GetEmployeesParams{
+			Limit:     IntPtr(2),
+			SortBy:    StringPtr("last_name"),
+			PageToken: StringPtr(first.NextPageToken),
+		})
+		require.NoError(t, err)
+		assert.Len(t, second.Employees, 1)
+		assert.Empty(t, second.NextPageToken)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("descending timestamps after filters", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		token := (&employeesPage{sortBy: "hire_date", desc: true}).nextToken(&entity.Employee{ID: Uint64Ptr(9), HireDate: &hired})
+
+		mockDB.On("Query", mock.Anything,
+			queryContains("AND role = $1", "AND (hire_date, id) < ($2, $3) ORDER BY hire_date DESC, id DESC LIMIT 51"),
+			"manager", hired, uint64(9),
+		).Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil).Once()
+
+		page, err := controller.GetEmployees(&entity.GetEmployeesParams{
+			Role:      StringPtr("manager"),
+			SortBy:    StringPtr("hire_date"),
+			SortOrder: StringPtr(SortOrderDesc),
+			PageToken: StringPtr(token),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, page.Employees)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("total count", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT count(*) FROM employees WHERE 1=1 AND status = $1", "active").
+			Return(NewMockRow([]interface{}{int64(30000)}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, queryContains("ORDER BY id ASC LIMIT 51"), "active").
+			Return(NewMockRows([][]interface{}{employeeListRow(1, "Doe", hired)}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		page, err := controller.GetEmployees(&entity.GetEmployeesParams{Status: StringPtr("active"), IncludeTotal: BoolPtr(true)})
+		require.NoError(t, err)
+		require.NotNil(t, page.TotalCount)
+		assert.Equal(t, int64(30000), *page.TotalCount)
+		mockDB.AssertExpectations(t)
+	})
+
+	otherOrder := (&employeesPage{sortBy: "id"}).nextToken(&entity.Employee{ID: Uint64Ptr(3)})
+
+	invalid := []struct {
+		name        string
+		params      *entity.GetEmployeesParams
+		expectedErr error
+	}{
+		{name: "zero limit", params: &entity.GetEmployeesParams{Limit: IntPtr(0)}, expectedErr: ErrInvalidPageSize},
+		{name: "limit over max", params: &entity.GetEmployeesParams{Limit: IntPtr(MaxEmployeesPageSize + 1)}, expectedErr: ErrInvalidPageSize},
+		{name: "unknown sort field", params: &entity.GetEmployeesParams{SortBy: StringPtr("password")}, expectedErr: ErrInvalidSortField},
+		{name: "unknown sort order", params: &entity.GetEmployeesParams{SortOrder: StringPtr("up")}, expectedErr: ErrInvalidSortOrder},
+		{name: "malformed token", params: &entity.GetEmployeesParams{PageToken: StringPtr("not a token")}, expectedErr: ErrInvalidPageToken},
+		{
+			name:        "token of another order",
+			params:      &entity.GetEmployeesParams{SortBy: StringPtr("last_name"), PageToken: StringPtr(otherOrder)},
+			expectedErr: ErrInvalidPageToken,
+		},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+			page, err := controller.GetEmployees(tt.params)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, page)
+			mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestEmployeeController_GetEmployees_Filters(t *testing.T) {
+	hiredFrom := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	hiredTo := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("all filters", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything,
+			queryContains(" WHERE 1=1 AND role = $1 AND department_id = $2 AND status = $3 AND manager_id = $4"+
+				" AND is_active = $5 AND hire_date >= $6 AND hire_date <= $7"+
+				" AND EXTRACT(MONTH FROM birthday) = $8 AND lower(position) = lower($9) ORDER BY"),
+			"employee", uint64(1), "active", uint64(3), true, hiredFrom, hiredTo, 5, "Developer",
+		).Return(NewMockRows([][]interface{}{employeeListRow(1, "Doe", hiredFrom)}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		page, err := controller.GetEmployees(&entity.GetEmployeesParams{
+			Role:          StringPtr("employee"),
+			DepartmentID:  Uint64Ptr(1),
+			Status:        StringPtr("active"),
+			ManagerID:     Uint64Ptr(3),
+			IsActive:      BoolPtr(true),
+			HiredFrom:     &hiredFrom,
+			HiredTo:       &hiredTo,
+			BirthdayMonth: IntPtr(5),
+			Position:      StringPtr("Developer"),
+		})
+		require.NoError(t, err)
+		assert.Len(t, page.Employees, 1)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("fired hidden by default", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, queryContains(" WHERE 1=1 AND status <> 'fired' ORDER BY")).
+			Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil).Once()
+		mockDB.On("Query", mock.Anything, queryContains(" WHERE 1=1 AND status = $1 ORDER BY"), entity.StatusFired).
+			Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil).Once()
+
+		_, err := controller.GetEmployees(&entity.GetEmployeesParams{})
+		require.NoError(t, err)
+
+		_, err = controller.GetEmployees(&entity.GetEmployeesParams{Status: StringPtr(entity.StatusFired)})
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name   string
+		params *entity.GetEmployeesParams
+	}{
+		{name: "birthday month out of range", params: &entity.GetEmployeesParams{BirthdayMonth: IntPtr(13)}},
+		{name: "inverted hire date range", params: &entity.GetEmployeesParams{HiredFrom: &hiredTo, HiredTo: &hiredFrom}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+			page, err := controller.GetEmployees(tt.params)
+			assert.ErrorIs(t, err, ErrInvalidEmployeesFilter)
+			assert.Nil(t, page)
+			mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestEmployeeController_GetEmployeeByID(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -464,70 +667,84 @@ func TestEmployeeController_CreateEmployee(t *testing.T) {
 	}
 }
 
-func TestEmployeeController_RequestVacation(t *testing.T) {
+func TestEmployeeController_UpdateEmployee(t *testing.T) {
 	tests := []struct {
 		name          string
 		employeeID    uint64
-		days          uint64
+		employee      entity.Employee
 		setupMocks    func(*MockDB)
 		expectError   bool
 		errorContains string
 	}{
 		{
-			name:       "successful vacation request",
+			name:       "successful update",
 			employeeID: 1,
-			days:       5,
+			employee: entity.Employee{
+				FirstName: "John",
+				LastName:  "Doe",
+				Email:     StringPtr("john.updated@example.com"),
+				Role:      "manager",
+				Status:    entity.StatusActive,
+			},
 			setupMocks: func(mockDB *MockDB) {
-				rows := NewMockRows([][]interface{}{
-					{uint64(1), "John", "Doe", "john@example.com", "employee", uint64(30)},
+				countRow := NewMockRow([]interface{}{0}, nil, EmployeeFieldDescriptions)
+				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
+					return query == "SELECT COUNT(*) FROM employees WHERE (email = $1 OR (personal_number IS NOT NULL AND personal_number = $2)) AND id != $3"
+				}), mock.Anything, mock.Anything, uint64(1)).Return(countRow)
+
+				updateRows := NewMockRows([][]interface{}{
+					{uint64(1), "John", "Doe", "john.updated@example.com", "manager"},
 				}, nil, EmployeeFieldDescriptions)
 				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), uint64(5), mock.AnythingOfType("time.Time"), uint64(1)).Return(rows, nil)
+				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1), uint64(1)).Return(updateRows, nil)
 			},
 			expectError: false,
 		},
 		{
-			name:          "invalid vacation days - zero",
-			employeeID:    1,
-			days:          0,
+			name:       "missing required fields",
+			employeeID: 1,
+			employee: entity.Employee{
+				FirstName: "",
+				LastName:  "Doe",
+				Email:     StringPtr("john@example.com"),
+			},
 			setupMocks:    func(mockDB *MockDB) {},
 			expectError:   true,
-			errorContains: "invalid vacation days",
+			errorContains: "invalid employee data",
 		},
 		{
-			name:       "employee not found",
-			employeeID: 999,
-			days:       5,
-			setupMocks: func(mockDB *MockDB) {
-				rows := NewMockRows(nil, pgx.ErrNoRows, EmployeeFieldDescriptions)
-				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
-					return query[:6] == "UPDATE"
-				}), uint64(5), mock.AnythingOfType("time.Time"), uint64(999)).Return(rows, nil)
+			name:       "password is not editable",
+			employeeID: 1,
+			employee: entity.Employee{
+				FirstName: "John",
+				LastName:  "Doe",
+				Email:     StringPtr("john@example.com"),
+				Password:  StringPtr("N3w-Secret-Passw0rd"),
 			},
+			setupMocks:    func(mockDB *MockDB) {},
 			expectError:   true,
-			errorContains: "employee not found",
+			errorContains: ErrPasswordNotEditable.Error(),
 		},
 		{
-			name:       "database update error",
+			name:       "update database error",
 			employeeID: 1,
-			days:       5,
-			setupMocks: func(mockDB *MockDB) {
-				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
-					return query[:6] == "UPDATE"
-				}), uint64(5), mock.AnythingOfType("time.Time"), uint64(1)).Return((*MockRows)(nil), errors.New("update error"))
+			employee: entity.Employee{
+				FirstName: "John",
+				LastName:  "Doe",
+				Email:     StringPtr("john@example.com"),
+				Role:      entity.RoleEmployee,
+				Status:    entity.StatusActive,
 			},
-			expectError: true,
-		},
-		{
-			name:       "collection error",
-			employeeID: 1,
-			days:       5,
 			setupMocks: func(mockDB *MockDB) {
-				rows := NewMockRows(nil, errors.New("collection error"), EmployeeFieldDescriptions)
+				countRow := NewMockRow([]interface{}{0}, nil, EmployeeFieldDescriptions)
+				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
+					return query == "SELECT COUNT(*) FROM employees WHERE (email = $1 OR (personal_number IS NOT NULL AND personal_number = $2)) AND id != $3"
+				}), mock.Anything, mock.Anything, uint64(1)).Return(countRow)
+
 				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), uint64(5), mock.AnythingOfType("time.Time"), uint64(1)).Return(rows, nil)
+				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1), uint64(1)).Return((*MockRows)(nil), errors.New("update error"))
 			},
 			expectError: true,
 		},
@@ -541,7 +758,7 @@ func TestEmployeeController_RequestVacation(t *testing.T) {
 			tt.setupMocks(mockDB)
 
 			controller := NewEmployeeController(deps)
-			result, err := controller.RequestVacation(tt.employeeID, tt.days)
+			result, err := controller.UpdateEmployee(adminUser, tt.employeeID, tt.employee, 1)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -552,7 +769,6 @@ func TestEmployeeController_RequestVacation(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
-				assert.Nil(t, result.Password)
 			}
 
 			mockDB.AssertExpectations(t)
@@ -560,149 +776,421 @@ func TestEmployeeController_RequestVacation(t *testing.T) {
 	}
 }
 
-func TestEmployeeController_getPasswordHash(t *testing.T) {
+func TestEmployeeController_UpdateEmployee_RoleStatus(t *testing.T) {
+	employee := func(role, status string) entity.Employee {
+		return entity.Employee{FirstName: "Jane", LastName: "Doe", Email: StringPtr("jane@example.com"), Role: role, Status: status}
+	}
+
+	t.Run("unknown role", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		_, err := controller.UpdateEmployee(adminUser, 4, employee("owner", entity.StatusActive), 1)
+		assert.ErrorIs(t, err, ErrInvalidEmployee)
+		mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		_, err := controller.UpdateEmployee(adminUser, 4, employee(entity.RoleEmployee, "retired"), 1)
+		assert.ErrorIs(t, err, ErrInvalidEmployee)
+
+		_, err = controller.UpdateEmployee(adminUser, 4, employee(entity.RoleEmployee, ""), 1)
+		assert.ErrorIs(t, err, ErrInvalidEmployee)
+		mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+	})
+
+	t.Run("sick without sick leave", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT status FROM employees WHERE id = $1", uint64(4)).
+			Return(NewMockRow([]interface{}{entity.StatusActive}, nil, nil))
+
+		_, err := controller.UpdateEmployee(adminUser, 4, employee(entity.RoleEmployee, entity.StatusSick), 1)
+		assert.ErrorIs(t, err, ErrInvalidEmployee)
+		mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+	})
+
+	t.Run("sick employee keeps the status", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT status FROM employees WHERE id = $1", uint64(4)).
+			Return(NewMockRow([]interface{}{entity.StatusSick}, nil, nil))
+		mockDB.On("QueryRow", mock.Anything, queryPrefix("SELECT COUNT(*) FROM employees"), mock.Anything, mock.Anything, uint64(4)).
+			Return(NewMockRow([]interface{}{0}, nil, nil))
+
+		row := employeeListRow(4, "Doe", time.Now())
+		row[6] = entity.StatusSick
+		updateArgs := []interface{}{mock.Anything, queryPrefix("UPDATE employees")}
+		for range 19 {
+			updateArgs = append(updateArgs, mock.Anything)
+		}
+		mockDB.On("Query", updateArgs...).Return(NewMockRows([][]interface{}{row}, nil, EmployeeFieldDescriptions), nil)
+
+		result, err := controller.UpdateEmployee(adminUser, 4, employee(entity.RoleEmployee, entity.StatusSick), 1)
+		require.NoError(t, err)
+		assert.Equal(t, entity.StatusSick, result.Status)
+	})
+}
+
+func TestEmployeeController_UpdateEmployee_RevokesSessions(t *testing.T) {
 	tests := []struct {
-		name        string
-		newPassword *string
-		employeeID  uint64
-		setupMocks  func(*MockDB)
-		expectError bool
+		name     string
+		status   string
+		isActive bool
+		revoked  bool
 	}{
+		{name: "fired", status: entity.StatusFired, isActive: true, revoked: true},
+		{name: "suspended", status: entity.StatusSuspended, isActive: true, revoked: true},
+		{name: "deactivated", status: entity.StatusActive, isActive: false, revoked: true},
+		{name: "active", status: entity.StatusActive, isActive: true, revoked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			controller := NewEmployeeController(CreateTestDependencies(mockDB, mockRedis))
+
+			mockDB.On("QueryRow", mock.Anything, queryPrefix("SELECT COUNT(*) FROM employees"), mock.Anything, mock.Anything, uint64(4)).
+				Return(NewMockRow([]interface{}{0}, nil, nil))
+
+			row := make([]interface{}, len(EmployeeFieldDescriptions))
+			row[0], row[1], row[2], row[3], row[5], row[6], row[17] = uint64(4), "Jane", "Doe", "jane@example.com", "employee", tt.status, tt.isActive
+			updateArgs := []interface{}{mock.Anything, queryPrefix("UPDATE employees")}
+			for range 22 {
+				updateArgs = append(updateArgs, mock.Anything)
+			}
+			mockDB.On("Query", updateArgs...).Return(NewMockRows([][]interface{}{row}, nil, EmployeeFieldDescriptions), nil)
+
+			if tt.revoked {
+				mockRedis.On("SMembers", mock.Anything, "user_sessions:4").Return([]string{})
+				mockRedis.On("Del", mock.Anything, []string{"user_sessions:4"}).Return(int64(0))
+			}
+
+			result, err := controller.UpdateEmployee(adminUser, 4, entity.Employee{
+				FirstName: "Jane",
+				LastName:  "Doe",
+				Email:     StringPtr("jane@example.com"),
+				Role:      entity.RoleEmployee,
+				Status:    tt.status,
+				IsActive:  &tt.isActive,
+			}, 1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, result.Status)
+
+			mockRedis.AssertExpectations(t)
+			if !tt.revoked {
+				mockRedis.AssertNotCalled(t, "SMembers", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestEmployeeController_PatchEmployee(t *testing.T) {
+	hired := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("changes only the given fields", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything,
+			queryPrefix("UPDATE employees SET middle_name = $1, phone = $2, updated_at = $3, version = version + 1 WHERE id = $4 AND version = $5 RETURNING"),
+			(*string)(nil), StringPtr("+7 900 000-00-00"), mock.AnythingOfType("time.Time"), uint64(5), uint64(1),
+		).Return(NewMockRows([][]interface{}{employeeListRow(5, "Doe", hired)}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		emp, err := controller.PatchEmployee(adminUser, 5, entity.EmployeePatch{
+			Fields:   []string{"middle_name", "phone", "phone"},
+			Employee: entity.Employee{Phone: StringPtr("+7 900 000-00-00")},
+		}, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "Doe", emp.LastName)
+		assert.Equal(t, uint64(2), emp.Version)
+		assert.Nil(t, emp.Password)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("fired employee loses sessions", func(t *testing.T) {
+		mockDB := &MockDB{}
+		mockRedis := &MockRedis{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, mockRedis))
+
+		row := employeeListRow(5, "Doe", hired)
+		row[6] = entity.StatusFired
+		mockDB.On("Query", mock.Anything, queryPrefix("UPDATE employees SET status = $1, updated_at = $2, version = version + 1 WHERE id = $3 AND version = $4"),
+			entity.StatusFired, mock.Anything, uint64(5), uint64(1),
+		).Return(NewMockRows([][]interface{}{row}, nil, EmployeeFieldDescriptions), nil).Once()
+		mockRedis.On("SMembers", mock.Anything, "user_sessions:5").Return([]string{})
+		mockRedis.On("Del", mock.Anything, []string{"user_sessions:5"}).Return(int64(0))
+
+		_, err := controller.PatchEmployee(adminUser, 5, entity.EmployeePatch{
+			Fields:   []string{"status"},
+			Employee: entity.Employee{Status: entity.StatusFired},
+		}, 1)
+		require.NoError(t, err)
+		mockRedis.AssertExpectations(t)
+	})
+
+	t.Run("email taken", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, queryPrefix("SELECT COUNT(*) FROM employees"),
+			StringPtr("taken@example.com"), (*string)(nil), uint64(5),
+		).Return(NewMockRow([]interface{}{1}, nil, nil))
+
+		_, err := controller.PatchEmployee(adminUser, 5, entity.EmployeePatch{
+			Fields:   []string{"email"},
+			Employee: entity.Employee{Email: StringPtr("taken@example.com")},
+		}, 1)
+		assert.ErrorIs(t, err, ErrEmployeeConflict)
+		mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, queryPrefix("UPDATE employees"), "Jane", mock.Anything, uint64(9), uint64(1)).
+			Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil)
+		mockDB.On("QueryRow", mock.Anything, "SELECT version FROM employees WHERE id = $1", uint64(9)).
+			Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+
+		_, err := controller.PatchEmployee(adminUser, 9, entity.EmployeePatch{
+			Fields:   []string{"first_name"},
+			Employee: entity.Employee{FirstName: "Jane"},
+		}, 1)
+		assert.ErrorIs(t, err, ErrEmployeeNotFound)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, queryPrefix("UPDATE employees"), "Jane", mock.Anything, uint64(9), uint64(1)).
+			Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil)
+		mockDB.On("QueryRow", mock.Anything, "SELECT version FROM employees WHERE id = $1", uint64(9)).
+			Return(NewMockRow([]interface{}{uint64(3)}, nil, nil))
+
+		_, err := controller.PatchEmployee(adminUser, 9, entity.EmployeePatch{
+			Fields:   []string{"first_name"},
+			Employee: entity.Employee{FirstName: "Jane"},
+		}, 1)
+		assert.ErrorIs(t, err, ErrVersionMismatch)
+	})
+
+	t.Run("version required", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		_, err := controller.PatchEmployee(adminUser, 9, entity.EmployeePatch{
+			Fields:   []string{"first_name"},
+			Employee: entity.Employee{FirstName: "Jane"},
+		}, 0)
+		assert.ErrorIs(t, err, ErrVersionRequired)
+		mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+	})
+
+	invalid := []struct {
+		name  string
+		patch entity.EmployeePatch
+	}{
+		{name: "no fields", patch: entity.EmployeePatch{}},
+		{name: "read-only field", patch: entity.EmployeePatch{Fields: []string{"created_at"}}},
+		{name: "unknown field", patch: entity.EmployeePatch{Fields: []string{"salary"}}},
+		{name: "required field cleared", patch: entity.EmployeePatch{Fields: []string{"hire_date"}}},
+		{name: "empty last name", patch: entity.EmployeePatch{Fields: []string{"last_name"}}},
 		{
-			name:        "new password provided",
-			newPassword: StringPtr("newpassword123"),
-			employeeID:  1,
-			setupMocks:  func(mockDB *MockDB) {},
-			expectError: false,
+			name:  "password",
+			patch: entity.EmployeePatch{Fields: []string{"password"}, Employee: entity.Employee{Password: StringPtr("N3w-Secret-Passw0rd")}},
 		},
 		{
-			name:        "no new password - get existing",
-			newPassword: nil,
-			employeeID:  1,
-			setupMocks: func(mockDB *MockDB) {
-				row := NewMockRow([]interface{}{"existinghash"}, nil, EmployeeFieldDescriptions)
-				mockDB.On("QueryRow", mock.Anything, "SELECT password FROM employees WHERE id = $1", uint64(1)).Return(row)
-			},
-			expectError: false,
+			name:  "sick status",
+			patch: entity.EmployeePatch{Fields: []string{"status"}, Employee: entity.Employee{Status: entity.StatusSick}},
 		},
 		{
-			name:        "no new password - employee not found",
-			newPassword: nil,
-			employeeID:  999,
-			setupMocks: func(mockDB *MockDB) {
-				row := NewMockRow(nil, pgx.ErrNoRows, EmployeeFieldDescriptions)
-				mockDB.On("QueryRow", mock.Anything, "SELECT password FROM employees WHERE id = $1", uint64(999)).Return(row)
-			},
-			expectError: true,
+			name:  "unknown status",
+			patch: entity.EmployeePatch{Fields: []string{"status"}, Employee: entity.Employee{Status: "retired"}},
 		},
 	}
 
-	for _, tt := range tests {
+	for _, tt := range invalid {
 		t.Run(tt.name, func(t *testing.T) {
 			mockDB := &MockDB{}
-			deps := CreateTestDependencies(mockDB, &MockRedis{})
+			controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
 
-			tt.setupMocks(mockDB)
+			_, err := controller.PatchEmployee(adminUser, 5, tt.patch, 1)
+			assert.ErrorIs(t, err, ErrInvalidPatch)
+			mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+		})
+	}
+}
 
-			controller := NewEmployeeController(deps)
-			result, err := controller.getPasswordHash(tt.newPassword, tt.employeeID)
+func TestEmployeeController_PatchOwnProfile(t *testing.T) {
+	t.Run("hr managed field", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
 
-			if tt.expectError {
-				assert.Error(t, err)
-				assert.Equal(t, result, "")
-			} else {
-				assert.NoError(t, err)
-				assert.NotNil(t, result)
-			}
+		_, err := controller.PatchOwnProfile(5, entity.EmployeePatch{
+			Fields:   []string{"phone", "role"},
+			Employee: entity.Employee{Role: entity.RoleAdmin},
+		}, 1)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+	})
 
-			mockDB.AssertExpectations(t)
-		})
-	}
+	t.Run("own contact", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, queryPrefix("UPDATE employees SET address = $1, updated_at = $2, version = version + 1 WHERE id = $3 AND version = $4"),
+			StringPtr("Moscow"), mock.Anything, uint64(5), uint64(1),
+		).Return(NewMockRows([][]interface{}{employeeListRow(5, "Doe", time.Now())}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		_, err := controller.PatchOwnProfile(5, entity.EmployeePatch{
+			Fields:   []string{"address"},
+			Employee: entity.Employee{Address: StringPtr("Moscow")},
+		}, 1)
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
 }
 
-func TestEmployeeController_UpdateEmployee(t *testing.T) {
+func TestEmployeeController_AdminRole(t *testing.T) {
+	hr := &entity.Claims{ID: 2, Role: entity.RoleHR}
+
+	t.Run("hr cannot promote to admin", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		_, err := controller.PatchEmployee(hr, 2, entity.EmployeePatch{
+			Fields:   []string{"role"},
+			Employee: entity.Employee{Role: entity.RoleAdmin},
+		}, 1)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = controller.UpdateEmployee(hr, 5, entity.Employee{
+			FirstName: "John",
+			LastName:  "Doe",
+			Email:     StringPtr("john@example.com"),
+			Role:      entity.RoleAdmin,
+		}, 1)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+	})
+
+	t.Run("hr cannot edit admin", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT role FROM employees WHERE id = $1", uint64(1)).
+			Return(NewMockRow([]interface{}{entity.RoleAdmin}, nil, nil))
+
+		_, err := controller.PatchEmployee(hr, 1, entity.EmployeePatch{
+			Fields:   []string{"email"},
+			Employee: entity.Employee{Email: StringPtr("hr@example.com")},
+		}, 1)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = controller.UpdateEmployee(hr, 1, entity.Employee{
+			FirstName: "John",
+			LastName:  "Doe",
+			Email:     StringPtr("hr@example.com"),
+			Role:      entity.RoleEmployee,
+		}, 1)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		mockDB.AssertNotCalled(t, "Query", mock.Anything, mock.Anything)
+	})
+
+	t.Run("hr edits employee", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT role FROM employees WHERE id = $1", uint64(5)).
+			Return(NewMockRow([]interface{}{entity.RoleEmployee}, nil, nil))
+		mockDB.On("Query", mock.Anything, queryPrefix("UPDATE employees SET role = $1,"), entity.RoleManager, mock.Anything, uint64(5), uint64(1)).
+			Return(NewMockRows([][]interface{}{employeeListRow(5, "Doe", time.Now())}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		_, err := controller.PatchEmployee(hr, 5, entity.EmployeePatch{
+			Fields:   []string{"role"},
+			Employee: entity.Employee{Role: entity.RoleManager},
+		}, 1)
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("admin demotes admin", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, queryPrefix("UPDATE employees SET role = $1,"), entity.RoleHR, mock.Anything, uint64(3), uint64(1)).
+			Return(NewMockRows([][]interface{}{employeeListRow(3, "Doe", time.Now())}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		_, err := controller.PatchEmployee(adminUser, 3, entity.EmployeePatch{
+			Fields:   []string{"role"},
+			Employee: entity.Employee{Role: entity.RoleHR},
+		}, 1)
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestEmployeeController_DeleteEmployee(t *testing.T) {
 	tests := []struct {
 		name          string
 		employeeID    uint64
-		employee      entity.Employee
-		setupMocks    func(*MockDB)
+		setupMocks    func(*MockDB, *MockRedis)
 		expectError   bool
 		errorContains string
 	}{
 		{
-			name:       "successful update",
+			name:       "successful termination",
 			employeeID: 1,
-			employee: entity.Employee{
-				FirstName: "John",
-				LastName:  "Doe",
-				Email:     StringPtr("john.updated@example.com"),
-				Role:      "manager",
-			},
-			setupMocks: func(mockDB *MockDB) {
-				passwordRow := NewMockRow([]interface{}{"existinghash"}, nil, EmployeeFieldDescriptions)
-				mockDB.On("QueryRow", mock.Anything, "SELECT password FROM employees WHERE id = $1", uint64(1)).Return(passwordRow)
-
-				countRow := NewMockRow([]interface{}{0}, nil, EmployeeFieldDescriptions)
-				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
-					return query == "SELECT COUNT(*) FROM employees WHERE (email = $1 OR (personal_number IS NOT NULL AND personal_number = $2)) AND id != $3"
-				}), mock.Anything, mock.Anything, uint64(1)).Return(countRow)
-
-				updateRows := NewMockRows([][]interface{}{
-					{uint64(1), "John", "Doe", "john.updated@example.com", "manager"},
-				}, nil, EmployeeFieldDescriptions)
-				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
-					return query[:6] == "UPDATE"
-				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1)).Return(updateRows, nil)
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				commandTag := NewMockCommandTag(1)
+				mockDB.On("Exec", mock.Anything, queryContains("UPDATE employees", "SET status = $1, is_active = false", "WHERE id = $3 AND version = $4"), entity.StatusFired, mock.Anything, uint64(1), uint64(1)).Return(commandTag, nil)
+				mockRedis.On("SMembers", mock.Anything, "user_sessions:1").Return([]string{"s1"})
+				mockRedis.On("Get", mock.Anything, "session:s1").Return(redis.NewStringResult(`{"id":"s1","user_id":1,"access_token":"a1","refresh_token":"r1"}`, nil))
+				mockRedis.On("Del", mock.Anything, []string{"access_token:a1", "refresh_token:r1", "session:s1"}).Return(int64(3))
+				mockRedis.On("SRem", mock.Anything, "user_sessions:1", []interface{}{"s1"}).Return(nil)
+				mockRedis.On("Del", mock.Anything, []string{"user_sessions:1"}).Return(int64(1))
 			},
 			expectError: false,
 		},
 		{
-			name:       "missing required fields",
-			employeeID: 1,
-			employee: entity.Employee{
-				FirstName: "",
-				LastName:  "Doe",
-				Email:     StringPtr("john@example.com"),
+			name:       "employee not found",
+			employeeID: 999,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				commandTag := NewMockCommandTag(0)
+				mockDB.On("Exec", mock.Anything, queryContains("UPDATE employees", "SET status = $1, is_active = false", "WHERE id = $3 AND version = $4"), entity.StatusFired, mock.Anything, uint64(999), uint64(1)).Return(commandTag, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM employees WHERE id = $1", uint64(999)).
+					Return(NewMockRow(nil, pgx.ErrNoRows, nil))
 			},
-			setupMocks:    func(mockDB *MockDB) {},
 			expectError:   true,
-			errorContains: "invalid employee data",
+			errorContains: "employee not found",
 		},
 		{
-			name:       "get password hash error - employee not found",
-			employeeID: 999,
-			employee: entity.Employee{
-				FirstName: "John",
-				LastName:  "Doe",
-				Email:     StringPtr("john@example.com"),
-			},
-			setupMocks: func(mockDB *MockDB) {
-				passwordRow := NewMockRow(nil, pgx.ErrNoRows, EmployeeFieldDescriptions)
-				mockDB.On("QueryRow", mock.Anything, "SELECT password FROM employees WHERE id = $1", uint64(999)).Return(passwordRow)
+			name:       "stale version",
+			employeeID: 1,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				commandTag := NewMockCommandTag(0)
+				mockDB.On("Exec", mock.Anything, queryContains("UPDATE employees", "SET status = $1, is_active = false", "WHERE id = $3 AND version = $4"), entity.StatusFired, mock.Anything, uint64(1), uint64(1)).Return(commandTag, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM employees WHERE id = $1", uint64(1)).
+					Return(NewMockRow([]interface{}{uint64(2)}, nil, nil))
 			},
 			expectError:   true,
-			errorContains: "employee not found",
+			errorContains: ErrVersionMismatch.Error(),
 		},
 		{
-			name:       "update database error",
+			name:       "database error",
 			employeeID: 1,
-			employee: entity.Employee{
-				FirstName: "John",
-				LastName:  "Doe",
-				Email:     StringPtr("john@example.com"),
-			},
-			setupMocks: func(mockDB *MockDB) {
-				passwordRow := NewMockRow([]interface{}{"existinghash"}, nil, EmployeeFieldDescriptions)
-				mockDB.On("QueryRow", mock.Anything, "SELECT password FROM employees WHERE id = $1", uint64(1)).Return(passwordRow)
-
-				countRow := NewMockRow([]interface{}{0}, nil, EmployeeFieldDescriptions)
-				mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
-					return query == "SELECT COUNT(*) FROM employees WHERE (email = $1 OR (personal_number IS NOT NULL AND personal_number = $2)) AND id != $3"
-				}), mock.Anything, mock.Anything, uint64(1)).Return(countRow)
-
-				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
-					return query[:6] == "UPDATE"
-				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1)).Return((*MockRows)(nil), errors.New("update error"))
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				commandTag := NewMockCommandTag(0)
+				mockDB.On("Exec", mock.Anything, queryContains("UPDATE employees", "SET status = $1, is_active = false", "WHERE id = $3 AND version = $4"), entity.StatusFired, mock.Anything, uint64(1), uint64(1)).Return(commandTag, errors.New("db error"))
 			},
 			expectError: true,
 		},
@@ -711,89 +1199,141 @@ func TestEmployeeController_UpdateEmployee(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockDB := &MockDB{}
-			deps := CreateTestDependencies(mockDB, &MockRedis{})
+			mockRedis := &MockRedis{}
+			deps := CreateTestDependencies(mockDB, mockRedis)
 
-			tt.setupMocks(mockDB)
+			tt.setupMocks(mockDB, mockRedis)
 
 			controller := NewEmployeeController(deps)
-			result, err := controller.UpdateEmployee(tt.employeeID, tt.employee)
+			err := controller.DeleteEmployee(tt.employeeID, 1)
 
 			if tt.expectError {
 				assert.Error(t, err)
 				if tt.errorContains != "" {
 					assert.Contains(t, err.Error(), tt.errorContains)
 				}
-				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, result)
 			}
 
 			mockDB.AssertExpectations(t)
+			mockRedis.AssertExpectations(t)
 		})
 	}
 }
 
-func TestEmployeeController_DeleteEmployee(t *testing.T) {
+func TestEmployeeController_RestoreEmployee(t *testing.T) {
+	hired := time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("fired employee is rehired", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything,
+			queryContains("SET status = $1, is_active = true, fire_date = NULL", "WHERE id = $3 AND status = $4"),
+			entity.StatusActive, mock.Anything, uint64(1), entity.StatusFired,
+		).Return(NewMockRows([][]interface{}{employeeListRow(1, "Doe", hired)}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		employee, err := controller.RestoreEmployee(1)
+		require.NoError(t, err)
+		assert.Equal(t, entity.StatusActive, employee.Status)
+		assert.Nil(t, employee.Password)
+		mockDB.AssertExpectations(t)
+	})
+
 	tests := []struct {
-		name          string
-		employeeID    uint64
-		setupMocks    func(*MockDB)
-		expectError   bool
-		errorContains string
+		name        string
+		statusRow   *MockRow
+		expectedErr error
+	}{
+		{name: "employee not found", statusRow: NewMockRow(nil, pgx.ErrNoRows, nil), expectedErr: ErrEmployeeNotFound},
+		{name: "employee not fired", statusRow: NewMockRow([]interface{}{entity.StatusActive}, nil, nil), expectedErr: ErrEmployeeNotFired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+			mockDB.On("Query", mock.Anything, queryContains("UPDATE employees"), mock.Anything, mock.Anything, uint64(1), mock.Anything).
+				Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil).Once()
+			mockDB.On("QueryRow", mock.Anything, "SELECT status FROM employees WHERE id = $1", uint64(1)).Return(tt.statusRow).Once()
+
+			employee, err := controller.RestoreEmployee(1)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, employee)
+			mockDB.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEmployeeController_PurgeEmployee(t *testing.T) {
+	const purgeQuery = "DELETE FROM employees WHERE id = $1 AND version = $2 AND status = $3"
+
+	t.Run("fired employee is erased", func(t *testing.T) {
+		mockDB := &MockDB{}
+		mockRedis := &MockRedis{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, mockRedis))
+
+		mockDB.On("Exec", mock.Anything, purgeQuery, uint64(1), uint64(3), entity.StatusFired).Return(NewMockCommandTag(1), nil).Once()
+		mockRedis.On("SMembers", mock.Anything, "user_sessions:1").Return([]string{})
+		mockRedis.On("Del", mock.Anything, []string{"user_sessions:1"}).Return(int64(0))
+
+		require.NoError(t, controller.PurgeEmployee(1, 3))
+		mockDB.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*MockDB)
+		expectedErr error
 	}{
 		{
-			name:       "successful delete",
-			employeeID: 1,
+			name: "employee not fired",
 			setupMocks: func(mockDB *MockDB) {
-				commandTag := NewMockCommandTag(1)
-				mockDB.On("Exec", mock.Anything, "DELETE FROM employees WHERE id = $1", uint64(1)).Return(commandTag, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT status FROM employees WHERE id = $1", uint64(1)).
+					Return(NewMockRow([]interface{}{entity.StatusActive}, nil, nil))
 			},
-			expectError: false,
+			expectedErr: ErrEmployeeNotFired,
 		},
 		{
-			name:       "employee not found",
-			employeeID: 999,
+			name: "employee not found",
 			setupMocks: func(mockDB *MockDB) {
-				commandTag := NewMockCommandTag(0)
-				mockDB.On("Exec", mock.Anything, "DELETE FROM employees WHERE id = $1", uint64(999)).Return(commandTag, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT status FROM employees WHERE id = $1", uint64(1)).
+					Return(NewMockRow(nil, pgx.ErrNoRows, nil))
 			},
-			expectError:   true,
-			errorContains: "employee not found",
+			expectedErr: ErrEmployeeNotFound,
 		},
 		{
-			name:       "database error",
-			employeeID: 1,
+			name: "stale version",
 			setupMocks: func(mockDB *MockDB) {
-				commandTag := NewMockCommandTag(0)
-				mockDB.On("Exec", mock.Anything, "DELETE FROM employees WHERE id = $1", uint64(1)).Return(commandTag, errors.New("db error"))
+				mockDB.On("QueryRow", mock.Anything, "SELECT status FROM employees WHERE id = $1", uint64(1)).
+					Return(NewMockRow([]interface{}{entity.StatusFired}, nil, nil))
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM employees WHERE id = $1", uint64(1)).
+					Return(NewMockRow([]interface{}{uint64(4)}, nil, nil))
 			},
-			expectError: true,
+			expectedErr: ErrVersionMismatch,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockDB := &MockDB{}
-			deps := CreateTestDependencies(mockDB, &MockRedis{})
+			controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
 
+			mockDB.On("Exec", mock.Anything, purgeQuery, uint64(1), uint64(3), entity.StatusFired).Return(NewMockCommandTag(0), nil).Once()
 			tt.setupMocks(mockDB)
 
-			controller := NewEmployeeController(deps)
-			err := controller.DeleteEmployee(tt.employeeID)
-
-			if tt.expectError {
-				assert.Error(t, err)
-				if tt.errorContains != "" {
-					assert.Contains(t, err.Error(), tt.errorContains)
-				}
-			} else {
-				assert.NoError(t, err)
-			}
-
+			assert.ErrorIs(t, controller.PurgeEmployee(1, 3), tt.expectedErr)
 			mockDB.AssertExpectations(t)
 		})
 	}
+
+	t.Run("version required", func(t *testing.T) {
+		controller := NewEmployeeController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		assert.ErrorIs(t, controller.PurgeEmployee(1, 0), ErrVersionRequired)
+	})
 }
 
 func TestEmployeeController_updateEmployeeInDB(t *testing.T) {
@@ -819,7 +1359,7 @@ func TestEmployeeController_updateEmployeeInDB(t *testing.T) {
 				}, nil, EmployeeFieldDescriptions)
 				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1)).Return(updateRows, nil)
+				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1), uint64(1)).Return(updateRows, nil)
 			},
 			expectError: false,
 		},
@@ -834,7 +1374,7 @@ func TestEmployeeController_updateEmployeeInDB(t *testing.T) {
 			setupMocks: func(mockDB *MockDB) {
 				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1)).Return((*MockRows)(nil), errors.New("query error"))
+				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1), uint64(1)).Return((*MockRows)(nil), errors.New("query error"))
 			},
 			expectError:   true,
 			errorContains: "failed to update employee",
@@ -851,11 +1391,31 @@ func TestEmployeeController_updateEmployeeInDB(t *testing.T) {
 				updateRows := NewMockRows(nil, pgx.ErrNoRows, EmployeeFieldDescriptions)
 				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
 					return query[:6] == "UPDATE"
-				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(999)).Return(updateRows, nil)
+				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(999), uint64(1)).Return(updateRows, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM employees WHERE id = $1", uint64(999)).
+					Return(NewMockRow(nil, pgx.ErrNoRows, nil))
 			},
 			expectError:   true,
 			errorContains: "employee not found",
 		},
+		{
+			name: "stale version",
+			employee: &entity.Employee{
+				FirstName: "John",
+				LastName:  "Doe",
+				Email:     StringPtr("john@example.com"),
+			},
+			employeeID: 1,
+			setupMocks: func(mockDB *MockDB) {
+				mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+					return query[:6] == "UPDATE"
+				}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1), uint64(1)).Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT version FROM employees WHERE id = $1", uint64(1)).
+					Return(NewMockRow([]interface{}{uint64(4)}, nil, nil))
+			},
+			expectError:   true,
+			errorContains: ErrVersionMismatch.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -866,7 +1426,7 @@ func TestEmployeeController_updateEmployeeInDB(t *testing.T) {
 			tt.setupMocks(mockDB)
 
 			controller := NewEmployeeController(deps)
-			result, err := controller.updateEmployeeInDB(tt.employee, tt.employeeID)
+			result, err := controller.updateEmployeeInDB(tt.employee, tt.employeeID, 1)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -893,3 +1453,48 @@ func TestNewEmployeeController(t *testing.T) {
 	assert.NotNil(t, controller)
 	assert.Equal(t, deps, controller.deps)
 }
+
+func TestEmployeeController_UpdateOwnProfile(t *testing.T) {
+	mockDB := &MockDB{}
+	deps := CreateTestDependencies(mockDB, &MockRedis{})
+
+	now := time.Now()
+	currentRows := NewMockRows([][]interface{}{
+		{
+			Uint64Ptr(3), "John", "Doe", StringPtr("john@example.com"), nil, "employee", "active",
+			Uint64Ptr(1), nil, StringPtr("Developer"), nil, nil, nil, nil, nil, TimePtr(now), nil,
+			BoolPtr(true), Uint64Ptr(28), Uint64Ptr(0), TimePtr(now), TimePtr(now),
+		},
+	}, nil, EmployeeFieldDescriptions)
+	mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+		return query[:6] == "SELECT"
+	}), uint64(3)).Return(currentRows, nil)
+
+	countRow := NewMockRow([]interface{}{0}, nil, EmployeeFieldDescriptions)
+	mockDB.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
+		return query[:6] == "SELECT"
+	}), mock.Anything, mock.Anything, uint64(3)).Return(countRow)
+
+	updateRows := NewMockRows([][]interface{}{
+		{uint64(3), "Johnny", "Doe", "john@example.com", "employee"},
+	}, nil, EmployeeFieldDescriptions)
+	mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+		return query[:6] == "UPDATE"
+	}), "Johnny", "Doe", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		"employee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, "active", mock.Anything, uint64(3), uint64(1),
+	).Return(updateRows, nil)
+
+	controller := NewEmployeeController(deps)
+	result, err := controller.UpdateOwnProfile(3, entity.Employee{
+		FirstName: "Johnny",
+		LastName:  "Doe",
+		Email:     StringPtr("john@example.com"),
+		Role:      "admin",
+		Status:    "fired",
+	}, 1)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	mockDB.AssertExpectations(t)
+}
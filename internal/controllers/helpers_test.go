@@ -30,6 +30,13 @@ type RedisInterface interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
 	Get(ctx context.Context, key string) *redis.StringCmd
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	Incr(ctx context.Context, key string) *redis.IntCmd
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.BoolCmd
+	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+	TTL(ctx context.Context, key string) *redis.DurationCmd
+	SAdd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+	SRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+	SMembers(ctx context.Context, key string) *redis.StringSliceCmd
 	Close() error
 }
 
@@ -105,6 +112,7 @@ func NewMockRow(data []interface{}, err error, fieldDescs []pgconn.FieldDescript
 			{Name: "sick_days", DataTypeOID: 20},
 			{Name: "created_at", DataTypeOID: 1114},
 			{Name: "updated_at", DataTypeOID: 1114},
+			{Name: "version", DataTypeOID: 20},
 		}
 	}
 	return &MockRow{
@@ -138,6 +146,14 @@ func (m *MockRow) Scan(dest ...interface{}) error {
 				if v, ok := val.(int64); ok {
 					*d = v
 				}
+			case *float64:
+				if v, ok := val.(float64); ok {
+					*d = v
+				}
+			case *int:
+				if v, ok := val.(int); ok {
+					*d = v
+				}
 			case *string:
 				if v, ok := val.(string); ok {
 					*d = v
@@ -172,6 +188,18 @@ func (m *MockRow) Scan(dest ...interface{}) error {
 				if v, ok := val.(*bool); ok {
 					*d = v
 				}
+			case **int64:
+				if v, ok := val.(*int64); ok {
+					*d = v
+				}
+			case *[]string:
+				if v, ok := val.([]string); ok {
+					*d = v
+				}
+			case *[]time.Time:
+				if v, ok := val.([]time.Time); ok {
+					*d = v
+				}
 			case *interface{}:
 				*d = val
 			}
@@ -214,6 +242,7 @@ func NewMockRows(rows [][]interface{}, err error, fieldDescs []pgconn.FieldDescr
 			{Name: "sick_days", DataTypeOID: 20},
 			{Name: "created_at", DataTypeOID: 1114},
 			{Name: "updated_at", DataTypeOID: 1114},
+			{Name: "version", DataTypeOID: 20},
 		}
 	}
 	return &MockRows{
@@ -260,6 +289,10 @@ func (m *MockRows) Scan(dest ...interface{}) error {
 				if v, ok := val.(int64); ok {
 					*d = v
 				}
+			case *float64:
+				if v, ok := val.(float64); ok {
+					*d = v
+				}
 			case *string:
 				if v, ok := val.(string); ok {
 					*d = v
@@ -268,6 +301,29 @@ func (m *MockRows) Scan(dest ...interface{}) error {
 				if v, ok := val.(time.Time); ok {
 					*d = v
 				}
+			case **bool:
+				if v, ok := val.(bool); ok {
+					*d = &v
+				}
+				if v, ok := val.(*bool); ok {
+					*d = v
+				}
+			case **uint64:
+				if v, ok := val.(*uint64); ok {
+					*d = v
+				}
+			case **int64:
+				if v, ok := val.(*int64); ok {
+					*d = v
+				}
+			case **string:
+				if v, ok := val.(*string); ok {
+					*d = v
+				}
+			case **time.Time:
+				if v, ok := val.(*time.Time); ok {
+					*d = v
+				}
 			case *interface{}:
 				switch val := val.(type) {
 				case uint64:
@@ -339,6 +395,23 @@ func (m *MockRedis) Set(ctx context.Context, key string, value interface{}, expi
 	return cmd
 }
 
+// SetNX returns the bool given to Return, true (key set) when it is nil.
+func (m *MockRedis) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.BoolCmd {
+	args := m.Called(ctx, key, value, expiration)
+
+	cmd := redis.NewBoolCmd(ctx)
+	switch val := args.Get(0).(type) {
+	case bool:
+		cmd.SetVal(val)
+	case error:
+		cmd.SetErr(val)
+	default:
+		cmd.SetVal(true)
+	}
+
+	return cmd
+}
+
 func (m *MockRedis) Get(ctx context.Context, key string) *redis.StringCmd {
 	args := m.Called(ctx, key)
 
@@ -383,6 +456,91 @@ func (m *MockRedis) Del(ctx context.Context, keys ...string) *redis.IntCmd {
 	return cmd
 }
 
+func (m *MockRedis) Incr(ctx context.Context, key string) *redis.IntCmd {
+	args := m.Called(ctx, key)
+
+	cmd := redis.NewIntCmd(ctx)
+	switch val := args.Get(0).(type) {
+	case int64:
+		cmd.SetVal(val)
+	case error:
+		cmd.SetErr(val)
+	}
+
+	return cmd
+}
+
+func (m *MockRedis) Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd {
+	args := m.Called(ctx, key, expiration)
+
+	cmd := redis.NewBoolCmd(ctx)
+	if err, ok := args.Get(0).(error); ok {
+		cmd.SetErr(err)
+	} else {
+		cmd.SetVal(true)
+	}
+
+	return cmd
+}
+
+// TTL returns the duration given to Return, -2ns (missing key) when it is nil.
+func (m *MockRedis) TTL(ctx context.Context, key string) *redis.DurationCmd {
+	args := m.Called(ctx, key)
+
+	cmd := redis.NewDurationCmd(ctx, time.Second)
+	switch val := args.Get(0).(type) {
+	case time.Duration:
+		cmd.SetVal(val)
+	case error:
+		cmd.SetErr(val)
+	default:
+		cmd.SetVal(-2)
+	}
+
+	return cmd
+}
+
+func (m *MockRedis) SAdd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd {
+	args := m.Called(ctx, key, members)
+
+	if intCmd, ok := args.Get(0).(*redis.IntCmd); ok {
+		return intCmd
+	}
+
+	cmd := redis.NewIntCmd(ctx)
+	cmd.SetVal(int64(len(members)))
+
+	return cmd
+}
+
+func (m *MockRedis) SRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd {
+	args := m.Called(ctx, key, members)
+
+	if intCmd, ok := args.Get(0).(*redis.IntCmd); ok {
+		return intCmd
+	}
+
+	cmd := redis.NewIntCmd(ctx)
+	cmd.SetVal(int64(len(members)))
+
+	return cmd
+}
+
+func (m *MockRedis) SMembers(ctx context.Context, key string) *redis.StringSliceCmd {
+	args := m.Called(ctx, key)
+
+	if sliceCmd, ok := args.Get(0).(*redis.StringSliceCmd); ok {
+		return sliceCmd
+	}
+
+	cmd := redis.NewStringSliceCmd(ctx)
+	if members, ok := args.Get(0).([]string); ok {
+		cmd.SetVal(members)
+	}
+
+	return cmd
+}
+
 func (m *MockRedis) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -428,14 +586,20 @@ func CreateTestDependencies(mockDB DBInterface, mockRedis RedisInterface) *Depen
 
 	cfg := &config.Config{}
 	cfg.Server.JWTSecret = "test-secret-key"
+	cfg.Idempotency.Secret = "test-idempotency-secret"
 	cfg.Redis.AccessTokenTTL = time.Hour
 	cfg.Redis.RefreshTokenTTL = time.Hour * 24
 
+	keys, _ := NewKeyManager(cfg, logger)
+	passwords, _ := NewPasswordManager(cfg, logger)
+
 	return &Dependens{
-		DB:     mockDB,
-		Redis:  mockRedis,
-		Logger: logger,
-		Config: cfg,
+		DB:        mockDB,
+		Redis:     mockRedis,
+		Keys:      keys,
+		Passwords: passwords,
+		Logger:    logger,
+		Config:    cfg,
 	}
 }
 
@@ -492,6 +656,14 @@ func Uint64Ptr(u uint64) *uint64 {
 	return &u
 }
 
+func IntPtr(i int) *int {
+	return &i
+}
+
+func Int64Ptr(i int64) *int64 {
+	return &i
+}
+
 func BoolPtr(b bool) *bool {
 	return &b
 }
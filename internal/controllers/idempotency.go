@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"unicode"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	DefaultIdempotencyTTL     = 24 * time.Hour
+	DefaultIdempotencyLockTTL = time.Minute
+
+	MaxIdempotencyKeyLength = 255
+)
+
+var (
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was used with a different request")
+	ErrIdempotencyInProgress = errors.New("request with the same idempotency key is in progress")
+)
+
+// IdempotentRequest identifies a create operation retried with the same
+// Idempotency-Key. Payload is the request, retries must send the same one.
+type IdempotentRequest struct {
+	Operation Operation
+	Caller    *entity.Claims
+	Key       string
+	Payload   any
+}
+
+// idempotencyRecord is stored under idempotency:<operation>:<caller>:<key>.
+// Result is empty while the first request is still running.
+type idempotencyRecord struct {
+	Fingerprint string          `json:"fingerprint"`
+	Result      json.RawMessage `json:"result,omitempty"`
+}
+
+// IdempotencyController remembers the result of create operations by the
+// Idempotency-Key of the caller, so that a retried request does not create the
+// record twice. Keys of different callers and operations do not clash.
+type IdempotencyController struct {
+	deps *Dependens
+}
+
+func NewIdempotencyController(deps *Dependens) *IdempotencyController {
+	return &IdempotencyController{
+		deps: deps,
+	}
+}
+
+// Idempotent calls create once per idempotency key. A retry with the same key
+// and payload gets the stored result back with replayed set, until the ttl of
+// the [idempotency] config passes. A failed create is not stored, so it can be
+// retried. Without a key create is just called.
+func Idempotent[T any](ctx context.Context, c *IdempotencyController, req IdempotentRequest, create func() (*T, error)) (*T, bool, error) {
+	if req.Key == "" {
+		result, err := create()
+		return result, false, err
+	}
+
+	if err := validateIdempotencyKey(req.Key); err != nil {
+		return nil, false, err
+	}
+
+	fingerprint, err := requestFingerprint(c.deps.Config.Idempotency.Secret, req.Payload)
+	if err != nil {
+		c.deps.Logger.Error("Error fingerprinting request", slog.String("error", err.Error()))
+		return nil, false, err
+	}
+
+	key := idempotencyKey(req)
+
+	pending, err := json.Marshal(idempotencyRecord{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, false, err
+	}
+
+	lockTTL := valueOrDefault(c.deps.Config.Idempotency.LockTTL, DefaultIdempotencyLockTTL)
+
+	acquired, err := c.deps.Redis.SetNX(ctx, key, pending, lockTTL).Result()
+	if err != nil {
+		c.deps.Logger.Error("Error reserving idempotency key", slog.String("error", err.Error()))
+		return nil, false, err
+	}
+
+	if !acquired {
+		result, replayErr := replayIdempotent[T](ctx, c, key, fingerprint)
+		return result, replayErr == nil, replayErr
+	}
+
+	result, err := create()
+	if err != nil {
+		if delErr := c.deps.Redis.Del(ctx, key).Err(); delErr != nil {
+			c.deps.Logger.Error("Error releasing idempotency key", slog.String("error", delErr.Error()))
+		}
+
+		return nil, false, err
+	}
+
+	// The record is created at this point, so failing to remember the result
+	// only loses the replay and is not reported to the caller.
+	data, err := json.Marshal(replayResult(result))
+	if err == nil {
+		data, err = json.Marshal(idempotencyRecord{Fingerprint: fingerprint, Result: data})
+	}
+
+	if err == nil {
+		ttl := valueOrDefault(c.deps.Config.Idempotency.TTL, DefaultIdempotencyTTL)
+		err = c.deps.Redis.Set(ctx, key, data, ttl).Err()
+	}
+
+	if err != nil {
+		c.deps.Logger.Error("Error storing idempotent result", slog.String("error", err.Error()), slog.String("operation", string(req.Operation)))
+	}
+
+	return result, false, nil
+}
+
+func replayIdempotent[T any](ctx context.Context, c *IdempotencyController, key, fingerprint string) (*T, error) {
+	data, err := c.deps.Redis.Get(ctx, key).Bytes()
+	if err != nil {
+		// The reservation of a request that did not finish has just expired.
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrIdempotencyInProgress
+		}
+
+		c.deps.Logger.Error("Error getting idempotent result", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var record idempotencyRecord
+	if err = json.Unmarshal(data, &record); err != nil {
+		c.deps.Logger.Error("Error unmarshaling idempotent result", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if record.Fingerprint != fingerprint {
+		c.deps.Logger.Warn("Idempotency key reused with a different request", slog.String("key", key))
+		return nil, ErrIdempotencyKeyReused
+	}
+
+	if len(record.Result) == 0 {
+		return nil, ErrIdempotencyInProgress
+	}
+
+	var result T
+	if err = json.Unmarshal(record.Result, &result); err != nil {
+		c.deps.Logger.Error("Error unmarshaling idempotent result", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	c.deps.Logger.Info("Idempotent result replayed", slog.String("key", key))
+
+	return &result, nil
+}
+
+// replayResult returns the part of the result that is stored for replays. The
+// temporary password of a created employee is shown only in the first response
+// and is not kept in Redis.
+func replayResult(result any) any {
+	if employee, ok := result.(*entity.Employee); ok {
+		redacted := *employee
+		redacted.Password = nil
+
+		return &redacted
+	}
+
+	return result
+}
+
+func validateIdempotencyKey(key string) error {
+	if len(key) > MaxIdempotencyKeyLength {
+		return fmt.Errorf("%w: longer than %d characters", ErrInvalidIdempotencyKey, MaxIdempotencyKeyLength)
+	}
+
+	for _, r := range key {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return fmt.Errorf("%w: only printable ASCII characters are allowed", ErrInvalidIdempotencyKey)
+		}
+	}
+
+	return nil
+}
+
+// requestFingerprint hashes the decoded request, so retries that differ only
+// in the formatting of the body are still identical. The hash is keyed with
+// idempotency.secret, as the request may carry a password.
+func requestFingerprint(secret string, payload any) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func idempotencyKey(req IdempotentRequest) string {
+	caller := "anonymous"
+	if req.Caller != nil {
+		caller = fmt.Sprintf("user:%d", req.Caller.ID)
+		if req.Caller.APIKeyID != 0 {
+			caller = fmt.Sprintf("api_key:%d", req.Caller.APIKeyID)
+		}
+	}
+
+	return fmt.Sprintf("idempotency:%s:%s:%s", req.Operation, caller, req.Key)
+}
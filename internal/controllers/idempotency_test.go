@@ -0,0 +1,205 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotent(t *testing.T) {
+	const key = "idempotency:CreateDepartment:user:1:retry-1"
+
+	dept := entity.Department{Name: "Бухгалтерия"}
+	req := IdempotentRequest{
+		Operation: OpCreateDepartment,
+		Caller:    &entity.Claims{ID: 1},
+		Key:       "retry-1",
+		Payload:   dept,
+	}
+
+	storedRecord := func(t *testing.T, deps *Dependens, payload any, result *entity.Department) string {
+		fingerprint, err := requestFingerprint(deps.Config.Idempotency.Secret, payload)
+		require.NoError(t, err)
+
+		record := idempotencyRecord{Fingerprint: fingerprint}
+		if result != nil {
+			record.Result, err = json.Marshal(result)
+			require.NoError(t, err)
+		}
+
+		data, err := json.Marshal(record)
+		require.NoError(t, err)
+
+		return string(data)
+	}
+
+	t.Run("first request is executed and stored", func(t *testing.T) {
+		mockRedis := &MockRedis{}
+		controller := NewIdempotencyController(CreateTestDependencies(&MockDB{}, mockRedis))
+
+		mockRedis.On("SetNX", mock.Anything, key, mock.Anything, DefaultIdempotencyLockTTL).Return(true).Once()
+		mockRedis.On("Set", mock.Anything, key, mock.Anything, DefaultIdempotencyTTL).Return("OK").Once()
+
+		calls := 0
+		result, replayed, err := Idempotent(context.Background(), controller, req, func() (*entity.Department, error) {
+			calls++
+			return &entity.Department{ID: 5, Name: dept.Name, Version: 1}, nil
+		})
+		require.NoError(t, err)
+		assert.False(t, replayed)
+		assert.Equal(t, uint64(5), result.ID)
+		assert.Equal(t, 1, calls)
+		mockRedis.AssertExpectations(t)
+	})
+
+	t.Run("retry is replayed", func(t *testing.T) {
+		mockRedis := &MockRedis{}
+		deps := CreateTestDependencies(&MockDB{}, mockRedis)
+		controller := NewIdempotencyController(deps)
+
+		stored := storedRecord(t, deps, dept, &entity.Department{ID: 5, Name: dept.Name, Version: 1})
+		mockRedis.On("SetNX", mock.Anything, key, mock.Anything, DefaultIdempotencyLockTTL).Return(false).Once()
+		mockRedis.On("Get", mock.Anything, key).Return(redis.NewStringResult(stored, nil)).Once()
+
+		result, replayed, err := Idempotent(context.Background(), controller, req, func() (*entity.Department, error) {
+			t.Fatal("create must not be called on replay")
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.True(t, replayed)
+		assert.Equal(t, uint64(5), result.ID)
+		mockRedis.AssertExpectations(t)
+	})
+
+	t.Run("key reused with a different payload", func(t *testing.T) {
+		mockRedis := &MockRedis{}
+		deps := CreateTestDependencies(&MockDB{}, mockRedis)
+		controller := NewIdempotencyController(deps)
+
+		stored := storedRecord(t, deps, entity.Department{Name: "Склад"}, &entity.Department{ID: 4})
+		mockRedis.On("SetNX", mock.Anything, key, mock.Anything, DefaultIdempotencyLockTTL).Return(false).Once()
+		mockRedis.On("Get", mock.Anything, key).Return(redis.NewStringResult(stored, nil)).Once()
+
+		_, _, err := Idempotent(context.Background(), controller, req, func() (*entity.Department, error) {
+			t.Fatal("create must not be called for a reused key")
+			return nil, nil
+		})
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	})
+
+	t.Run("first request still in progress", func(t *testing.T) {
+		mockRedis := &MockRedis{}
+		deps := CreateTestDependencies(&MockDB{}, mockRedis)
+		controller := NewIdempotencyController(deps)
+
+		stored := storedRecord(t, deps, dept, nil)
+		mockRedis.On("SetNX", mock.Anything, key, mock.Anything, DefaultIdempotencyLockTTL).Return(false).Once()
+		mockRedis.On("Get", mock.Anything, key).Return(redis.NewStringResult(stored, nil)).Once()
+
+		_, _, err := Idempotent(context.Background(), controller, req, func() (*entity.Department, error) {
+			t.Fatal("create must not be called while the key is held")
+			return nil, nil
+		})
+		assert.ErrorIs(t, err, ErrIdempotencyInProgress)
+	})
+
+	t.Run("failed create releases the key", func(t *testing.T) {
+		mockRedis := &MockRedis{}
+		controller := NewIdempotencyController(CreateTestDependencies(&MockDB{}, mockRedis))
+
+		createErr := errors.New("database is down")
+		mockRedis.On("SetNX", mock.Anything, key, mock.Anything, DefaultIdempotencyLockTTL).Return(true).Once()
+		mockRedis.On("Del", mock.Anything, []string{key}).Return(int64(1)).Once()
+
+		_, replayed, err := Idempotent(context.Background(), controller, req, func() (*entity.Department, error) {
+			return nil, createErr
+		})
+		assert.ErrorIs(t, err, createErr)
+		assert.False(t, replayed)
+		mockRedis.AssertExpectations(t)
+	})
+
+	t.Run("api keys and users do not share keys", func(t *testing.T) {
+		userKey := idempotencyKey(req)
+
+		apiKeyReq := req
+		apiKeyReq.Caller = &entity.Claims{APIKeyID: 1}
+
+		assert.Equal(t, key, userKey)
+		assert.Equal(t, "idempotency:CreateDepartment:api_key:1:retry-1", idempotencyKey(apiKeyReq))
+	})
+
+	t.Run("without key create is called directly", func(t *testing.T) {
+		controller := NewIdempotencyController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		noKey := req
+		noKey.Key = ""
+
+		result, replayed, err := Idempotent(context.Background(), controller, noKey, func() (*entity.Department, error) {
+			return &entity.Department{ID: 6}, nil
+		})
+		require.NoError(t, err)
+		assert.False(t, replayed)
+		assert.Equal(t, uint64(6), result.ID)
+	})
+
+	t.Run("temporary password is not stored for replays", func(t *testing.T) {
+		mockRedis := &MockRedis{}
+		controller := NewIdempotencyController(CreateTestDependencies(&MockDB{}, mockRedis))
+
+		employeeReq := IdempotentRequest{
+			Operation: OpCreateEmployee,
+			Caller:    &entity.Claims{ID: 1},
+			Key:       "retry-1",
+			Payload:   entity.Employee{Email: StringPtr("ivan@example.com")},
+		}
+		employeeKey := "idempotency:CreateEmployee:user:1:retry-1"
+
+		mockRedis.On("SetNX", mock.Anything, employeeKey, mock.Anything, DefaultIdempotencyLockTTL).Return(true).Once()
+		mockRedis.On("Set", mock.Anything, employeeKey, mock.MatchedBy(func(value any) bool {
+			var record idempotencyRecord
+			if err := json.Unmarshal(value.([]byte), &record); err != nil {
+				return false
+			}
+
+			var stored entity.Employee
+			if err := json.Unmarshal(record.Result, &stored); err != nil {
+				return false
+			}
+
+			return stored.Password == nil && stored.ID != nil && *stored.ID == 7
+		}), DefaultIdempotencyTTL).Return("OK").Once()
+
+		result, replayed, err := Idempotent(context.Background(), controller, employeeReq, func() (*entity.Employee, error) {
+			return &entity.Employee{ID: Uint64Ptr(7), Password: StringPtr("Temp-Password-1")}, nil
+		})
+		require.NoError(t, err)
+		assert.False(t, replayed)
+		require.NotNil(t, result.Password)
+		assert.Equal(t, "Temp-Password-1", *result.Password)
+		mockRedis.AssertExpectations(t)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		controller := NewIdempotencyController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		for _, invalid := range []string{strings.Repeat("k", MaxIdempotencyKeyLength+1), "ключ", "line\nbreak"} {
+			badKey := req
+			badKey.Key = invalid
+
+			_, _, err := Idempotent(context.Background(), controller, badKey, func() (*entity.Department, error) {
+				t.Fatal("create must not be called for an invalid key")
+				return nil, nil
+			})
+			assert.ErrorIs(t, err, ErrInvalidIdempotencyKey)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+const DefaultImpersonationTTL = 15 * time.Minute
+
+var (
+	ErrImpersonationNotAllowed = fmt.Errorf("%w: employee cannot be impersonated", ErrPermissionDenied)
+
+	// ErrImpersonationRestricted is returned for the operations that change the
+	// credentials or sessions of the impersonated employee.
+	ErrImpersonationRestricted = fmt.Errorf("%w: not allowed while impersonating", ErrPermissionDenied)
+)
+
+// Impersonate issues a short-lived access token of the employee to the admin.
+// The token carries the admin as ActorID, cannot be refreshed, and every
+// request made with it is written to the audit trail. Admins cannot be
+// impersonated.
+func (c *AuthController) Impersonate(user *entity.Claims, employeeID uint64) (*entity.ImpersonationToken, error) {
+	if employeeID == user.ID {
+		return nil, ErrImpersonationNotAllowed
+	}
+
+	ctx := context.Background()
+
+	var email, role string
+	if err := c.deps.DB.QueryRow(ctx, "SELECT email, role FROM employees WHERE id = $1", employeeID).Scan(&email, &role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Employee not found", slog.Any("id", employeeID))
+			return nil, ErrEmployeeNotFound
+		}
+
+		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if role == entity.RoleAdmin {
+		c.deps.Logger.Warn("Impersonation of admin denied", slog.Any("actor_id", user.ID), slog.Any("user_id", employeeID))
+		return nil, ErrImpersonationNotAllowed
+	}
+
+	if err := c.audit.Record(ctx, entity.AuditEntry{
+		ActorID:    user.ID,
+		EmployeeID: &employeeID,
+		Action:     string(OpImpersonate),
+		Allowed:    true,
+	}); err != nil {
+		return nil, err
+	}
+
+	sessionID, err := generateTokenID(c.deps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := valueOrDefault(c.deps.Config.Impersonation.TokenTTL, DefaultImpersonationTTL)
+	now := time.Now()
+	claims := entity.Claims{
+		ID:      employeeID,
+		Email:   email,
+		Role:    role,
+		TokenID: sessionID,
+		Type:    TokenTypeAccess,
+		ActorID: user.ID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+
+	accessToken, err := c.deps.Keys.Sign(claims)
+	if err != nil {
+		c.deps.Logger.Error("Error signing impersonation token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err = c.deps.Redis.Set(ctx, "access_token:"+accessToken, "valid", ttl).Err(); err != nil {
+		c.deps.Logger.Error("Error setting access token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	// The session lets the admin end the impersonation with a logout and shows
+	// it to the employee among their sessions.
+	if err = c.sessions.Save(ctx, &entity.Session{
+		ID:          sessionID,
+		UserID:      employeeID,
+		AccessToken: accessToken,
+		CreatedAt:   now,
+		RefreshedAt: now,
+		ActorID:     user.ID,
+	}); err != nil {
+		return nil, err
+	}
+
+	c.deps.Logger.Info("Impersonation started", slog.Any("actor_id", user.ID), slog.Any("user_id", employeeID), slog.String("session_id", sessionID))
+
+	return &entity.ImpersonationToken{
+		AccessToken: accessToken,
+		ExpiresAt:   now.Add(ttl),
+	}, nil
+}
+
+// auditImpersonated logs and records a request made with an impersonation token.
+// The request is refused if it cannot be recorded.
+func (c *AuthController) auditImpersonated(user *entity.Claims, op Operation, targetID *uint64, authErr error) error {
+	c.deps.Logger.Info("Impersonated request",
+		slog.Any("operation", op),
+		slog.Any("user_id", user.ID),
+		slog.Any("actor_id", user.ActorID),
+		slog.Bool("allowed", authErr == nil),
+	)
+
+	employeeID := user.ID
+	if err := c.audit.Record(context.Background(), entity.AuditEntry{
+		ActorID:    user.ActorID,
+		EmployeeID: &employeeID,
+		Action:     string(op),
+		TargetID:   targetID,
+		Allowed:    authErr == nil,
+	}); err != nil && authErr == nil {
+		return err
+	}
+
+	return authErr
+}
@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthController_Impersonate(t *testing.T) {
+	admin := &entity.Claims{ID: 1, Email: "admin@example.com", Role: entity.RoleAdmin}
+
+	t.Run("issues token with actor", func(t *testing.T) {
+		mockDB := &MockDB{}
+		mockRedis := &MockRedis{}
+		controller := NewAuthController(CreateTestDependencies(mockDB, mockRedis))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT email, role FROM employees WHERE id = $1", uint64(5)).
+			Return(NewMockRow([]interface{}{"user@example.com", entity.RoleEmployee}, nil, nil))
+		mockDB.On("Exec", mock.Anything, queryPrefix("INSERT INTO audit_log"),
+			uint64(1), mock.Anything, string(OpImpersonate), (*uint64)(nil), true, mock.Anything,
+		).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+		mockRedis.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRedis.On("SAdd", mock.Anything, "user_sessions:5", mock.Anything).Return(nil)
+
+		token, err := controller.Impersonate(admin, 5)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(DefaultImpersonationTTL), token.ExpiresAt, time.Minute)
+
+		claims, err := controller.parseToken(token.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), claims.ID)
+		assert.Equal(t, uint64(1), claims.ActorID)
+		assert.Equal(t, TokenTypeAccess, claims.Type)
+		mockDB.AssertExpectations(t)
+		mockRedis.AssertNotCalled(t, "Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "refresh_token:")
+		}), mock.Anything, mock.Anything)
+	})
+
+	t.Run("admin cannot be impersonated", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewAuthController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT email, role FROM employees WHERE id = $1", uint64(2)).
+			Return(NewMockRow([]interface{}{"other-admin@example.com", entity.RoleAdmin}, nil, nil))
+
+		_, err := controller.Impersonate(admin, 2)
+		assert.ErrorIs(t, err, ErrImpersonationNotAllowed)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("self", func(t *testing.T) {
+		controller := NewAuthController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.Impersonate(admin, 1)
+		assert.ErrorIs(t, err, ErrImpersonationNotAllowed)
+	})
+
+	t.Run("unknown employee", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewAuthController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT email, role FROM employees WHERE id = $1", uint64(9)).
+			Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+
+		_, err := controller.Impersonate(admin, 9)
+		assert.ErrorIs(t, err, ErrEmployeeNotFound)
+	})
+
+	t.Run("no token without audit record", func(t *testing.T) {
+		mockDB := &MockDB{}
+		mockRedis := &MockRedis{}
+		controller := NewAuthController(CreateTestDependencies(mockDB, mockRedis))
+
+		mockDB.On("QueryRow", mock.Anything, "SELECT email, role FROM employees WHERE id = $1", uint64(5)).
+			Return(NewMockRow([]interface{}{"user@example.com", entity.RoleEmployee}, nil, nil))
+		mockDB.On("Exec", mock.Anything, queryPrefix("INSERT INTO audit_log"), mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		).Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		_, err := controller.Impersonate(admin, 5)
+		require.Error(t, err)
+		mockRedis.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthController_Authorize_Impersonated(t *testing.T) {
+	target := uint64(5)
+
+	tests := []struct {
+		name        string
+		op          Operation
+		targetID    *uint64
+		allowed     bool
+		expectedErr error
+	}{
+		{
+			name:     "reads own profile",
+			op:       OpGetEmployeeByID,
+			targetID: &target,
+			allowed:  true,
+		},
+		{
+			name:        "cannot change password",
+			op:          OpChangePassword,
+			expectedErr: ErrImpersonationRestricted,
+		},
+		{
+			name:        "cannot disable mfa",
+			op:          OpDisableMFA,
+			expectedErr: ErrImpersonationRestricted,
+		},
+		{
+			name:        "cannot revoke sessions",
+			op:          OpRevokeAllSessions,
+			expectedErr: ErrImpersonationRestricted,
+		},
+		{
+			name:        "role rules still apply",
+			op:          OpCreateEmployee,
+			expectedErr: ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			controller := NewAuthController(CreateTestDependencies(mockDB, &MockRedis{}))
+			user := &entity.Claims{ID: 5, Role: entity.RoleEmployee, ActorID: 1}
+
+			mockDB.On("Exec", mock.Anything, queryPrefix("INSERT INTO audit_log"),
+				uint64(1), mock.MatchedBy(func(id *uint64) bool { return id != nil && *id == 5 }),
+				string(tt.op), tt.targetID, tt.allowed, mock.Anything,
+			).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+
+			err := controller.Authorize(user, tt.op, tt.targetID)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockDB.AssertExpectations(t)
+		})
+	}
+
+	t.Run("refused when the audit record fails", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewAuthController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Exec", mock.Anything, queryPrefix("INSERT INTO audit_log"), mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		).Return(pgconn.CommandTag{}, errors.New("db down"))
+
+		err := controller.Authorize(&entity.Claims{ID: 5, Role: entity.RoleEmployee, ActorID: 1}, OpGetEmployeeByID, &target)
+		assert.Error(t, err)
+	})
+
+	t.Run("regular tokens are not audited", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewAuthController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		require.NoError(t, controller.Authorize(&entity.Claims{ID: 5, Role: entity.RoleEmployee}, OpGetEmployeeByID, &target))
+		mockDB.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
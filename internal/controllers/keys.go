@@ -0,0 +1,385 @@
+package controllers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/config"
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	KeyCheckInterval = time.Minute
+	RSAKeySize       = 2048
+
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+var (
+	ErrUnknownKey         = errors.New("unknown signing key")
+	ErrUnsupportedKeyType = errors.New("unsupported signing key type")
+)
+
+// SigningKey is a private key used to sign tokens, identified by the kid header.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	CreatedAt time.Time
+}
+
+// KeyManager signs tokens with the active key and verifies them with every key
+// that may still have unexpired tokens. Keys are PKCS#8 PEM files named <kid>.pem
+// in jwt.keys_dir. Without keys_dir tokens are signed with HS256 and server.jwt_secret.
+type KeyManager struct {
+	cfg    *config.Config
+	logger *slog.Logger
+
+	mu     sync.RWMutex
+	keys   map[string]*SigningKey
+	active *SigningKey
+}
+
+func NewKeyManager(cfg *config.Config, logger *slog.Logger) (*KeyManager, error) {
+	m := &KeyManager{
+		cfg:    cfg,
+		logger: logger,
+		keys:   make(map[string]*SigningKey),
+	}
+
+	if cfg.JWT.KeysDir == "" {
+		logger.Warn("JWT keys_dir is empty, tokens are signed with HS256")
+		return m, nil
+	}
+
+	if cfg.Server.JWTSecret != "" {
+		logger.Warn("JWT jwt_secret is ignored while keys_dir is set, HS256 tokens are rejected")
+	}
+
+	if err := os.MkdirAll(cfg.JWT.KeysDir, 0o700); err != nil {
+		logger.Error("Error creating keys directory", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+
+	if m.active == nil {
+		if err := m.Rotate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Run rotates the active key every rotation_interval and prunes retired keys
+// until the context is done. Keys are reloaded on every check, so keys rotated
+// by another instance sharing keys_dir are picked up.
+func (m *KeyManager) Run(ctx context.Context) {
+	if m.cfg.JWT.KeysDir == "" {
+		return
+	}
+
+	ticker := time.NewTicker(KeyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.check()
+		}
+	}
+}
+
+func (m *KeyManager) check() {
+	if err := m.Reload(); err != nil {
+		return
+	}
+
+	m.mu.RLock()
+	active := m.active
+	m.mu.RUnlock()
+
+	interval := m.cfg.JWT.RotationInterval
+	if active == nil || (interval > 0 && time.Since(active.CreatedAt) >= interval) {
+		if err := m.Rotate(); err != nil {
+			return
+		}
+	}
+
+	m.Prune()
+}
+
+// Reload reads all keys from keys_dir. The most recently created key becomes active.
+func (m *KeyManager) Reload() error {
+	entries, err := os.ReadDir(m.cfg.JWT.KeysDir)
+	if err != nil {
+		m.logger.Error("Error reading keys directory", slog.String("error", err.Error()))
+		return err
+	}
+
+	keys := make(map[string]*SigningKey, len(entries))
+	var active *SigningKey
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".pem" {
+			continue
+		}
+
+		key, loadErr := m.loadKey(filepath.Join(m.cfg.JWT.KeysDir, entry.Name()))
+		if loadErr != nil {
+			m.logger.Error("Error loading signing key", slog.String("file", entry.Name()), slog.String("error", loadErr.Error()))
+			return loadErr
+		}
+
+		keys[key.ID] = key
+		if active == nil || key.CreatedAt.After(active.CreatedAt) {
+			active = key
+		}
+	}
+
+	m.mu.Lock()
+	m.keys = keys
+	m.active = active
+	m.mu.Unlock()
+
+	return nil
+}
+
+func (m *KeyManager) loadKey(path string) (*SigningKey, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &SigningKey{
+		ID:        strings.TrimSuffix(filepath.Base(path), ".pem"),
+		CreatedAt: info.ModTime(),
+	}
+
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Algorithm = AlgRS256
+		key.Private = private
+	case ed25519.PrivateKey:
+		key.Algorithm = AlgEdDSA
+		key.Private = private
+	default:
+		return nil, ErrUnsupportedKeyType
+	}
+
+	return key, nil
+}
+
+// Rotate generates a new key of the configured algorithm, stores it in keys_dir
+// and makes it active. The previous keys stay available for verification.
+func (m *KeyManager) Rotate() error {
+	id, err := generateTokenID(m.logger)
+	if err != nil {
+		return err
+	}
+
+	var private crypto.Signer
+	if m.cfg.JWT.Algorithm == AlgEdDSA {
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	} else {
+		private, err = rsa.GenerateKey(rand.Reader, RSAKeySize)
+	}
+	if err != nil {
+		m.logger.Error("Error generating signing key", slog.String("error", err.Error()))
+		return err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		m.logger.Error("Error marshaling signing key", slog.String("error", err.Error()))
+		return err
+	}
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err = os.WriteFile(m.keyPath(id), data, 0o600); err != nil {
+		m.logger.Error("Error writing signing key", slog.String("error", err.Error()))
+		return err
+	}
+
+	key, err := m.loadKey(m.keyPath(id))
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.keys[key.ID] = key
+	m.active = key
+	m.mu.Unlock()
+
+	m.logger.Info("JWT signing key rotated", slog.String("kid", key.ID), slog.String("algorithm", key.Algorithm))
+
+	return nil
+}
+
+// Prune removes the retired keys whose tokens have all expired. A key is retired
+// when a newer key is created, and tokens signed before that live at most the
+// longest token TTL.
+func (m *KeyManager) Prune() {
+	ttl := max(m.cfg.Redis.AccessTokenTTL, m.cfg.Redis.RefreshTokenTTL)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, key := range m.keys {
+		if key == m.active {
+			continue
+		}
+
+		retiredAt := m.active.CreatedAt
+		for _, other := range m.keys {
+			if other.CreatedAt.After(key.CreatedAt) && other.CreatedAt.Before(retiredAt) {
+				retiredAt = other.CreatedAt
+			}
+		}
+
+		if time.Since(retiredAt) < ttl {
+			continue
+		}
+
+		if err := os.Remove(m.keyPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			m.logger.Error("Error removing signing key", slog.String("kid", id), slog.String("error", err.Error()))
+			continue
+		}
+
+		delete(m.keys, id)
+		m.logger.Info("JWT signing key removed", slog.String("kid", id))
+	}
+}
+
+// Sign signs the claims with the active key.
+func (m *KeyManager) Sign(claims jwt.Claims) (string, error) {
+	m.mu.RLock()
+	key := m.active
+	m.mu.RUnlock()
+
+	if key == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(m.cfg.Server.JWTSecret))
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.Private)
+}
+
+// Keyfunc returns the verification key selected by the kid header. Tokens
+// without kid are verified with server.jwt_secret only when keys_dir is not
+// set, so a leaked secret cannot forge tokens once the keys are in use.
+func (m *KeyManager) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if m.hs256Enabled() && token.Method.Alg() == jwt.SigningMethodHS256.Alg() {
+			return []byte(m.cfg.Server.JWTSecret), nil
+		}
+
+		return nil, ErrUnknownKey
+	}
+
+	m.mu.RLock()
+	key, ok := m.keys[kid]
+	m.mu.RUnlock()
+
+	if !ok || token.Method.Alg() != key.Algorithm {
+		return nil, ErrUnknownKey
+	}
+
+	return key.Private.Public(), nil
+}
+
+// ValidMethods returns the signing algorithms accepted by Keyfunc.
+func (m *KeyManager) ValidMethods() []string {
+	if m.hs256Enabled() {
+		return []string{jwt.SigningMethodHS256.Alg()}
+	}
+
+	return []string{AlgRS256, AlgEdDSA}
+}
+
+func (m *KeyManager) hs256Enabled() bool {
+	return m.cfg.JWT.KeysDir == "" && m.cfg.Server.JWTSecret != ""
+}
+
+// JWKS returns the public verification keys, newest first.
+func (m *KeyManager) JWKS() entity.JWKS {
+	m.mu.RLock()
+	keys := make([]*SigningKey, 0, len(m.keys))
+	for _, key := range m.keys {
+		keys = append(keys, key)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+
+	jwks := entity.JWKS{Keys: make([]entity.JWK, 0, len(keys))}
+	for _, key := range keys {
+		jwk := entity.JWK{
+			Kid: key.ID,
+			Use: "sig",
+			Alg: key.Algorithm,
+		}
+
+		switch public := key.Private.Public().(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks
+}
+
+func (m *KeyManager) keyPath(id string) string {
+	return filepath.Join(m.cfg.JWT.KeysDir, id+".pem")
+}
@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyManager(t *testing.T, algorithm, secret string) *KeyManager {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Server.JWTSecret = secret
+	cfg.JWT.Algorithm = algorithm
+	cfg.JWT.KeysDir = t.TempDir()
+	cfg.Redis.AccessTokenTTL = time.Hour
+	cfg.Redis.RefreshTokenTTL = time.Hour * 24
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	keys, err := NewKeyManager(cfg, logger)
+	require.NoError(t, err)
+
+	return keys
+}
+
+func parseWithKeys(keys *KeyManager, tokenStr string) (*jwt.Token, error) {
+	return jwt.Parse(tokenStr, keys.Keyfunc, jwt.WithValidMethods(keys.ValidMethods()))
+}
+
+func TestKeyManager_SignAndVerify(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm string
+		kty       string
+	}{
+		{name: "RS256", algorithm: AlgRS256, kty: "RSA"},
+		{name: "EdDSA", algorithm: AlgEdDSA, kty: "OKP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := newTestKeyManager(t, tt.algorithm, "")
+
+			tokenStr, err := keys.Sign(jwt.MapClaims{"sub": "1"})
+			require.NoError(t, err)
+
+			token, err := parseWithKeys(keys, tokenStr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.algorithm, token.Method.Alg())
+			assert.Equal(t, keys.active.ID, token.Header["kid"])
+
+			jwks := keys.JWKS()
+			require.Len(t, jwks.Keys, 1)
+			assert.Equal(t, keys.active.ID, jwks.Keys[0].Kid)
+			assert.Equal(t, tt.kty, jwks.Keys[0].Kty)
+			assert.Equal(t, tt.algorithm, jwks.Keys[0].Alg)
+
+			_, err = os.Stat(filepath.Join(keys.cfg.JWT.KeysDir, keys.active.ID+".pem"))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestKeyManager_HS256Fallback(t *testing.T) {
+	deps := CreateTestDependencies(&MockDB{}, &MockRedis{})
+
+	tokenStr, err := deps.Keys.Sign(jwt.MapClaims{"sub": "1"})
+	require.NoError(t, err)
+
+	token, err := parseWithKeys(deps.Keys, tokenStr)
+	require.NoError(t, err)
+	assert.Equal(t, jwt.SigningMethodHS256.Alg(), token.Method.Alg())
+	assert.Empty(t, deps.Keys.JWKS().Keys)
+}
+
+func TestKeyManager_RejectsHS256WithoutSecret(t *testing.T) {
+	keys := newTestKeyManager(t, AlgRS256, "")
+
+	tokenStr, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "1"}).SignedString([]byte("guessed-secret"))
+	require.NoError(t, err)
+
+	_, err = parseWithKeys(keys, tokenStr)
+	assert.Error(t, err)
+}
+
+func TestKeyManager_RejectsHS256WithKeys(t *testing.T) {
+	keys := newTestKeyManager(t, AlgRS256, "test-secret-key")
+
+	tokenStr, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "1"}).SignedString([]byte("test-secret-key"))
+	require.NoError(t, err)
+
+	_, err = parseWithKeys(keys, tokenStr)
+	assert.Error(t, err)
+
+	tokenStr, err = keys.Sign(jwt.MapClaims{"sub": "1"})
+	require.NoError(t, err)
+
+	_, err = parseWithKeys(keys, tokenStr)
+	assert.NoError(t, err)
+}
+
+func TestKeyManager_Rotate(t *testing.T) {
+	keys := newTestKeyManager(t, AlgEdDSA, "")
+	oldID := keys.active.ID
+
+	oldToken, err := keys.Sign(jwt.MapClaims{"sub": "1"})
+	require.NoError(t, err)
+
+	require.NoError(t, keys.Rotate())
+	assert.NotEqual(t, oldID, keys.active.ID)
+
+	newToken, err := keys.Sign(jwt.MapClaims{"sub": "1"})
+	require.NoError(t, err)
+
+	token, err := parseWithKeys(keys, newToken)
+	require.NoError(t, err)
+	assert.Equal(t, keys.active.ID, token.Header["kid"])
+
+	_, err = parseWithKeys(keys, oldToken)
+	assert.NoError(t, err, "previous key must keep verifying its tokens")
+	assert.Len(t, keys.JWKS().Keys, 2)
+
+	keys.Prune()
+	assert.Len(t, keys.JWKS().Keys, 2, "retired key is kept until its tokens expire")
+}
+
+func TestKeyManager_PruneExpiredKeys(t *testing.T) {
+	keys := newTestKeyManager(t, AlgEdDSA, "")
+	oldID := keys.active.ID
+
+	oldToken, err := keys.Sign(jwt.MapClaims{"sub": "1"})
+	require.NoError(t, err)
+
+	past := time.Now().Add(-72 * time.Hour)
+	require.NoError(t, os.Chtimes(keys.keyPath(oldID), past, past))
+	require.NoError(t, keys.Reload())
+
+	next := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, keys.Rotate())
+	require.NoError(t, os.Chtimes(keys.keyPath(keys.active.ID), next, next))
+	require.NoError(t, keys.Reload())
+
+	keys.Prune()
+
+	assert.Len(t, keys.JWKS().Keys, 1)
+	_, err = os.Stat(keys.keyPath(oldID))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = parseWithKeys(keys, oldToken)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestKeyManager_ReloadSharedKeys(t *testing.T) {
+	keys := newTestKeyManager(t, AlgEdDSA, "")
+
+	other, err := NewKeyManager(keys.cfg, keys.logger)
+	require.NoError(t, err)
+	require.NoError(t, other.Rotate())
+
+	tokenStr, err := other.Sign(jwt.MapClaims{"sub": "1"})
+	require.NoError(t, err)
+
+	_, err = parseWithKeys(keys, tokenStr)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+
+	require.NoError(t, keys.Reload())
+	_, err = parseWithKeys(keys, tokenStr)
+	assert.NoError(t, err)
+	assert.Equal(t, other.active.ID, keys.active.ID)
+}
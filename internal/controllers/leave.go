@@ -0,0 +1,325 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/config"
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	DefaultLeaveAccrualInterval = time.Hour
+	DefaultAnnualLeaveDays      = 28
+)
+
+// leaveEntryColumns are the columns of leave_ledger mapped to entity.LeaveEntry.
+const leaveEntryColumns = `id, employee_id, leave_type, kind, days, effective_date, period, vacation_request_id,
+	comment, created_by, created_at`
+
+// leaveBalanceConstraint is raised by the ledger when an entry would take the
+// balance below zero.
+const leaveBalanceConstraint = "leave_ledger_balance_check"
+
+var (
+	ErrInvalidLeaveAdjustment   = errors.New("invalid leave adjustment")
+	ErrInvalidLeaveFilter       = errors.New("invalid leave filter")
+	ErrInsufficientLeaveBalance = errors.New("not enough leave days")
+)
+
+var leaveTypes = []string{entity.LeaveTypeAnnual}
+
+// LeaveController keeps the leave ledger. Balances are never stored, they are
+// the sum of the accrual, usage, adjustment and expiry entries of the ledger.
+type LeaveController struct {
+	deps *Dependens
+}
+
+func NewLeaveController(deps *Dependens) *LeaveController {
+	return &LeaveController{
+		deps: deps,
+	}
+}
+
+// Run posts accruals and year-end expiry every accrual_interval of the [leave]
+// config until the context is done. Entries are posted once per year, so
+// several instances may run it.
+func (c *LeaveController) Run(ctx context.Context) {
+	interval := valueOrDefault(c.deps.Config.Leave.AccrualInterval, DefaultLeaveAccrualInterval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_ = c.Accrue(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Accrue credits the days of the year of asOf to the employees hired by then
+// and expires what exceeds the carry-over cap at the end of the previous year.
+func (c *LeaveController) Accrue(ctx context.Context, asOf time.Time) error {
+	asOf = dateOnly(asOf)
+	year := asOf.Year()
+
+	for _, leaveType := range leaveTypes {
+		rule := c.leaveRule(leaveType)
+
+		if rule.DaysPerYear > 0 {
+			query := `INSERT INTO leave_ledger (employee_id, leave_type, kind, days, effective_date, period, created_at)
+                      SELECT id, $1, $2, ROUND($3::numeric * (make_date($4, 12, 31) - start_date + 1) / (make_date($4, 12, 31) - make_date($4, 1, 1) + 1), 2),
+                             start_date, $4, $6
+                      FROM (SELECT id, GREATEST(COALESCE(hire_date, created_at)::date, make_date($4, 1, 1)) AS start_date
+                            FROM employees WHERE status <> $7) hired
+                      WHERE start_date <= $5
+                      ON CONFLICT (employee_id, leave_type, kind, period) WHERE kind IN ('accrual', 'expiry') DO NOTHING`
+
+			tag, err := c.deps.DB.Exec(ctx, query, leaveType, entity.LeaveEntryAccrual, rule.DaysPerYear, year, asOf, time.Now(), entity.StatusFired)
+			if err != nil {
+				c.deps.Logger.Error("Error accruing leave", slog.String("leave_type", leaveType), slog.String("error", err.Error()))
+				return err
+			}
+
+			if tag.RowsAffected() > 0 {
+				c.deps.Logger.Info("Leave accrued", slog.String("leave_type", leaveType), slog.Int("year", year), slog.Int64("employees", tag.RowsAffected()))
+			}
+		}
+
+		if rule.CarryOverCap != nil {
+			query := `INSERT INTO leave_ledger (employee_id, leave_type, kind, days, effective_date, period, created_at)
+                      SELECT employee_id, $1, $2, $3::numeric - SUM(days), make_date($4, 12, 31), $4, $5
+                      FROM leave_ledger WHERE leave_type = $1 AND effective_date <= make_date($4, 12, 31)
+                      GROUP BY employee_id HAVING SUM(days) > $3::numeric
+                      ON CONFLICT (employee_id, leave_type, kind, period) WHERE kind IN ('accrual', 'expiry') DO NOTHING`
+
+			tag, err := c.deps.DB.Exec(ctx, query, leaveType, entity.LeaveEntryExpiry, *rule.CarryOverCap, year-1, time.Now())
+			if err != nil {
+				c.deps.Logger.Error("Error expiring leave", slog.String("leave_type", leaveType), slog.String("error", err.Error()))
+				return err
+			}
+
+			if tag.RowsAffected() > 0 {
+				c.deps.Logger.Info("Leave expired", slog.String("leave_type", leaveType), slog.Int("year", year-1), slog.Int64("employees", tag.RowsAffected()))
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetLeaveBalance derives the balances of the employee on asOf, today by
+// default, from the ledger entries effective on that date.
+func (c *LeaveController) GetLeaveBalance(employeeID uint64, asOf *time.Time) (*entity.LeaveBalances, error) {
+	date := dateOnly(time.Now())
+	if asOf != nil {
+		date = dateOnly(*asOf)
+	}
+
+	if err := c.checkEmployee(employeeID); err != nil {
+		return nil, err
+	}
+
+	query := `SELECT types.leave_type,
+                     COALESCE(SUM(l.days), 0) AS balance,
+                     COALESCE(SUM(l.days) FILTER (WHERE l.kind = $3), 0) AS accrued,
+                     -COALESCE(SUM(l.days) FILTER (WHERE l.kind = $4), 0) AS used,
+                     COALESCE(SUM(l.days) FILTER (WHERE l.kind = $5), 0) AS adjusted,
+                     -COALESCE(SUM(l.days) FILTER (WHERE l.kind = $6), 0) AS expired
+              FROM unnest($7::varchar[]) AS types (leave_type)
+              LEFT JOIN leave_ledger l ON l.leave_type = types.leave_type AND l.employee_id = $1 AND l.effective_date <= $2
+              GROUP BY types.leave_type
+              ORDER BY types.leave_type`
+
+	rows, err := c.deps.DB.Query(context.Background(), query, employeeID, date, entity.LeaveEntryAccrual,
+		entity.LeaveEntryUsage, entity.LeaveEntryAdjustment, entity.LeaveEntryExpiry, leaveTypes)
+	if err != nil {
+		c.deps.Logger.Error("Error querying leave balance", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	balances, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.LeaveBalance])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &entity.LeaveBalances{EmployeeID: employeeID, AsOf: date, Balances: balances}, nil
+}
+
+// GetLeaveLedger returns the ledger entries of the employee in the order they
+// take effect, each with the balance of its leave type after the entry.
+func (c *LeaveController) GetLeaveLedger(employeeID uint64, params *entity.GetLeaveLedgerParams) ([]entity.LeaveHistoryEntry, error) {
+	if err := c.checkEmployee(employeeID); err != nil {
+		return nil, err
+	}
+
+	// The running balance is summed over the whole ledger, before the filters.
+	// Entries of retired leave types, such as the former sick day counter, stay
+	// in the table as history but are not shown.
+	query := `SELECT * FROM (
+                  SELECT ` + leaveEntryColumns + `,
+                         SUM(days) OVER (PARTITION BY leave_type ORDER BY effective_date, id) AS balance
+                  FROM leave_ledger WHERE employee_id = $1 AND leave_type = ANY($2)
+              ) ledger WHERE 1=1`
+	args := []any{employeeID, leaveTypes}
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		query += " AND " + strings.ReplaceAll(condition, "$n", fmt.Sprintf("$%d", len(args)))
+	}
+
+	if params != nil {
+		if params.LeaveType != nil {
+			if !slices.Contains(leaveTypes, *params.LeaveType) {
+				return nil, fmt.Errorf("%w: leave_type must be one of %s", ErrInvalidLeaveFilter, strings.Join(leaveTypes, ", "))
+			}
+
+			add("leave_type = $n", *params.LeaveType)
+		}
+
+		if params.From != nil && params.To != nil && params.To.Before(*params.From) {
+			return nil, fmt.Errorf("%w: to is before from", ErrInvalidLeaveFilter)
+		}
+
+		if params.From != nil {
+			add("effective_date >= $n", dateOnly(*params.From))
+		}
+
+		if params.To != nil {
+			add("effective_date <= $n", dateOnly(*params.To))
+		}
+	}
+
+	query += " ORDER BY effective_date, id"
+
+	rows, err := c.deps.DB.Query(context.Background(), query, args...)
+	if err != nil {
+		c.deps.Logger.Error("Error querying leave ledger", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.LeaveHistoryEntry])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// AdjustLeave posts a correction of the balance by hand. The comment is
+// required, as it is the only explanation of the entry.
+func (c *LeaveController) AdjustLeave(user *entity.Claims, employeeID uint64, adjustment entity.LeaveAdjustment) (*entity.LeaveEntry, error) {
+	if err := validateLeaveAdjustment(adjustment); err != nil {
+		c.deps.Logger.Warn("Invalid leave adjustment", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err := c.checkEmployee(employeeID); err != nil {
+		return nil, err
+	}
+
+	effectiveDate := dateOnly(time.Now())
+	if adjustment.EffectiveDate != nil {
+		effectiveDate = dateOnly(*adjustment.EffectiveDate)
+	}
+
+	query := `INSERT INTO leave_ledger (employee_id, leave_type, kind, days, effective_date, comment, created_by, created_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+              RETURNING ` + leaveEntryColumns
+
+	rows, err := c.deps.DB.Query(context.Background(), query, employeeID, adjustment.LeaveType, entity.LeaveEntryAdjustment,
+		adjustment.Days, effectiveDate, strings.TrimSpace(adjustment.Comment), actorID(user), time.Now())
+	if err != nil {
+		return nil, c.entryError(err)
+	}
+	defer rows.Close()
+
+	entry, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.LeaveEntry])
+	if err != nil {
+		return nil, c.entryError(err)
+	}
+
+	c.deps.Logger.Info("Leave adjusted",
+		slog.Any("employee_id", employeeID), slog.String("leave_type", entry.LeaveType), slog.Float64("days", entry.Days), slog.Any("user_id", user.ID))
+
+	return &entry, nil
+}
+
+func (c *LeaveController) checkEmployee(employeeID uint64) error {
+	var exists bool
+	if err := c.deps.DB.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM employees WHERE id = $1)", employeeID).
+		Scan(&exists); err != nil {
+		c.deps.Logger.Error("Error checking employee", slog.String("error", err.Error()))
+		return err
+	}
+
+	if !exists {
+		return ErrEmployeeNotFound
+	}
+
+	return nil
+}
+
+func (c *LeaveController) entryError(err error) error {
+	if isLeaveBalanceViolation(err) {
+		c.deps.Logger.Warn("Leave balance would become negative")
+		return ErrInsufficientLeaveBalance
+	}
+
+	c.deps.Logger.Error("Error inserting leave entry", slog.String("error", err.Error()))
+
+	return err
+}
+
+// isLeaveBalanceViolation reports whether the ledger refused an entry that
+// would take the balance below zero.
+func isLeaveBalanceViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == pgCheckViolation && pgErr.ConstraintName == leaveBalanceConstraint
+}
+
+// leaveRule returns the accrual rule of the leave type from the [leave] config.
+func (c *LeaveController) leaveRule(leaveType string) config.LeaveRule {
+	if leaveType != entity.LeaveTypeAnnual {
+		return config.LeaveRule{}
+	}
+
+	rule := c.deps.Config.Leave.Annual
+	rule.DaysPerYear = valueOrDefault(rule.DaysPerYear, DefaultAnnualLeaveDays)
+
+	return rule
+}
+
+func validateLeaveAdjustment(adjustment entity.LeaveAdjustment) error {
+	if !slices.Contains(leaveTypes, adjustment.LeaveType) {
+		return fmt.Errorf("%w: leave_type must be one of %s", ErrInvalidLeaveAdjustment, strings.Join(leaveTypes, ", "))
+	}
+
+	// The ledger keeps hundredths of a day.
+	if math.Round(adjustment.Days*100) == 0 || math.Abs(adjustment.Days) >= 100000 {
+		return fmt.Errorf("%w: days must be a non-zero number of days", ErrInvalidLeaveAdjustment)
+	}
+
+	if strings.TrimSpace(adjustment.Comment) == "" {
+		return fmt.Errorf("%w: comment is required", ErrInvalidLeaveAdjustment)
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var LeaveEntryFieldDescriptions = []pgconn.FieldDescription{
+	{Name: "id", DataTypeOID: 20},                  // int8 (uint64)
+	{Name: "employee_id", DataTypeOID: 20},         // int8 (uint64)
+	{Name: "leave_type", DataTypeOID: 25},          // text (string)
+	{Name: "kind", DataTypeOID: 25},                // text (string)
+	{Name: "days", DataTypeOID: 1700},              // numeric (float64)
+	{Name: "effective_date", DataTypeOID: 1082},    // date
+	{Name: "period", DataTypeOID: 23},              // int4 (int64, nullable)
+	{Name: "vacation_request_id", DataTypeOID: 20}, // int8 (uint64, nullable)
+	{Name: "comment", DataTypeOID: 25},             // text (string, nullable)
+	{Name: "created_by", DataTypeOID: 20},          // int8 (uint64, nullable)
+	{Name: "created_at", DataTypeOID: 1114},        // timestamp
+}
+
+func TestLeaveController_Accrue(t *testing.T) {
+	asOf := time.Date(2027, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	t.Run("annual leave is accrued for the year", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Exec", mock.Anything, queryContains("INSERT INTO leave_ledger", "make_date($4, 1, 1)", "ON CONFLICT"),
+			entity.LeaveTypeAnnual, entity.LeaveEntryAccrual, float64(DefaultAnnualLeaveDays), 2027,
+			time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), mock.Anything, entity.StatusFired,
+		).Return(NewMockCommandTag(12), nil).Once()
+
+		require.NoError(t, controller.Accrue(context.Background(), asOf))
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("balance above the cap expires at the end of the previous year", func(t *testing.T) {
+		mockDB := &MockDB{}
+		deps := CreateTestDependencies(mockDB, &MockRedis{})
+		carryOverCap := 14.0
+		deps.Config.Leave.Annual.CarryOverCap = &carryOverCap
+		controller := NewLeaveController(deps)
+
+		mockDB.On("Exec", mock.Anything, queryContains("make_date($4, 1, 1)"), entity.LeaveTypeAnnual,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		).Return(NewMockCommandTag(0), nil).Once()
+		mockDB.On("Exec", mock.Anything, queryContains("HAVING SUM(days) > $3::numeric"),
+			entity.LeaveTypeAnnual, entity.LeaveEntryExpiry, carryOverCap, 2026, mock.Anything,
+		).Return(NewMockCommandTag(3), nil).Once()
+
+		require.NoError(t, controller.Accrue(context.Background(), asOf))
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestLeaveController_GetLeaveBalance(t *testing.T) {
+	existsQuery := "SELECT EXISTS (SELECT 1 FROM employees WHERE id = $1)"
+	balanceDescs := []pgconn.FieldDescription{
+		{Name: "leave_type", DataTypeOID: 25},
+		{Name: "balance", DataTypeOID: 1700},
+		{Name: "accrued", DataTypeOID: 1700},
+		{Name: "used", DataTypeOID: 1700},
+		{Name: "adjusted", DataTypeOID: 1700},
+		{Name: "expired", DataTypeOID: 1700},
+	}
+
+	t.Run("balances on a date", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		asOf := time.Date(2026, 6, 30, 15, 0, 0, 0, time.UTC)
+		mockDB.On("QueryRow", mock.Anything, existsQuery, uint64(1)).Return(NewMockRow([]interface{}{true}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, queryContains("LEFT JOIN leave_ledger", "l.effective_date <= $2"),
+			uint64(1), time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC), entity.LeaveEntryAccrual, entity.LeaveEntryUsage,
+			entity.LeaveEntryAdjustment, entity.LeaveEntryExpiry, leaveTypes,
+		).Return(NewMockRows([][]interface{}{
+			{entity.LeaveTypeAnnual, float64(30.5), float64(28), float64(5), float64(10), float64(2.5)},
+		}, nil, balanceDescs), nil).Once()
+
+		balances, err := controller.GetLeaveBalance(1, &asOf)
+		require.NoError(t, err)
+		require.Len(t, balances.Balances, 1)
+		assert.Equal(t, 30.5, balances.Balances[0].Balance)
+		assert.Equal(t, float64(5), balances.Balances[0].Used)
+		assert.Equal(t, time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC), balances.AsOf)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, existsQuery, uint64(1)).Return(NewMockRow([]interface{}{false}, nil, nil)).Once()
+
+		_, err := controller.GetLeaveBalance(1, nil)
+		assert.ErrorIs(t, err, ErrEmployeeNotFound)
+	})
+}
+
+func TestLeaveController_GetLeaveLedger(t *testing.T) {
+	existsQuery := "SELECT EXISTS (SELECT 1 FROM employees WHERE id = $1)"
+	historyDescs := append(append([]pgconn.FieldDescription{}, LeaveEntryFieldDescriptions...), pgconn.FieldDescription{Name: "balance", DataTypeOID: 1700})
+
+	t.Run("entries with running balance", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		leaveType := entity.LeaveTypeAnnual
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		now := time.Now()
+
+		mockDB.On("QueryRow", mock.Anything, existsQuery, uint64(1)).Return(NewMockRow([]interface{}{true}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, queryContains("SUM(days) OVER (PARTITION BY leave_type", "leave_type = ANY($2)", "AND leave_type = $3", "AND effective_date >= $4"),
+			uint64(1), leaveTypes, leaveType, from,
+		).Return(NewMockRows([][]interface{}{
+			{uint64(1), uint64(1), leaveType, entity.LeaveEntryAccrual, float64(28), from, Int64Ptr(2026), nil, nil, nil, now, float64(28)},
+			{uint64(2), uint64(1), leaveType, entity.LeaveEntryUsage, float64(-5), now, nil, Uint64Ptr(7), nil, Uint64Ptr(5), now, float64(23)},
+		}, nil, historyDescs), nil).Once()
+
+		entries, err := controller.GetLeaveLedger(1, &entity.GetLeaveLedgerParams{LeaveType: &leaveType, From: &from})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, int64(2026), *entries[0].Period)
+		assert.Equal(t, float64(-5), entries[1].Days)
+		assert.Equal(t, float64(23), entries[1].Balance)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("unknown leave type", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		leaveType := "maternity"
+		mockDB.On("QueryRow", mock.Anything, existsQuery, uint64(1)).Return(NewMockRow([]interface{}{true}, nil, nil)).Once()
+
+		_, err := controller.GetLeaveLedger(1, &entity.GetLeaveLedgerParams{LeaveType: &leaveType})
+		assert.ErrorIs(t, err, ErrInvalidLeaveFilter)
+	})
+}
+
+func TestLeaveController_AdjustLeave(t *testing.T) {
+	existsQuery := "SELECT EXISTS (SELECT 1 FROM employees WHERE id = $1)"
+	hr := &entity.Claims{ID: 5, Role: entity.RoleHR}
+
+	t.Run("adjustment is posted", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		now := time.Now()
+		comment := "Донор крови"
+		mockDB.On("QueryRow", mock.Anything, existsQuery, uint64(1)).Return(NewMockRow([]interface{}{true}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, queryContains("INSERT INTO leave_ledger"),
+			uint64(1), entity.LeaveTypeAnnual, entity.LeaveEntryAdjustment, float64(2), dateOnly(now), comment, Uint64Ptr(5), mock.Anything,
+		).Return(NewMockRows([][]interface{}{
+			{uint64(9), uint64(1), entity.LeaveTypeAnnual, entity.LeaveEntryAdjustment, float64(2), dateOnly(now), nil, nil, &comment, Uint64Ptr(5), now},
+		}, nil, LeaveEntryFieldDescriptions), nil).Once()
+
+		entry, err := controller.AdjustLeave(hr, 1, entity.LeaveAdjustment{LeaveType: entity.LeaveTypeAnnual, Days: 2, Comment: " " + comment + " "})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(9), entry.ID)
+		assert.Equal(t, entity.LeaveEntryAdjustment, entry.Kind)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("balance cannot become negative", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		checkErr := &pgconn.PgError{Code: pgCheckViolation, ConstraintName: leaveBalanceConstraint}
+		mockDB.On("QueryRow", mock.Anything, existsQuery, uint64(1)).Return(NewMockRow([]interface{}{true}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, queryContains("INSERT INTO leave_ledger"), uint64(1), mock.Anything, mock.Anything,
+			float64(-40), mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		).Return(NewMockRows(nil, nil, LeaveEntryFieldDescriptions), checkErr).Once()
+
+		_, err := controller.AdjustLeave(hr, 1, entity.LeaveAdjustment{LeaveType: entity.LeaveTypeAnnual, Days: -40, Comment: "Ошибка начисления"})
+		assert.ErrorIs(t, err, ErrInsufficientLeaveBalance)
+	})
+
+	invalid := []struct {
+		name       string
+		adjustment entity.LeaveAdjustment
+	}{
+		{name: "unknown leave type", adjustment: entity.LeaveAdjustment{LeaveType: "sick", Days: 1, Comment: "Больничный"}},
+		{name: "zero days", adjustment: entity.LeaveAdjustment{LeaveType: entity.LeaveTypeAnnual, Days: 0.001, Comment: "Ничего"}},
+		{name: "without comment", adjustment: entity.LeaveAdjustment{LeaveType: entity.LeaveTypeAnnual, Days: 1, Comment: "  "}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := NewLeaveController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+			_, err := controller.AdjustLeave(hr, 1, tt.adjustment)
+			assert.ErrorIs(t, err, ErrInvalidLeaveAdjustment)
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	LockoutScopeEmail = "email"
+	LockoutScopeIP    = "ip"
+
+	DefaultLoginMaxAttempts     = 5
+	DefaultLoginIPMaxAttempts   = 50
+	DefaultLoginFailureWindow   = 15 * time.Minute
+	DefaultLoginLockoutDuration = 15 * time.Minute
+	DefaultLoginDelayAfter      = 3
+	DefaultLoginBaseDelay       = time.Second
+	DefaultLoginMaxDelay        = 30 * time.Second
+
+	// lockoutsKey is the set of scope:value pairs that may be locked, used to list lockouts.
+	lockoutsKey = "login_lockouts"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrLoginLocked        = errors.New("too many failed login attempts")
+	ErrLockoutNotFound    = errors.New("lockout not found")
+	ErrInvalidLockout     = errors.New("invalid lockout scope")
+)
+
+var (
+	// LoginFailuresTotal counts logins rejected because of wrong credentials.
+	LoginFailuresTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "auth_login_failures_total",
+		Help: "Total number of failed login attempts",
+	})
+
+	// LoginLockoutsTotal counts lockouts imposed after the maximum number of failures.
+	LoginLockoutsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_login_lockouts_total",
+		Help: "Total number of login lockouts",
+	}, []string{"scope"})
+
+	// LoginLockedTotal counts login attempts rejected while a lockout or delay is active.
+	LoginLockedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_login_locked_total",
+		Help: "Total number of login attempts rejected by a lockout",
+	}, []string{"scope"})
+)
+
+// LockedError is returned while the email or the client IP is locked. It wraps
+// ErrLoginLocked and tells when the next attempt is allowed.
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrLoginLocked, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LockedError) Unwrap() error {
+	return ErrLoginLocked
+}
+
+// LoginLimiter counts failed logins per email and per client IP in Redis. After
+// delay_after failures every next attempt has to wait a doubling delay, and after
+// max_attempts the email or IP is locked for lockout_duration. Counters are kept
+// under login_failures:<scope>:<value> and locks under login_lock:<scope>:<value>.
+type LoginLimiter struct {
+	deps *Dependens
+}
+
+func NewLoginLimiter(deps *Dependens) *LoginLimiter {
+	return &LoginLimiter{
+		deps: deps,
+	}
+}
+
+// Check returns a LockedError if the email or the client IP may not try to log in yet.
+func (l *LoginLimiter) Check(ctx context.Context, email, ip string) error {
+	for _, subject := range loginSubjects(email, ip) {
+		scope, value := subject.scope, subject.value
+
+		ttl, err := l.deps.Redis.TTL(ctx, lockKey(scope, value)).Result()
+		if err != nil {
+			l.deps.Logger.Error("Error checking login lockout", slog.String("error", err.Error()))
+			return err
+		}
+
+		if ttl > 0 {
+			l.deps.Logger.Warn("Login locked", slog.String("scope", scope), slog.String("value", value), slog.Duration("retry_after", ttl))
+			LoginLockedTotal.WithLabelValues(scope).Inc()
+			return &LockedError{RetryAfter: ttl}
+		}
+	}
+
+	return nil
+}
+
+// Fail records a failed login and imposes a delay or a lockout when needed.
+func (l *LoginLimiter) Fail(ctx context.Context, email, ip string) {
+	LoginFailuresTotal.Inc()
+
+	cfg := l.deps.Config.Login
+	maxAttempts := map[string]int{
+		LockoutScopeEmail: valueOrDefault(cfg.MaxAttempts, DefaultLoginMaxAttempts),
+		LockoutScopeIP:    valueOrDefault(cfg.IPMaxAttempts, DefaultLoginIPMaxAttempts),
+	}
+
+	for _, subject := range loginSubjects(email, ip) {
+		scope, value := subject.scope, subject.value
+
+		failures, err := l.deps.Redis.Incr(ctx, failuresKey(scope, value)).Result()
+		if err != nil {
+			l.deps.Logger.Error("Error counting failed login", slog.String("error", err.Error()))
+			continue
+		}
+
+		if failures == 1 {
+			window := valueOrDefault(cfg.FailureWindow, DefaultLoginFailureWindow)
+			if err = l.deps.Redis.Expire(ctx, failuresKey(scope, value), window).Err(); err != nil {
+				l.deps.Logger.Error("Error setting failed login window", slog.String("error", err.Error()))
+			}
+		}
+
+		var lock time.Duration
+		if failures >= int64(maxAttempts[scope]) {
+			lock = valueOrDefault(cfg.LockoutDuration, DefaultLoginLockoutDuration)
+			LoginLockoutsTotal.WithLabelValues(scope).Inc()
+			l.deps.Logger.Warn("Login locked out", slog.String("scope", scope), slog.String("value", value), slog.Int64("failures", failures))
+		} else {
+			lock = loginDelay(failures, valueOrDefault(cfg.DelayAfter, DefaultLoginDelayAfter),
+				valueOrDefault(cfg.BaseDelay, DefaultLoginBaseDelay), valueOrDefault(cfg.MaxDelay, DefaultLoginMaxDelay))
+		}
+
+		if lock == 0 {
+			continue
+		}
+
+		if err = l.deps.Redis.Set(ctx, lockKey(scope, value), failures, lock).Err(); err != nil {
+			l.deps.Logger.Error("Error setting login lockout", slog.String("error", err.Error()))
+			continue
+		}
+
+		if err = l.deps.Redis.SAdd(ctx, lockoutsKey, scope+":"+value).Err(); err != nil {
+			l.deps.Logger.Error("Error registering login lockout", slog.String("error", err.Error()))
+		}
+	}
+}
+
+// Succeed forgets the failed attempts of the email after a successful login. The
+// IP counter is kept, so one valid account does not reset attempts on others.
+func (l *LoginLimiter) Succeed(ctx context.Context, email string) {
+	if err := l.deps.Redis.Del(ctx, failuresKey(LockoutScopeEmail, normalizeEmail(email))).Err(); err != nil {
+		l.deps.Logger.Error("Error resetting failed logins", slog.String("error", err.Error()))
+	}
+}
+
+// List returns the active lockouts and delays and forgets the expired ones.
+func (l *LoginLimiter) List(ctx context.Context) ([]entity.Lockout, error) {
+	members, err := l.deps.Redis.SMembers(ctx, lockoutsKey).Result()
+	if err != nil {
+		l.deps.Logger.Error("Error getting login lockouts", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	lockouts := make([]entity.Lockout, 0, len(members))
+	for _, member := range members {
+		scope, value, _ := strings.Cut(member, ":")
+
+		ttl, ttlErr := l.deps.Redis.TTL(ctx, lockKey(scope, value)).Result()
+		if ttlErr != nil {
+			l.deps.Logger.Error("Error checking login lockout", slog.String("error", ttlErr.Error()))
+			return nil, ttlErr
+		}
+
+		if ttl <= 0 {
+			l.deps.Redis.SRem(ctx, lockoutsKey, member)
+			continue
+		}
+
+		failures, getErr := l.deps.Redis.Get(ctx, failuresKey(scope, value)).Int64()
+		if getErr != nil && !errors.Is(getErr, redis.Nil) {
+			l.deps.Logger.Error("Error getting failed logins", slog.String("error", getErr.Error()))
+			return nil, getErr
+		}
+
+		lockouts = append(lockouts, entity.Lockout{
+			Scope:     scope,
+			Value:     value,
+			Failures:  failures,
+			ExpiresAt: time.Now().Add(ttl),
+		})
+	}
+
+	return lockouts, nil
+}
+
+// Clear removes the lockout and the failed attempts of the email or IP.
+func (l *LoginLimiter) Clear(ctx context.Context, scope, value string) error {
+	switch scope {
+	case LockoutScopeEmail:
+		value = normalizeEmail(value)
+	case LockoutScopeIP:
+	default:
+		return ErrInvalidLockout
+	}
+
+	deleted, err := l.deps.Redis.Del(ctx, lockKey(scope, value), failuresKey(scope, value)).Result()
+	if err != nil {
+		l.deps.Logger.Error("Error clearing login lockout", slog.String("error", err.Error()))
+		return err
+	}
+
+	if err = l.deps.Redis.SRem(ctx, lockoutsKey, scope+":"+value).Err(); err != nil {
+		l.deps.Logger.Error("Error unregistering login lockout", slog.String("error", err.Error()))
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrLockoutNotFound
+	}
+
+	l.deps.Logger.Info("Login lockout cleared", slog.String("scope", scope), slog.String("value", value))
+
+	return nil
+}
+
+// loginDelay doubles base for every failure after the first delayAfter ones.
+func loginDelay(failures int64, delayAfter int, base, maxDelay time.Duration) time.Duration {
+	if failures <= int64(delayAfter) {
+		return 0
+	}
+
+	delay := base
+	for i := int64(delayAfter) + 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
+
+type loginSubject struct {
+	scope string
+	value string
+}
+
+func loginSubjects(email, ip string) []loginSubject {
+	subjects := []loginSubject{{scope: LockoutScopeEmail, value: normalizeEmail(email)}}
+	if ip != "" {
+		subjects = append(subjects, loginSubject{scope: LockoutScopeIP, value: ip})
+	}
+
+	return subjects
+}
+
+// ClientIP returns the address of the client that sent a request received from
+// peer. X-Forwarded-For values are used only while the hop is a trusted proxy
+// and are read from the right: each proxy appends the address it got the request
+// from, while the entries on the left come from the client and can be forged.
+func ClientIP(peer string, forwardedFor []string, trustedProxies []string) string {
+	var hops []string
+	for _, value := range forwardedFor {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+
+	client := peer
+	for i := len(hops) - 1; i >= 0 && isTrustedProxy(client, trustedProxies); i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+
+		client = hop
+	}
+
+	return client
+}
+
+// isTrustedProxy reports whether the address matches one of the proxies, given
+// as addresses or CIDR ranges.
+func isTrustedProxy(addr string, trustedProxies []string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range trustedProxies {
+		if _, network, err := net.ParseCIDR(proxy); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+
+			continue
+		}
+
+		if proxyIP := net.ParseIP(proxy); proxyIP != nil && proxyIP.Equal(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func failuresKey(scope, value string) string {
+	return "login_failures:" + scope + ":" + value
+}
+
+func lockKey(scope, value string) string {
+	return "login_lock:" + scope + ":" + value
+}
+
+func valueOrDefault[T comparable](value, def T) T {
+	var zero T
+	if value == zero {
+		return def
+	}
+
+	return value
+}
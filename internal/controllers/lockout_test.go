@@ -0,0 +1,239 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginDelay(t *testing.T) {
+	tests := []struct {
+		failures int64
+		expected time.Duration
+	}{
+		{failures: 1, expected: 0},
+		{failures: 3, expected: 0},
+		{failures: 4, expected: time.Second},
+		{failures: 5, expected: 2 * time.Second},
+		{failures: 6, expected: 4 * time.Second},
+		{failures: 20, expected: 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, loginDelay(tt.failures, 3, time.Second, 30*time.Second), "failures: %d", tt.failures)
+	}
+}
+
+func TestLoginLimiter_Check(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(*MockRedis)
+		retryAfter time.Duration
+	}{
+		{
+			name: "not locked",
+			setupMocks: func(mockRedis *MockRedis) {
+				mockRedis.On("TTL", mock.Anything, "login_lock:email:user@example.com").Return(nil)
+				mockRedis.On("TTL", mock.Anything, "login_lock:ip:10.0.0.1").Return(nil)
+			},
+		},
+		{
+			name: "email locked",
+			setupMocks: func(mockRedis *MockRedis) {
+				mockRedis.On("TTL", mock.Anything, "login_lock:email:user@example.com").Return(10 * time.Minute)
+			},
+			retryAfter: 10 * time.Minute,
+		},
+		{
+			name: "ip locked",
+			setupMocks: func(mockRedis *MockRedis) {
+				mockRedis.On("TTL", mock.Anything, "login_lock:email:user@example.com").Return(nil)
+				mockRedis.On("TTL", mock.Anything, "login_lock:ip:10.0.0.1").Return(2 * time.Second)
+			},
+			retryAfter: 2 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRedis := &MockRedis{}
+			limiter := NewLoginLimiter(CreateTestDependencies(&MockDB{}, mockRedis))
+
+			tt.setupMocks(mockRedis)
+
+			err := limiter.Check(context.Background(), " User@Example.com", "10.0.0.1")
+
+			if tt.retryAfter > 0 {
+				var locked *LockedError
+				require.ErrorAs(t, err, &locked)
+				assert.ErrorIs(t, err, ErrLoginLocked)
+				assert.Equal(t, tt.retryAfter, locked.RetryAfter)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginLimiter_Fail(t *testing.T) {
+	tests := []struct {
+		name       string
+		failures   int64
+		setupMocks func(*MockRedis)
+	}{
+		{
+			name:     "first failure starts the window",
+			failures: 1,
+			setupMocks: func(mockRedis *MockRedis) {
+				mockRedis.On("Expire", mock.Anything, "login_failures:email:user@example.com", DefaultLoginFailureWindow).Return(nil)
+				mockRedis.On("Expire", mock.Anything, "login_failures:ip:10.0.0.1", DefaultLoginFailureWindow).Return(nil)
+			},
+		},
+		{
+			name:     "failures after delay_after are delayed",
+			failures: 4,
+			setupMocks: func(mockRedis *MockRedis) {
+				for _, subject := range []string{"email:user@example.com", "ip:10.0.0.1"} {
+					mockRedis.On("Set", mock.Anything, "login_lock:"+subject, int64(4), DefaultLoginBaseDelay).Return(nil)
+					mockRedis.On("SAdd", mock.Anything, lockoutsKey, []interface{}{subject}).Return(nil)
+				}
+			},
+		},
+		{
+			name:     "max attempts lock the email",
+			failures: DefaultLoginMaxAttempts,
+			setupMocks: func(mockRedis *MockRedis) {
+				mockRedis.On("Set", mock.Anything, "login_lock:email:user@example.com", int64(DefaultLoginMaxAttempts), DefaultLoginLockoutDuration).Return(nil)
+				mockRedis.On("SAdd", mock.Anything, lockoutsKey, []interface{}{"email:user@example.com"}).Return(nil)
+				mockRedis.On("Set", mock.Anything, "login_lock:ip:10.0.0.1", int64(DefaultLoginMaxAttempts), 2*DefaultLoginBaseDelay).Return(nil)
+				mockRedis.On("SAdd", mock.Anything, lockoutsKey, []interface{}{"ip:10.0.0.1"}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRedis := &MockRedis{}
+			limiter := NewLoginLimiter(CreateTestDependencies(&MockDB{}, mockRedis))
+
+			mockRedis.On("Incr", mock.Anything, "login_failures:email:user@example.com").Return(tt.failures)
+			mockRedis.On("Incr", mock.Anything, "login_failures:ip:10.0.0.1").Return(tt.failures)
+			tt.setupMocks(mockRedis)
+
+			limiter.Fail(context.Background(), "user@example.com", "10.0.0.1")
+
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginLimiter_ListAndClear(t *testing.T) {
+	mockRedis := &MockRedis{}
+	limiter := NewLoginLimiter(CreateTestDependencies(&MockDB{}, mockRedis))
+	ctx := context.Background()
+
+	mockRedis.On("SMembers", mock.Anything, lockoutsKey).Return([]string{"email:user@example.com", "ip:10.0.0.1"})
+	mockRedis.On("TTL", mock.Anything, "login_lock:email:user@example.com").Return(5 * time.Minute)
+	mockRedis.On("Get", mock.Anything, "login_failures:email:user@example.com").Return(redis.NewStringResult("5", nil))
+	mockRedis.On("TTL", mock.Anything, "login_lock:ip:10.0.0.1").Return(nil)
+	mockRedis.On("SRem", mock.Anything, lockoutsKey, []interface{}{"ip:10.0.0.1"}).Return(nil)
+
+	lockouts, err := limiter.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, lockouts, 1)
+	assert.Equal(t, entity.Lockout{
+		Scope:     LockoutScopeEmail,
+		Value:     "user@example.com",
+		Failures:  5,
+		ExpiresAt: lockouts[0].ExpiresAt,
+	}, lockouts[0])
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), lockouts[0].ExpiresAt, time.Second)
+
+	mockRedis.On("Del", mock.Anything, []string{"login_lock:email:user@example.com", "login_failures:email:user@example.com"}).Return(int64(2))
+	mockRedis.On("SRem", mock.Anything, lockoutsKey, []interface{}{"email:user@example.com"}).Return(nil)
+	assert.NoError(t, limiter.Clear(ctx, LockoutScopeEmail, "User@Example.com"))
+
+	mockRedis.On("Del", mock.Anything, []string{"login_lock:ip:10.0.0.2", "login_failures:ip:10.0.0.2"}).Return(nil)
+	mockRedis.On("SRem", mock.Anything, lockoutsKey, []interface{}{"ip:10.0.0.2"}).Return(nil)
+	assert.ErrorIs(t, limiter.Clear(ctx, LockoutScopeIP, "10.0.0.2"), ErrLockoutNotFound)
+
+	assert.ErrorIs(t, limiter.Clear(ctx, "user", "1"), ErrInvalidLockout)
+
+	mockRedis.AssertExpectations(t)
+}
+
+func TestAuthController_AuthLoginLocked(t *testing.T) {
+	mockDB := &MockDB{}
+	mockRedis := &MockRedis{}
+	controller := NewAuthController(CreateTestDependencies(mockDB, mockRedis))
+
+	mockRedis.On("TTL", mock.Anything, "login_lock:email:test@example.com").Return(nil)
+	mockRedis.On("TTL", mock.Anything, "login_lock:ip:10.0.0.1").Return(time.Minute)
+
+	_, err := controller.AuthLogin(&entity.LoginRequest{Email: "test@example.com", Password: "password123", ClientIP: "10.0.0.1"})
+
+	assert.ErrorIs(t, err, ErrLoginLocked)
+	mockDB.AssertNotCalled(t, "QueryRow")
+	mockRedis.AssertExpectations(t)
+}
+
+func TestClientIP(t *testing.T) {
+	trusted := []string{"10.0.0.0/8", "192.168.1.5"}
+
+	tests := []struct {
+		name         string
+		peer         string
+		forwardedFor []string
+		expected     string
+	}{
+		{
+			name:     "direct request",
+			peer:     "203.0.113.7",
+			expected: "203.0.113.7",
+		},
+		{
+			name:         "forwarded header from an untrusted peer is ignored",
+			peer:         "203.0.113.7",
+			forwardedFor: []string{"198.51.100.1"},
+			expected:     "203.0.113.7",
+		},
+		{
+			name:         "spoofed entry before the one appended by the proxy",
+			peer:         "10.1.2.3",
+			forwardedFor: []string{"198.51.100.1, 203.0.113.7"},
+			expected:     "203.0.113.7",
+		},
+		{
+			name:         "chain of trusted proxies",
+			peer:         "10.1.2.3",
+			forwardedFor: []string{"198.51.100.1, 203.0.113.7", "192.168.1.5"},
+			expected:     "203.0.113.7",
+		},
+		{
+			name:         "malformed entry stops the walk",
+			peer:         "10.1.2.3",
+			forwardedFor: []string{"203.0.113.7, not-an-ip"},
+			expected:     "10.1.2.3",
+		},
+		{
+			name:         "all entries are trusted proxies",
+			peer:         "10.1.2.3",
+			forwardedFor: []string{"10.0.0.1"},
+			expected:     "10.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClientIP(tt.peer, tt.forwardedFor, trusted))
+		})
+	}
+}
@@ -0,0 +1,420 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	TokenTypeMFA = "mfa"
+
+	DefaultMFAIssuer   = "Employee Service"
+	DefaultMFATokenTTL = 5 * time.Minute
+	MFAMaxAttempts     = 5
+	RecoveryCodeCount  = 10
+	recoveryCodeSize   = 5
+)
+
+var (
+	ErrMFAAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnrolled    = errors.New("two-factor authentication is not enrolled")
+	ErrMFARequired       = errors.New("two-factor authentication is required for the role")
+	ErrInvalidMFACode    = errors.New("invalid two-factor code")
+	ErrInvalidMFAToken   = errors.New("invalid or expired mfa token")
+
+	// ErrMFAEnrollmentRequired is returned for every operation but the TOTP
+	// enrollment until a user of a role with mandatory MFA enables it.
+	ErrMFAEnrollmentRequired = fmt.Errorf("%w: two-factor enrollment required", ErrPermissionDenied)
+)
+
+// mfaSettings is the TOTP state of an employee stored in employee_mfa.
+type mfaSettings struct {
+	Secret        string
+	Enabled       bool
+	LastUsedStep  *int64
+	RecoveryCodes []string
+}
+
+// EnrollMFA generates a new TOTP secret for the user. The secret stays pending
+// until it is confirmed by EnableMFA, so a lost QR code can simply be enrolled again.
+func (c *AuthController) EnrollMFA(user *entity.Claims) (*entity.MFAEnrollment, error) {
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		c.deps.Logger.Error("Error generating TOTP secret", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	query := `INSERT INTO employee_mfa (employee_id, secret, enabled) VALUES ($1, $2, FALSE)
+              ON CONFLICT (employee_id) DO UPDATE SET secret = EXCLUDED.secret, recovery_codes = '{}', last_used_step = NULL, created_at = CURRENT_TIMESTAMP
+              WHERE employee_mfa.enabled = FALSE`
+	result, err := c.deps.DB.Exec(context.Background(), query, user.ID, secret)
+	if err != nil {
+		c.deps.Logger.Error("Error storing TOTP secret", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	c.deps.Logger.Info("MFA enrollment started", slog.Any("user_id", user.ID))
+
+	return &entity.MFAEnrollment{
+		Secret: secret,
+		URI:    totpURI(valueOrDefault(c.deps.Config.MFA.Issuer, DefaultMFAIssuer), user.Email, secret),
+	}, nil
+}
+
+// EnableMFA confirms the pending secret with a code from the authenticator and
+// returns the recovery codes. All sessions of the user are revoked, since they
+// were opened without the second factor, and a new token pair is returned.
+func (c *AuthController) EnableMFA(user *entity.Claims, req *entity.MFACodeRequest) (*entity.MFAEnabled, error) {
+	ctx := context.Background()
+
+	settings, err := c.getMFASettings(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.Enabled {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	step, ok := verifyTOTP(settings.Secret, req.Code, time.Now())
+	if !ok {
+		c.deps.Logger.Warn("Invalid TOTP code on enable", slog.Any("user_id", user.ID))
+		return nil, ErrInvalidMFACode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		c.deps.Logger.Error("Error generating recovery codes", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	query := `UPDATE employee_mfa SET enabled = TRUE, enabled_at = $1, recovery_codes = $2, last_used_step = $3
+              WHERE employee_id = $4 AND enabled = FALSE`
+	result, err := c.deps.DB.Exec(ctx, query, time.Now(), hashes, step, user.ID)
+	if err != nil {
+		c.deps.Logger.Error("Error enabling MFA", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	c.deps.Logger.Info("MFA enabled", slog.Any("user_id", user.ID))
+
+	if err = c.sessions.RevokeAll(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	sessionID, err := generateTokenID(c.deps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	id, email := user.ID, user.Email
+	accessToken, refreshToken, err := c.issueTokens(entity.Employee{ID: &id, Email: &email, Role: user.Role}, &entity.Session{
+		ID:                     sessionID,
+		CreatedAt:              time.Now(),
+		PasswordChangeRequired: user.PasswordChangeRequired,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.MFAEnabled{
+		RecoveryCodes: codes,
+		AccessToken:   accessToken,
+		RefreshToken:  refreshToken,
+	}, nil
+}
+
+// DisableMFA turns off TOTP after checking a current code or a recovery code.
+// Roles with mandatory MFA cannot disable it.
+func (c *AuthController) DisableMFA(user *entity.Claims, req *entity.MFACodeRequest) error {
+	if c.mfaRequired(user.Role) {
+		return ErrMFARequired
+	}
+
+	ctx := context.Background()
+
+	if err := c.checkMFACode(ctx, user.ID, req.Code); err != nil {
+		return err
+	}
+
+	if _, err := c.deps.DB.Exec(ctx, "DELETE FROM employee_mfa WHERE employee_id = $1", user.ID); err != nil {
+		c.deps.Logger.Error("Error disabling MFA", slog.String("error", err.Error()))
+		return err
+	}
+
+	c.deps.Logger.Info("MFA disabled", slog.Any("user_id", user.ID))
+
+	return nil
+}
+
+// VerifyMFA exchanges the MFA token returned by AuthLogin and a TOTP or recovery
+// code for a token pair. The MFA token is single-use and is revoked after
+// MFAMaxAttempts wrong codes. Wrong codes also count as failed logins of the
+// employee, so new MFA tokens do not give new guesses once the email is locked.
+func (c *AuthController) VerifyMFA(req *entity.MFAVerifyRequest) (string, string, error) {
+	claims, err := c.parseToken(req.MFAToken)
+	if err != nil || claims.Type != TokenTypeMFA {
+		c.deps.Logger.Warn("Invalid mfa token")
+		return "", "", ErrInvalidMFAToken
+	}
+
+	ctx := context.Background()
+	pendingKey := "mfa_pending:" + claims.TokenID
+
+	if err = c.limiter.Check(ctx, claims.Email, req.ClientIP); err != nil {
+		return "", "", err
+	}
+
+	if err = c.deps.Redis.Get(ctx, pendingKey).Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			c.deps.Logger.Warn("MFA token already used or expired", slog.Any("user_id", claims.ID))
+			return "", "", ErrInvalidMFAToken
+		}
+
+		c.deps.Logger.Error("Error getting mfa token", slog.String("error", err.Error()))
+		return "", "", err
+	}
+
+	if err = c.checkMFACode(ctx, claims.ID, req.Code); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			c.countMFAFailure(ctx, claims)
+			c.limiter.Fail(ctx, claims.Email, req.ClientIP)
+		}
+
+		return "", "", err
+	}
+
+	deleted, err := c.deps.Redis.Del(ctx, pendingKey, "mfa_attempts:"+claims.TokenID).Result()
+	if err != nil {
+		c.deps.Logger.Error("Error deleting mfa token", slog.String("error", err.Error()))
+		return "", "", err
+	}
+
+	if deleted == 0 {
+		return "", "", ErrInvalidMFAToken
+	}
+
+	c.limiter.Succeed(ctx, claims.Email)
+
+	var id uint64
+	var email, role, status string
+	var mustChangePassword, isActive bool
+
+	query := "SELECT id, email, role, must_change_password, is_active, status FROM employees WHERE id = $1"
+	if err = c.deps.DB.QueryRow(ctx, query, claims.ID).Scan(&id, &email, &role, &mustChangePassword, &isActive, &status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Employee of mfa token not found", slog.Any("id", claims.ID))
+			return "", "", ErrInvalidMFAToken
+		}
+
+		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
+		return "", "", err
+	}
+
+	if !accountActive(isActive, status) {
+		c.deps.Logger.Warn("Login to inactive account", slog.Any("user_id", id), slog.String("status", status))
+		return "", "", ErrAccountInactive
+	}
+
+	sessionID, err := generateTokenID(c.deps.Logger)
+	if err != nil {
+		return "", "", err
+	}
+
+	return c.issueTokens(entity.Employee{ID: &id, Email: &email, Role: role}, &entity.Session{
+		ID:                     sessionID,
+		CreatedAt:              time.Now(),
+		PasswordChangeRequired: mustChangePassword,
+	})
+}
+
+// createMFAToken returns a short-lived token that only VerifyMFA accepts.
+func (c *AuthController) createMFAToken(ctx context.Context, emp entity.Employee) (string, error) {
+	tokenID, err := generateTokenID(c.deps.Logger)
+	if err != nil {
+		return "", err
+	}
+
+	ttl := valueOrDefault(c.deps.Config.MFA.TokenTTL, DefaultMFATokenTTL)
+	claims := entity.Claims{
+		ID:      *emp.ID,
+		Email:   *emp.Email,
+		Role:    emp.Role,
+		TokenID: tokenID,
+		Type:    TokenTypeMFA,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	tokenStr, err := c.deps.Keys.Sign(claims)
+	if err != nil {
+		c.deps.Logger.Error("Error signing mfa token", slog.String("error", err.Error()))
+		return "", err
+	}
+
+	if err = c.deps.Redis.Set(ctx, "mfa_pending:"+tokenID, *emp.ID, ttl).Err(); err != nil {
+		c.deps.Logger.Error("Error setting mfa token", slog.String("error", err.Error()))
+		return "", err
+	}
+
+	return tokenStr, nil
+}
+
+// countMFAFailure revokes the MFA token after too many wrong codes.
+func (c *AuthController) countMFAFailure(ctx context.Context, claims *entity.Claims) {
+	attemptsKey := "mfa_attempts:" + claims.TokenID
+
+	attempts, err := c.deps.Redis.Incr(ctx, attemptsKey).Result()
+	if err != nil {
+		c.deps.Logger.Error("Error counting mfa attempts", slog.String("error", err.Error()))
+		return
+	}
+
+	if attempts == 1 {
+		c.deps.Redis.Expire(ctx, attemptsKey, valueOrDefault(c.deps.Config.MFA.TokenTTL, DefaultMFATokenTTL))
+	}
+
+	if attempts >= MFAMaxAttempts {
+		c.deps.Logger.Warn("Too many invalid mfa codes", slog.Any("user_id", claims.ID))
+		c.deps.Redis.Del(ctx, "mfa_pending:"+claims.TokenID, attemptsKey)
+	}
+}
+
+// checkMFACode accepts a TOTP code that was not used before or an unused recovery
+// code, which is consumed.
+func (c *AuthController) checkMFACode(ctx context.Context, employeeID uint64, code string) error {
+	settings, err := c.getMFASettings(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+
+	if !settings.Enabled {
+		return ErrMFANotEnrolled
+	}
+
+	code = strings.TrimSpace(code)
+
+	if step, ok := verifyTOTP(settings.Secret, code, time.Now()); ok {
+		query := `UPDATE employee_mfa SET last_used_step = $1
+                  WHERE employee_id = $2 AND (last_used_step IS NULL OR last_used_step < $1)`
+		result, execErr := c.deps.DB.Exec(ctx, query, step, employeeID)
+		if execErr != nil {
+			c.deps.Logger.Error("Error updating TOTP step", slog.String("error", execErr.Error()))
+			return execErr
+		}
+
+		if result.RowsAffected() == 0 {
+			c.deps.Logger.Warn("TOTP code reused", slog.Any("employee_id", employeeID))
+			return ErrInvalidMFACode
+		}
+
+		return nil
+	}
+
+	hash := hashRecoveryCode(code)
+	if !slices.Contains(settings.RecoveryCodes, hash) {
+		c.deps.Logger.Warn("Invalid mfa code", slog.Any("employee_id", employeeID))
+		return ErrInvalidMFACode
+	}
+
+	query := `UPDATE employee_mfa SET recovery_codes = array_remove(recovery_codes, $1)
+              WHERE employee_id = $2 AND $1 = ANY(recovery_codes)`
+	result, err := c.deps.DB.Exec(ctx, query, hash, employeeID)
+	if err != nil {
+		c.deps.Logger.Error("Error consuming recovery code", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrInvalidMFACode
+	}
+
+	c.deps.Logger.Info("Recovery code used", slog.Any("employee_id", employeeID), slog.Int("remaining", len(settings.RecoveryCodes)-1))
+
+	return nil
+}
+
+func (c *AuthController) getMFASettings(ctx context.Context, employeeID uint64) (*mfaSettings, error) {
+	var settings mfaSettings
+
+	query := "SELECT secret, enabled, last_used_step, recovery_codes FROM employee_mfa WHERE employee_id = $1"
+	if err := c.deps.DB.QueryRow(ctx, query, employeeID).Scan(&settings.Secret, &settings.Enabled, &settings.LastUsedStep, &settings.RecoveryCodes); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMFANotEnrolled
+		}
+
+		c.deps.Logger.Error("Error querying mfa settings", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// mfaEnabled reports whether the employee has confirmed TOTP.
+func (c *AuthController) mfaEnabled(ctx context.Context, employeeID uint64) (bool, error) {
+	var enabled bool
+	if err := c.deps.DB.QueryRow(ctx, "SELECT enabled FROM employee_mfa WHERE employee_id = $1", employeeID).Scan(&enabled); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		c.deps.Logger.Error("Error querying mfa settings", slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return enabled, nil
+}
+
+func (c *AuthController) mfaRequired(role string) bool {
+	return slices.Contains(c.deps.Config.MFA.RequiredRoles, role)
+}
+
+// generateRecoveryCodes returns the codes shown to the user and their hashes to store.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+
+	for range RecoveryCodeCount {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+
+		code := hex.EncodeToString(b)
+		codes = append(codes, code[:recoveryCodeSize]+"-"+code[recoveryCodeSize:])
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case and dashes, so codes can be typed as printed or not.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(code, "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,322 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	mfaSettingsQuery = "SELECT secret, enabled, last_used_step, recovery_codes FROM employee_mfa WHERE employee_id = $1"
+	mfaTestSecret    = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" // base32 of "12345678901234567890"
+)
+
+func queryPrefix(prefix string) interface{} {
+	return mock.MatchedBy(func(query string) bool {
+		return strings.HasPrefix(query, prefix)
+	})
+}
+
+func currentTOTPCode(t *testing.T) string {
+	key, err := totpEncoding.DecodeString(mfaTestSecret)
+	require.NoError(t, err)
+
+	return totpCode(key, time.Now().Unix()/int64(TOTPPeriod.Seconds()))
+}
+
+func TestTOTP(t *testing.T) {
+	// RFC 6238 test vector for SHA1 at T = 59s, truncated to 6 digits.
+	assert.Equal(t, "287082", totpCode([]byte("12345678901234567890"), 1))
+
+	step, ok := verifyTOTP(mfaTestSecret, "287082", time.Unix(59, 0))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), step)
+
+	_, ok = verifyTOTP(mfaTestSecret, "287082", time.Unix(59+3*30, 0))
+	assert.False(t, ok, "code outside the skew window")
+
+	_, ok = verifyTOTP("not base32!", "287082", time.Unix(59, 0))
+	assert.False(t, ok)
+
+	uri := totpURI("Employee Service", "user@example.com", mfaTestSecret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Employee%20Service:user@example.com?"))
+	assert.Contains(t, uri, "secret="+mfaTestSecret)
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, hashes, err := generateRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, RecoveryCodeCount)
+	require.Len(t, hashes, RecoveryCodeCount)
+
+	assert.Regexp(t, `^[0-9a-f]{5}-[0-9a-f]{5}$`, codes[0])
+	assert.Equal(t, hashes[0], hashRecoveryCode(strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))))
+}
+
+func TestAuthController_EnrollMFA(t *testing.T) {
+	mockDB := &MockDB{}
+	controller := NewAuthController(CreateTestDependencies(mockDB, &MockRedis{}))
+	user := &entity.Claims{ID: 1, Email: "test@example.com", Role: entity.RoleEmployee}
+
+	mockDB.On("Exec", mock.Anything, queryPrefix("INSERT INTO employee_mfa"), uint64(1), mock.AnythingOfType("string")).
+		Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+
+	enrollment, err := controller.EnrollMFA(user)
+	require.NoError(t, err)
+	assert.Len(t, enrollment.Secret, 32)
+	assert.Contains(t, enrollment.URI, "secret="+enrollment.Secret)
+
+	mockDB.On("Exec", mock.Anything, queryPrefix("INSERT INTO employee_mfa"), uint64(1), mock.AnythingOfType("string")).
+		Return(pgconn.NewCommandTag("INSERT 0 0"), nil).Once()
+
+	_, err = controller.EnrollMFA(user)
+	assert.ErrorIs(t, err, ErrMFAAlreadyEnabled)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestAuthController_EnableMFA(t *testing.T) {
+	tests := []struct {
+		name        string
+		code        func(*testing.T) string
+		enabled     bool
+		expectedErr error
+	}{
+		{
+			name: "valid code",
+			code: currentTOTPCode,
+		},
+		{
+			name:        "invalid code",
+			code:        func(*testing.T) string { return "000000" },
+			expectedErr: ErrInvalidMFACode,
+		},
+		{
+			name:        "already enabled",
+			code:        currentTOTPCode,
+			enabled:     true,
+			expectedErr: ErrMFAAlreadyEnabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			controller := NewAuthController(CreateTestDependencies(mockDB, mockRedis))
+			user := &entity.Claims{ID: 1, Email: "test@example.com", Role: entity.RoleHR, MFAEnrollmentRequired: true}
+
+			mockDB.On("QueryRow", mock.Anything, mfaSettingsQuery, uint64(1)).
+				Return(NewMockRow([]interface{}{mfaTestSecret, tt.enabled, (*int64)(nil), []string{}}, nil, nil))
+
+			if tt.expectedErr == nil {
+				mockDB.On("Exec", mock.Anything, queryPrefix("UPDATE employee_mfa SET enabled = TRUE"),
+					mock.AnythingOfType("time.Time"), mock.AnythingOfType("[]string"), mock.AnythingOfType("int64"), uint64(1)).
+					Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+				mockRedis.On("SMembers", mock.Anything, "user_sessions:1").Return([]string{})
+				mockRedis.On("Del", mock.Anything, []string{"user_sessions:1"}).Return(nil)
+				mockRedis.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockRedis.On("SAdd", mock.Anything, "user_sessions:1", mock.Anything).Return(nil)
+			}
+
+			enabled, err := controller.EnableMFA(user, &entity.MFACodeRequest{Code: tt.code(t)})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, enabled)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, enabled.RecoveryCodes, RecoveryCodeCount)
+				assert.NotEmpty(t, enabled.AccessToken)
+				assert.NotEmpty(t, enabled.RefreshToken)
+
+				claims, parseErr := controller.parseToken(enabled.AccessToken)
+				require.NoError(t, parseErr)
+				assert.False(t, claims.MFAEnrollmentRequired)
+			}
+
+			mockDB.AssertExpectations(t)
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_DisableMFA(t *testing.T) {
+	mockDB := &MockDB{}
+	deps := CreateTestDependencies(mockDB, &MockRedis{})
+	deps.Config.MFA.RequiredRoles = []string{entity.RoleAdmin}
+	controller := NewAuthController(deps)
+
+	err := controller.DisableMFA(&entity.Claims{ID: 1, Role: entity.RoleAdmin}, &entity.MFACodeRequest{Code: "123456"})
+	assert.ErrorIs(t, err, ErrMFARequired)
+
+	mockDB.On("QueryRow", mock.Anything, mfaSettingsQuery, uint64(3)).
+		Return(NewMockRow([]interface{}{mfaTestSecret, true, (*int64)(nil), []string{}}, nil, nil))
+	mockDB.On("Exec", mock.Anything, queryPrefix("UPDATE employee_mfa SET last_used_step"), mock.AnythingOfType("int64"), uint64(3)).
+		Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	mockDB.On("Exec", mock.Anything, "DELETE FROM employee_mfa WHERE employee_id = $1", uint64(3)).
+		Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+	err = controller.DisableMFA(&entity.Claims{ID: 3, Role: entity.RoleEmployee}, &entity.MFACodeRequest{Code: currentTOTPCode(t)})
+	assert.NoError(t, err)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestAuthController_VerifyMFA(t *testing.T) {
+	recoveryCode := "abcde-12345"
+
+	tests := []struct {
+		name        string
+		code        func(*testing.T) string
+		setupMocks  func(*MockDB, *MockRedis, string)
+		expectedErr error
+	}{
+		{
+			name: "totp code",
+			code: currentTOTPCode,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, tokenID string) {
+				mockDB.On("Exec", mock.Anything, queryPrefix("UPDATE employee_mfa SET last_used_step"), mock.AnythingOfType("int64"), uint64(1)).
+					Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+			},
+		},
+		{
+			name: "recovery code",
+			code: func(*testing.T) string { return "ABCDE12345" },
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, tokenID string) {
+				mockDB.On("Exec", mock.Anything, queryPrefix("UPDATE employee_mfa SET recovery_codes"), hashRecoveryCode(recoveryCode), uint64(1)).
+					Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+			},
+		},
+		{
+			name: "reused totp code",
+			code: currentTOTPCode,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, tokenID string) {
+				mockDB.On("Exec", mock.Anything, queryPrefix("UPDATE employee_mfa SET last_used_step"), mock.AnythingOfType("int64"), uint64(1)).
+					Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+				mockRedis.On("Incr", mock.Anything, "mfa_attempts:"+tokenID).Return(int64(1))
+				mockRedis.On("Expire", mock.Anything, "mfa_attempts:"+tokenID, DefaultMFATokenTTL).Return(nil)
+			},
+			expectedErr: ErrInvalidMFACode,
+		},
+		{
+			name: "too many invalid codes revoke the token",
+			code: func(*testing.T) string { return "000000" },
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis, tokenID string) {
+				mockRedis.On("Incr", mock.Anything, "mfa_attempts:"+tokenID).Return(int64(MFAMaxAttempts))
+				mockRedis.On("Del", mock.Anything, []string{"mfa_pending:" + tokenID, "mfa_attempts:" + tokenID}).Return(int64(2))
+			},
+			expectedErr: ErrInvalidMFACode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			controller := NewAuthController(CreateTestDependencies(mockDB, mockRedis))
+
+			var tokenID string
+			mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+				tokenID = strings.TrimPrefix(key, "mfa_pending:")
+				return strings.HasPrefix(key, "mfa_pending:")
+			}), uint64(1), DefaultMFATokenTTL).Return(nil)
+
+			id, email := uint64(1), "test@example.com"
+			mfaToken, err := controller.createMFAToken(context.Background(), entity.Employee{ID: &id, Email: &email, Role: entity.RoleEmployee})
+			require.NoError(t, err)
+
+			mockRedis.On("TTL", mock.Anything, "login_lock:email:test@example.com").Return(nil)
+			mockRedis.On("Get", mock.Anything, "mfa_pending:"+tokenID).Return(redis.NewStringResult("1", nil))
+			mockDB.On("QueryRow", mock.Anything, mfaSettingsQuery, uint64(1)).
+				Return(NewMockRow([]interface{}{mfaTestSecret, true, (*int64)(nil), []string{hashRecoveryCode(recoveryCode)}}, nil, nil))
+			tt.setupMocks(mockDB, mockRedis, tokenID)
+
+			if tt.expectedErr != nil {
+				mockRedis.On("Incr", mock.Anything, "login_failures:email:test@example.com").Return(int64(1))
+				mockRedis.On("Expire", mock.Anything, "login_failures:email:test@example.com", DefaultLoginFailureWindow).Return(nil)
+			} else {
+				mockRedis.On("Del", mock.Anything, []string{"mfa_pending:" + tokenID, "mfa_attempts:" + tokenID}).Return(int64(1))
+				mockRedis.On("Del", mock.Anything, []string{"login_failures:email:test@example.com"}).Return(int64(1))
+				mockDB.On("QueryRow", mock.Anything, "SELECT id, email, role, must_change_password, is_active, status FROM employees WHERE id = $1", uint64(1)).
+					Return(NewMockRow([]interface{}{uint64(1), "test@example.com", entity.RoleEmployee, false, true, entity.StatusActive}, nil, nil))
+				mockRedis.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockRedis.On("SAdd", mock.Anything, "user_sessions:1", mock.Anything).Return(nil)
+			}
+
+			accessToken, refreshToken, err := controller.VerifyMFA(&entity.MFAVerifyRequest{MFAToken: mfaToken, Code: tt.code(t)})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, accessToken)
+			} else {
+				require.NoError(t, err)
+				assert.NotEmpty(t, accessToken)
+				assert.NotEmpty(t, refreshToken)
+			}
+
+			mockDB.AssertExpectations(t)
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_VerifyMFA_LoginKeepsFailures(t *testing.T) {
+	mockDB := &MockDB{}
+	mockRedis := &MockRedis{}
+	controller := NewAuthController(CreateTestDependencies(mockDB, mockRedis))
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+
+	mockRedis.On("TTL", mock.Anything, "login_lock:email:test@example.com").Return(nil).Once()
+	mockDB.On("QueryRow", mock.Anything, queryPrefix("SELECT id, email, password"), "test@example.com").
+		Return(NewMockRow([]interface{}{uint64(1), "test@example.com", string(hashedPassword), entity.RoleEmployee, false, true, entity.StatusActive}, nil, nil))
+	mockDB.On("QueryRow", mock.Anything, mfaEnabledQuery, uint64(1)).Return(NewMockRow([]interface{}{true}, nil, nil))
+	mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "mfa_pending:")
+	}), uint64(1), DefaultMFATokenTTL).Return(nil)
+
+	resp, err := controller.AuthLogin(&entity.LoginRequest{Email: "test@example.com", Password: "password123"})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.MFAToken)
+	mockRedis.AssertNotCalled(t, "Del", mock.Anything, []string{"login_failures:email:test@example.com"})
+
+	// The wrong codes sent with earlier mfa tokens locked the email, so the new
+	// token gives no new guesses.
+	mockRedis.On("TTL", mock.Anything, "login_lock:email:test@example.com").Return(10 * time.Minute).Once()
+
+	_, _, err = controller.VerifyMFA(&entity.MFAVerifyRequest{MFAToken: resp.MFAToken, Code: "000000"})
+
+	var locked *LockedError
+	require.ErrorAs(t, err, &locked)
+	assert.Equal(t, 10*time.Minute, locked.RetryAfter)
+	mockDB.AssertNotCalled(t, "QueryRow", mock.Anything, mfaSettingsQuery, uint64(1))
+}
+
+func TestAuthController_VerifyMFA_InvalidToken(t *testing.T) {
+	mockRedis := &MockRedis{}
+	controller := NewAuthController(CreateTestDependencies(&MockDB{}, mockRedis))
+
+	_, _, err := controller.VerifyMFA(&entity.MFAVerifyRequest{MFAToken: "invalid", Code: "123456"})
+	assert.ErrorIs(t, err, ErrInvalidMFAToken)
+
+	id, email := uint64(1), "test@example.com"
+	session := &entity.Session{ID: "session-id", UserID: id}
+	accessToken, err := controller.createToken(entity.Employee{ID: &id, Email: &email, Role: entity.RoleEmployee}, TokenTypeAccess, session)
+	require.NoError(t, err)
+
+	_, _, err = controller.VerifyMFA(&entity.MFAVerifyRequest{MFAToken: accessToken, Code: "123456"})
+	assert.ErrorIs(t, err, ErrInvalidMFAToken, "access tokens are not mfa tokens")
+
+	mockRedis.AssertNotCalled(t, "Get")
+}
@@ -0,0 +1,480 @@
+package controllers
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	DefaultOIDCStateTTL = 10 * time.Minute
+
+	oidcHTTPTimeout = 10 * time.Second
+)
+
+var (
+	ErrOIDCDisabled          = errors.New("oidc login is disabled")
+	ErrInvalidOIDCState      = errors.New("invalid or expired oidc state")
+	ErrInvalidIDToken        = errors.New("invalid id token")
+	ErrOIDCUnknownUser       = errors.New("no employee matches the oidc account")
+	ErrPasswordLoginDisabled = errors.New("password login is disabled")
+)
+
+// oidcState is kept in Redis under oidc_state:<state> between the redirect to
+// the IdP and the callback.
+type oidcState struct {
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcIDTokenClaims struct {
+	jwt.RegisteredClaims
+
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+// OIDCProvider talks to the IdP configured in the [oidc] section. The discovery
+// document and the signing keys are fetched on first use and cached; the keys
+// are fetched again when a token is signed with an unknown kid.
+type OIDCProvider struct {
+	deps   *Dependens
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]any
+}
+
+func NewOIDCProvider(deps *Dependens) *OIDCProvider {
+	return &OIDCProvider{
+		deps:   deps,
+		client: &http.Client{Timeout: oidcHTTPTimeout},
+	}
+}
+
+// StartOIDCLogin returns the IdP authorization URL. The state, nonce and PKCE
+// verifier are stored in Redis until the callback.
+func (c *AuthController) StartOIDCLogin() (string, error) {
+	cfg := c.deps.Config.OIDC
+	if !cfg.Enabled {
+		return "", ErrOIDCDisabled
+	}
+
+	ctx := context.Background()
+
+	discovery, err := c.oidc.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := generateTokenID(c.deps.Logger)
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := generateTokenID(c.deps.Logger)
+	if err != nil {
+		return "", err
+	}
+
+	verifier, err := generatePKCEVerifier()
+	if err != nil {
+		c.deps.Logger.Error("Error generating pkce verifier", slog.String("error", err.Error()))
+		return "", err
+	}
+
+	data, err := json.Marshal(oidcState{Verifier: verifier, Nonce: nonce})
+	if err != nil {
+		return "", err
+	}
+
+	if err = c.deps.Redis.Set(ctx, "oidc_state:"+state, data, valueOrDefault(cfg.StateTTL, DefaultOIDCStateTTL)).Err(); err != nil {
+		c.deps.Logger.Error("Error setting oidc state", slog.String("error", err.Error()))
+		return "", err
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email"}
+	}
+
+	values := url.Values{}
+	values.Set("response_type", "code")
+	values.Set("client_id", cfg.ClientID)
+	values.Set("redirect_uri", cfg.RedirectURL)
+	values.Set("scope", strings.Join(scopes, " "))
+	values.Set("state", state)
+	values.Set("nonce", nonce)
+	values.Set("code_challenge", pkceChallenge(verifier))
+	values.Set("code_challenge_method", "S256")
+
+	return discovery.AuthorizationEndpoint + "?" + values.Encode(), nil
+}
+
+// FinishOIDCLogin exchanges the authorization code for an ID token, maps it to
+// an employee and opens a session. The employee is found by the IdP subject,
+// or on the first login by the verified email, which links the subject to it.
+// The second factor is checked as on the password login: an employee with TOTP
+// enabled gets only an MFA token, whatever the IdP asked for.
+func (c *AuthController) FinishOIDCLogin(code, state string) (*entity.LoginResponse, error) {
+	if !c.deps.Config.OIDC.Enabled {
+		return nil, ErrOIDCDisabled
+	}
+
+	ctx := context.Background()
+
+	saved, err := c.consumeOIDCState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := c.oidc.exchangeCode(ctx, code, saved.Verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := c.oidc.verifyIDToken(ctx, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Nonce != saved.Nonce {
+		c.deps.Logger.Warn("OIDC nonce mismatch", slog.String("subject", claims.Subject))
+		return nil, ErrInvalidIDToken
+	}
+
+	emp, err := c.findOIDCEmployee(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	c.deps.Logger.Info("OIDC login", slog.Any("user_id", *emp.ID), slog.String("subject", claims.Subject))
+
+	return c.openSession(ctx, *emp, false)
+}
+
+func (c *AuthController) consumeOIDCState(ctx context.Context, state string) (*oidcState, error) {
+	key := "oidc_state:" + state
+
+	data, err := c.deps.Redis.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			c.deps.Logger.Warn("Unknown oidc state")
+			return nil, ErrInvalidOIDCState
+		}
+
+		c.deps.Logger.Error("Error getting oidc state", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	deleted, err := c.deps.Redis.Del(ctx, key).Result()
+	if err != nil {
+		c.deps.Logger.Error("Error deleting oidc state", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if deleted == 0 {
+		return nil, ErrInvalidOIDCState
+	}
+
+	var saved oidcState
+	if err = json.Unmarshal(data, &saved); err != nil {
+		c.deps.Logger.Error("Error unmarshaling oidc state", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+func (c *AuthController) findOIDCEmployee(ctx context.Context, claims *oidcIDTokenClaims) (*entity.Employee, error) {
+	var id uint64
+	var email, role, status string
+	var isActive bool
+
+	query := "SELECT id, email, role, is_active, status FROM employees WHERE oidc_subject = $1"
+	err := c.deps.DB.QueryRow(ctx, query, claims.Subject).Scan(&id, &email, &role, &isActive, &status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if claims.Email == "" || !claims.EmailVerified {
+			c.deps.Logger.Warn("OIDC account without verified email", slog.String("subject", claims.Subject))
+			return nil, ErrOIDCUnknownUser
+		}
+
+		query = `UPDATE employees SET oidc_subject = $1
+                 WHERE lower(email) = lower($2) AND oidc_subject IS NULL
+                 RETURNING id, email, role, is_active, status`
+		err = c.deps.DB.QueryRow(ctx, query, claims.Subject, claims.Email).Scan(&id, &email, &role, &isActive, &status)
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("OIDC account does not match an employee", slog.String("subject", claims.Subject), slog.String("email", claims.Email))
+			return nil, ErrOIDCUnknownUser
+		}
+
+		if err == nil {
+			c.deps.Logger.Info("OIDC subject linked", slog.Any("user_id", id), slog.String("subject", claims.Subject))
+		}
+	}
+
+	if err != nil {
+		c.deps.Logger.Error("Error querying oidc employee", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !accountActive(isActive, status) {
+		c.deps.Logger.Warn("Login to inactive account", slog.Any("user_id", id), slog.String("status", status))
+		return nil, ErrAccountInactive
+	}
+
+	return &entity.Employee{ID: &id, Email: &email, Role: role}, nil
+}
+
+func (p *OIDCProvider) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(p.deps.Config.OIDC.Issuer, "/")
+
+	var discovery oidcDiscovery
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		p.deps.Logger.Error("Error fetching oidc discovery", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("oidc issuer mismatch: %s", discovery.Issuer)
+	}
+
+	p.discovery = &discovery
+
+	return p.discovery, nil
+}
+
+// exchangeCode redeems the authorization code at the token endpoint and
+// returns the ID token.
+func (p *OIDCProvider) exchangeCode(ctx context.Context, code, verifier string) (string, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	cfg := p.deps.Config.OIDC
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", cfg.RedirectURL)
+	form.Set("client_id", cfg.ClientID)
+	form.Set("code_verifier", verifier)
+	if cfg.ClientSecret != "" {
+		form.Set("client_secret", cfg.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		p.deps.Logger.Error("Error exchanging oidc code", slog.String("error", err.Error()))
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		p.deps.Logger.Error("Error decoding oidc token response", slog.String("error", err.Error()))
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK || token.IDToken == "" {
+		p.deps.Logger.Warn("OIDC code exchange rejected",
+			slog.Int("status", resp.StatusCode),
+			slog.String("error", token.Error),
+			slog.String("description", token.ErrorDescription),
+		)
+		return "", ErrInvalidOIDCState
+	}
+
+	return token.IDToken, nil
+}
+
+// verifyIDToken checks the signature, issuer, audience and expiration of the ID token.
+func (p *OIDCProvider) verifyIDToken(ctx context.Context, idToken string) (*oidcIDTokenClaims, error) {
+	cfg := p.deps.Config.OIDC
+
+	claims := &oidcIDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(strings.TrimSuffix(cfg.Issuer, "/")),
+		jwt.WithAudience(cfg.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || claims.Subject == "" {
+		p.deps.Logger.Warn("Invalid id token", slog.Any("error", err))
+		return nil, ErrInvalidIDToken
+	}
+
+	return claims, nil
+}
+
+func (p *OIDCProvider) getKey(ctx context.Context, kid string) (any, error) {
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	p.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+
+	if err := p.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok = p.keys[kid]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown oidc key %q", kid)
+}
+
+func (p *OIDCProvider) refreshKeys(ctx context.Context) error {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return err
+	}
+
+	var jwks entity.JWKS
+	if err = p.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		p.deps.Logger.Error("Error fetching oidc keys", slog.String("error", err.Error()))
+		return err
+	}
+
+	keys := make(map[string]any, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, parseErr := parseJWK(jwk)
+		if parseErr != nil {
+			p.deps.Logger.Warn("Skipping oidc key", slog.String("kid", jwk.Kid), slog.String("error", parseErr.Error()))
+			continue
+		}
+
+		keys[jwk.Kid] = key
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, url string, dest any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(dest)
+}
+
+// parseJWK returns the public key of an RSA or Ed25519 JWK.
+func parseJWK(jwk entity.JWK) (any, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %s", jwk.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", jwk.Kty)
+	}
+}
+
+// generatePKCEVerifier returns a code verifier as defined by RFC 7636.
+func generatePKCEVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
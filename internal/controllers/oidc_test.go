@@ -0,0 +1,300 @@
+package controllers
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	oidcClientID     = "employee-service"
+	oidcSubjectQuery = "SELECT id, email, role, is_active, status FROM employees WHERE oidc_subject = $1"
+)
+
+// fakeIdP is a minimal OpenID provider: discovery, JWKS and a token endpoint
+// that accepts "good-code" with the verifier of the last authorization request.
+type fakeIdP struct {
+	t      *testing.T
+	server *httptest.Server
+
+	challenge string
+	claims    jwt.MapClaims
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &fakeIdP{t: t}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidcDiscovery{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JWKSURI:               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(entity.JWKS{Keys: []entity.JWK{{
+			Kty: "RSA",
+			Kid: "idp-key",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+
+		if r.PostForm.Get("code") != "good-code" || pkceChallenge(r.PostForm.Get("code_verifier")) != idp.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.claims)
+		token.Header["kid"] = "idp-key"
+		idToken, err := token.SignedString(key)
+		require.NoError(t, err)
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": idToken})
+	})
+
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+// authorize plays the browser redirect: it records the PKCE challenge and
+// returns the state, and sets the ID token claims for the nonce of the request.
+func (idp *fakeIdP) authorize(authURL string, claims jwt.MapClaims) string {
+	parsed, err := url.Parse(authURL)
+	require.NoError(idp.t, err)
+
+	query := parsed.Query()
+	assert.Equal(idp.t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(idp.t, oidcClientID, query.Get("client_id"))
+
+	idp.challenge = query.Get("code_challenge")
+	idp.claims = jwt.MapClaims{
+		"iss":   idp.server.URL,
+		"aud":   oidcClientID,
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": query.Get("nonce"),
+	}
+	for k, v := range claims {
+		idp.claims[k] = v
+	}
+
+	return query.Get("state")
+}
+
+func newOIDCTestController(t *testing.T) (*AuthController, *fakeIdP, *MockDB, *MockRedis) {
+	idp := newFakeIdP(t)
+
+	mockDB := &MockDB{}
+	mockRedis := &MockRedis{}
+	deps := CreateTestDependencies(mockDB, mockRedis)
+	deps.Config.OIDC.Enabled = true
+	deps.Config.OIDC.Issuer = idp.server.URL
+	deps.Config.OIDC.ClientID = oidcClientID
+	deps.Config.OIDC.RedirectURL = "http://localhost/callback"
+
+	return NewAuthController(deps), idp, mockDB, mockRedis
+}
+
+// startOIDC runs StartOIDCLogin with a Redis mock that keeps the saved state.
+func startOIDC(t *testing.T, controller *AuthController, mockRedis *MockRedis) string {
+	var saved []byte
+	mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "oidc_state:")
+	}), mock.Anything, DefaultOIDCStateTTL).Run(func(args mock.Arguments) {
+		saved = args.Get(2).([]byte)
+	}).Return(nil).Once()
+
+	authURL, err := controller.StartOIDCLogin()
+	require.NoError(t, err)
+
+	mockRedis.On("Get", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "oidc_state:")
+	})).Return(redis.NewStringResult(string(saved), nil)).Once()
+	mockRedis.On("Del", mock.Anything, mock.Anything).Return(int64(1)).Once()
+
+	return authURL
+}
+
+func TestAuthController_OIDCLogin(t *testing.T) {
+	t.Run("known subject", func(t *testing.T) {
+		controller, idp, mockDB, mockRedis := newOIDCTestController(t)
+
+		state := idp.authorize(startOIDC(t, controller, mockRedis), jwt.MapClaims{"sub": "idp-1"})
+
+		mockDB.On("QueryRow", mock.Anything, oidcSubjectQuery, "idp-1").
+			Return(NewMockRow([]interface{}{uint64(1), "test@example.com", entity.RoleEmployee, true, entity.StatusActive}, nil, nil))
+		mockDB.On("QueryRow", mock.Anything, mfaEnabledQuery, uint64(1)).Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+		mockRedis.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRedis.On("SAdd", mock.Anything, "user_sessions:1", mock.Anything).Return(nil)
+
+		resp, err := controller.FinishOIDCLogin("good-code", state)
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
+		assert.Empty(t, resp.MFAToken)
+
+		claims, err := controller.parseToken(resp.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), claims.ID)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("second factor is required", func(t *testing.T) {
+		controller, idp, mockDB, mockRedis := newOIDCTestController(t)
+
+		state := idp.authorize(startOIDC(t, controller, mockRedis), jwt.MapClaims{"sub": "idp-1"})
+
+		mockDB.On("QueryRow", mock.Anything, oidcSubjectQuery, "idp-1").
+			Return(NewMockRow([]interface{}{uint64(1), "test@example.com", entity.RoleHR, true, entity.StatusActive}, nil, nil))
+		mockDB.On("QueryRow", mock.Anything, mfaEnabledQuery, uint64(1)).Return(NewMockRow([]interface{}{true}, nil, nil))
+		mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "mfa_pending:")
+		}), uint64(1), DefaultMFATokenTTL).Return(nil).Once()
+
+		resp, err := controller.FinishOIDCLogin("good-code", state)
+		require.NoError(t, err)
+		assert.Empty(t, resp.AccessToken)
+		assert.Empty(t, resp.RefreshToken)
+
+		claims, err := controller.parseToken(resp.MFAToken)
+		require.NoError(t, err)
+		assert.Equal(t, TokenTypeMFA, claims.Type)
+		mockDB.AssertExpectations(t)
+		mockRedis.AssertExpectations(t)
+	})
+
+	t.Run("role with mandatory MFA must enroll", func(t *testing.T) {
+		controller, idp, mockDB, mockRedis := newOIDCTestController(t)
+		controller.deps.Config.MFA.RequiredRoles = []string{entity.RoleHR}
+
+		state := idp.authorize(startOIDC(t, controller, mockRedis), jwt.MapClaims{"sub": "idp-1"})
+
+		mockDB.On("QueryRow", mock.Anything, oidcSubjectQuery, "idp-1").
+			Return(NewMockRow([]interface{}{uint64(1), "test@example.com", entity.RoleHR, true, entity.StatusActive}, nil, nil))
+		mockDB.On("QueryRow", mock.Anything, mfaEnabledQuery, uint64(1)).Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+		mockRedis.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRedis.On("SAdd", mock.Anything, "user_sessions:1", mock.Anything).Return(nil)
+
+		resp, err := controller.FinishOIDCLogin("good-code", state)
+		require.NoError(t, err)
+
+		claims, err := controller.parseToken(resp.AccessToken)
+		require.NoError(t, err)
+		assert.True(t, claims.MFAEnrollmentRequired)
+	})
+
+	t.Run("first login links by verified email", func(t *testing.T) {
+		controller, idp, mockDB, mockRedis := newOIDCTestController(t)
+
+		state := idp.authorize(startOIDC(t, controller, mockRedis), jwt.MapClaims{
+			"sub": "idp-2", "email": "Test@Example.com", "email_verified": true,
+		})
+
+		mockDB.On("QueryRow", mock.Anything, oidcSubjectQuery, "idp-2").Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+		mockDB.On("QueryRow", mock.Anything, queryPrefix("UPDATE employees SET oidc_subject"), "idp-2", "Test@Example.com").
+			Return(NewMockRow([]interface{}{uint64(2), "test@example.com", entity.RoleManager, true, entity.StatusActive}, nil, nil))
+		mockDB.On("QueryRow", mock.Anything, mfaEnabledQuery, uint64(2)).Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+		mockRedis.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRedis.On("SAdd", mock.Anything, "user_sessions:2", mock.Anything).Return(nil)
+
+		_, err := controller.FinishOIDCLogin("good-code", state)
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("unverified email is not linked", func(t *testing.T) {
+		controller, idp, mockDB, mockRedis := newOIDCTestController(t)
+
+		state := idp.authorize(startOIDC(t, controller, mockRedis), jwt.MapClaims{
+			"sub": "idp-3", "email": "test@example.com", "email_verified": false,
+		})
+
+		mockDB.On("QueryRow", mock.Anything, oidcSubjectQuery, "idp-3").Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+
+		_, err := controller.FinishOIDCLogin("good-code", state)
+		assert.ErrorIs(t, err, ErrOIDCUnknownUser)
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		controller, idp, _, mockRedis := newOIDCTestController(t)
+
+		state := idp.authorize(startOIDC(t, controller, mockRedis), jwt.MapClaims{"sub": "idp-1", "nonce": "replayed"})
+
+		_, err := controller.FinishOIDCLogin("good-code", state)
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		controller, idp, _, mockRedis := newOIDCTestController(t)
+
+		state := idp.authorize(startOIDC(t, controller, mockRedis), jwt.MapClaims{"sub": "idp-1", "aud": "other-client"})
+
+		_, err := controller.FinishOIDCLogin("good-code", state)
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+
+	t.Run("code rejected by the IdP", func(t *testing.T) {
+		controller, idp, _, mockRedis := newOIDCTestController(t)
+
+		state := idp.authorize(startOIDC(t, controller, mockRedis), jwt.MapClaims{"sub": "idp-1"})
+
+		_, err := controller.FinishOIDCLogin("stolen-code", state)
+		assert.ErrorIs(t, err, ErrInvalidOIDCState)
+	})
+
+	t.Run("unknown state", func(t *testing.T) {
+		controller, _, _, mockRedis := newOIDCTestController(t)
+
+		mockRedis.On("Get", mock.Anything, "oidc_state:forged").Return(redis.NewStringResult("", redis.Nil))
+
+		_, err := controller.FinishOIDCLogin("good-code", "forged")
+		assert.ErrorIs(t, err, ErrInvalidOIDCState)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		controller := NewAuthController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.StartOIDCLogin()
+		assert.ErrorIs(t, err, ErrOIDCDisabled)
+
+		_, err = controller.FinishOIDCLogin("good-code", "state")
+		assert.ErrorIs(t, err, ErrOIDCDisabled)
+	})
+}
+
+func TestAuthController_AuthLogin_PasswordLoginDisabled(t *testing.T) {
+	deps := CreateTestDependencies(&MockDB{}, &MockRedis{})
+	deps.Config.OIDC.Enabled = true
+	deps.Config.OIDC.DisablePasswordLogin = true
+	controller := NewAuthController(deps)
+
+	_, err := controller.AuthLogin(&entity.LoginRequest{Email: "test@example.com", Password: "password"})
+	assert.ErrorIs(t, err, ErrPasswordLoginDisabled)
+}
@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+)
+
+const (
+	DefaultEmployeesPageSize = 50
+	MaxEmployeesPageSize     = 500
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+
+	defaultEmployeesSortField = "id"
+)
+
+var (
+	ErrInvalidPageSize  = errors.New("invalid page size")
+	ErrInvalidSortField = errors.New("invalid sort field")
+	ErrInvalidSortOrder = errors.New("invalid sort order")
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+// employeeSortFields are the columns the employee listing can be sorted by.
+// The value is true for the timestamp columns. All of them are NOT NULL, so
+// with id as the tie breaker they give a stable keyset order.
+var employeeSortFields = map[string]bool{
+	"id":         false,
+	"last_name":  false,
+	"first_name": false,
+	"hire_date":  true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// employeeCursor is the position after the last employee of a page. It is sent
+// to the client as an opaque page token.
+type employeeCursor struct {
+	SortBy string `json:"s"`
+	Desc   bool   `json:"d,omitempty"`
+	Value  string `json:"v,omitempty"`
+	ID     uint64 `json:"id"`
+}
+
+// employeesPage is the validated pagination of an employee listing.
+type employeesPage struct {
+	limit  int
+	sortBy string
+	desc   bool
+	after  *employeeCursor
+}
+
+func newEmployeesPage(params *entity.GetEmployeesParams) (*employeesPage, error) {
+	page := &employeesPage{
+		limit:  DefaultEmployeesPageSize,
+		sortBy: defaultEmployeesSortField,
+	}
+
+	if params == nil {
+		return page, nil
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > MaxEmployeesPageSize {
+			return nil, fmt.Errorf("%w: must be between 1 and %d", ErrInvalidPageSize, MaxEmployeesPageSize)
+		}
+
+		page.limit = *params.Limit
+	}
+
+	if params.SortBy != nil && *params.SortBy != "" {
+		if _, ok := employeeSortFields[*params.SortBy]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSortField, *params.SortBy)
+		}
+
+		page.sortBy = *params.SortBy
+	}
+
+	if params.SortOrder != nil && *params.SortOrder != "" {
+		switch *params.SortOrder {
+		case SortOrderAsc:
+		case SortOrderDesc:
+			page.desc = true
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSortOrder, *params.SortOrder)
+		}
+	}
+
+	if params.PageToken != nil && *params.PageToken != "" {
+		cursor, err := decodeEmployeeCursor(*params.PageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		// A token is only valid for the order it was issued for.
+		if cursor.SortBy != page.sortBy || cursor.Desc != page.desc {
+			return nil, ErrInvalidPageToken
+		}
+
+		page.after = cursor
+	}
+
+	return page, nil
+}
+
+// where returns the keyset condition that skips the employees up to the cursor.
+func (p *employeesPage) where(argIdx int) (string, []any, error) {
+	if p.after == nil {
+		return "", nil, nil
+	}
+
+	op := ">"
+	if p.desc {
+		op = "<"
+	}
+
+	if p.sortBy == "id" {
+		return fmt.Sprintf(" AND id %s $%d", op, argIdx), []any{p.after.ID}, nil
+	}
+
+	var value any = p.after.Value
+	if employeeSortFields[p.sortBy] {
+		t, err := time.Parse(time.RFC3339Nano, p.after.Value)
+		if err != nil {
+			return "", nil, ErrInvalidPageToken
+		}
+
+		value = t
+	}
+
+	return fmt.Sprintf(" AND (%s, id) %s ($%d, $%d)", p.sortBy, op, argIdx, argIdx+1), []any{value, p.after.ID}, nil
+}
+
+func (p *employeesPage) orderBy() string {
+	order := "ASC"
+	if p.desc {
+		order = "DESC"
+	}
+
+	if p.sortBy == "id" {
+		return fmt.Sprintf(" ORDER BY id %s", order)
+	}
+
+	return fmt.Sprintf(" ORDER BY %s %s, id %s", p.sortBy, order, order)
+}
+
+// nextToken returns the page token that continues after the employee.
+func (p *employeesPage) nextToken(last *entity.Employee) string {
+	cursor := employeeCursor{
+		SortBy: p.sortBy,
+		Desc:   p.desc,
+	}
+
+	if last.ID != nil {
+		cursor.ID = *last.ID
+	}
+
+	switch p.sortBy {
+	case "last_name":
+		cursor.Value = last.LastName
+	case "first_name":
+		cursor.Value = last.FirstName
+	case "hire_date":
+		cursor.Value = formatCursorTime(last.HireDate)
+	case "created_at":
+		cursor.Value = formatCursorTime(last.CreatedAt)
+	case "updated_at":
+		cursor.Value = formatCursorTime(last.UpdatedAt)
+	}
+
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func formatCursorTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339Nano)
+}
+
+func decodeEmployeeCursor(token string) (*employeeCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var cursor employeeCursor
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,248 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	DefaultPasswordResetTTL = time.Hour
+	ResetTokenSize          = 32
+	TemporaryPasswordLength = 16
+
+	temporaryPasswordAlphabet = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+var (
+	ErrInvalidPassword   = errors.New("invalid password")
+	ErrEmptyPassword     = errors.New("new password is empty")
+	ErrSamePassword      = errors.New("new password must differ from the old one")
+	ErrInvalidResetToken = errors.New("invalid or expired reset token")
+	ErrEmployeeNotFound  = errors.New("employee not found")
+)
+
+// ChangePassword sets a new password for the user after checking the old one.
+// All sessions of the user are revoked and a new token pair is returned.
+func (c *AuthController) ChangePassword(user *entity.Claims, req *entity.ChangePasswordRequest) (string, string, error) {
+	if req.NewPassword == "" {
+		return "", "", ErrEmptyPassword
+	}
+
+	if req.NewPassword == req.OldPassword {
+		return "", "", ErrSamePassword
+	}
+
+	ctx := context.Background()
+
+	var email, password, role string
+	if err := c.deps.DB.QueryRow(ctx, "SELECT email, password, role FROM employees WHERE id = $1", user.ID).Scan(&email, &password, &role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Employee not found", slog.Any("id", user.ID))
+			return "", "", ErrEmployeeNotFound
+		}
+
+		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
+		return "", "", err
+	}
+
+	if match, _, err := c.deps.Passwords.Hasher.Verify(password, req.OldPassword); err != nil || !match {
+		c.deps.Logger.Warn("Invalid old password", slog.Any("id", user.ID))
+		return "", "", ErrInvalidPassword
+	}
+
+	hash, err := hashNewPassword(ctx, c.deps, &user.ID, req.NewPassword)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err = c.setPassword(ctx, user.ID, hash, false); err != nil {
+		return "", "", err
+	}
+
+	sessionID, err := generateTokenID(c.deps.Logger)
+	if err != nil {
+		return "", "", err
+	}
+
+	id := user.ID
+	return c.issueTokens(entity.Employee{ID: &id, Email: &email, Role: role}, &entity.Session{
+		ID:                    sessionID,
+		CreatedAt:             time.Now(),
+		MFAEnrollmentRequired: user.MFAEnrollmentRequired,
+	})
+}
+
+// CreatePasswordReset issues a single-use reset token for the employee. Only admin
+// may reset the password of another admin.
+func (c *AuthController) CreatePasswordReset(user *entity.Claims, employeeID uint64) (*entity.PasswordReset, error) {
+	ctx := context.Background()
+
+	var role string
+	if err := c.deps.DB.QueryRow(ctx, "SELECT role FROM employees WHERE id = $1", employeeID).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.deps.Logger.Warn("Employee not found", slog.Any("id", employeeID))
+			return nil, ErrEmployeeNotFound
+		}
+
+		c.deps.Logger.Error("Error querying employee", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if role == entity.RoleAdmin && user.Role != entity.RoleAdmin {
+		c.deps.Logger.Warn("Password reset of admin denied", slog.Any("user_id", user.ID), slog.Any("employee_id", employeeID))
+		return nil, ErrPermissionDenied
+	}
+
+	b := make([]byte, ResetTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		c.deps.Logger.Error("Error generating reset token", slog.String("error", err.Error()))
+		return nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	ttl := c.deps.Config.Redis.PasswordResetTTL
+	if ttl == 0 {
+		ttl = DefaultPasswordResetTTL
+	}
+
+	if err := c.deps.Redis.Set(ctx, resetTokenKey(token), resetTokenValue(employeeID, user.ID), ttl).Err(); err != nil {
+		c.deps.Logger.Error("Error setting reset token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	c.deps.Logger.Info("Password reset issued", slog.Any("user_id", user.ID), slog.Any("employee_id", employeeID))
+
+	return &entity.PasswordReset{
+		ResetToken: token,
+		ExpiresAt:  time.Now().Add(ttl),
+	}, nil
+}
+
+// ResetPassword consumes the reset token and sets the new password. All sessions
+// of the employee are revoked. The token is consumed only after the new password
+// passes the policy, so a rejected password can be retried with the same token.
+// When the reset was issued by someone else, the issuer may know the password, so
+// the employee has to change it on the next login.
+func (c *AuthController) ResetPassword(req *entity.ResetPasswordRequest) error {
+	if req.NewPassword == "" {
+		return ErrEmptyPassword
+	}
+
+	ctx := context.Background()
+	key := resetTokenKey(req.ResetToken)
+
+	value, err := c.deps.Redis.Get(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			c.deps.Logger.Warn("Invalid reset token")
+			return ErrInvalidResetToken
+		}
+
+		c.deps.Logger.Error("Error getting reset token", slog.String("error", err.Error()))
+		return err
+	}
+
+	employeeID, issuerID, err := parseResetTokenValue(value)
+	if err != nil {
+		c.deps.Logger.Error("Error parsing reset token", slog.String("error", err.Error()))
+		return ErrInvalidResetToken
+	}
+
+	hash, err := hashNewPassword(ctx, c.deps, &employeeID, req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := c.deps.Redis.Del(ctx, key).Result()
+	if err != nil {
+		c.deps.Logger.Error("Error deleting reset token", slog.String("error", err.Error()))
+		return err
+	}
+
+	if deleted == 0 {
+		c.deps.Logger.Warn("Reset token already used", slog.Any("employee_id", employeeID))
+		return ErrInvalidResetToken
+	}
+
+	return c.setPassword(ctx, employeeID, hash, issuerID != employeeID)
+}
+
+// setPassword stores the password hash and the must change password flag,
+// records the hash in the password history and revokes all sessions of the employee.
+func (c *AuthController) setPassword(ctx context.Context, employeeID uint64, hash string, mustChange bool) error {
+	query := `UPDATE employees SET password = $1, must_change_password = $2, updated_at = $3 WHERE id = $4`
+	result, err := c.deps.DB.Exec(ctx, query, hash, mustChange, time.Now(), employeeID)
+	if err != nil {
+		c.deps.Logger.Error("Error updating password", slog.String("error", err.Error()))
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		c.deps.Logger.Warn("Employee not found", slog.Any("id", employeeID))
+		return ErrEmployeeNotFound
+	}
+
+	if err = recordPasswordHistory(ctx, c.deps, employeeID, hash); err != nil {
+		return err
+	}
+
+	c.deps.Logger.Info("Password changed", slog.Any("employee_id", employeeID))
+
+	return c.sessions.RevokeAll(ctx, employeeID)
+}
+
+// resetTokenValue keeps the employee whose password is reset and the user who issued the reset.
+func resetTokenValue(employeeID, issuerID uint64) string {
+	return fmt.Sprintf("%d:%d", employeeID, issuerID)
+}
+
+// parseResetTokenValue reads the value of resetTokenValue. A value without the
+// issuer counts as issued by someone else.
+func parseResetTokenValue(value string) (uint64, uint64, error) {
+	employee, issuer, _ := strings.Cut(value, ":")
+
+	employeeID, err := strconv.ParseUint(employee, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	issuerID, _ := strconv.ParseUint(issuer, 10, 64)
+
+	return employeeID, issuerID, nil
+}
+
+// resetTokenKey stores only the hash of the token, so a Redis dump does not leak usable tokens.
+func resetTokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "password_reset:" + hex.EncodeToString(sum[:])
+}
+
+func generateTemporaryPassword(logger *slog.Logger) (string, error) {
+	password := make([]byte, TemporaryPasswordLength)
+	limit := big.NewInt(int64(len(temporaryPasswordAlphabet)))
+
+	for i := range password {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			logger.Error("Error generating temporary password", slog.String("error", err.Error()))
+			return "", err
+		}
+
+		password[i] = temporaryPasswordAlphabet[n.Int64()]
+	}
+
+	return string(password), nil
+}
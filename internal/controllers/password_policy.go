@@ -0,0 +1,278 @@
+package controllers
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/adamanr/employes_service/internal/config"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const DefaultMinPasswordLength = 8
+
+var (
+	ErrWeakPassword   = errors.New("password does not satisfy the password policy")
+	ErrPasswordReused = fmt.Errorf("%w: password was used recently", ErrWeakPassword)
+)
+
+// PasswordRule is a single check of the password policy. Rules return an error
+// wrapping ErrWeakPassword that explains what is wrong with the password.
+type PasswordRule interface {
+	Check(password string) error
+}
+
+// MinLengthRule requires at least the given number of characters.
+type MinLengthRule int
+
+func (r MinLengthRule) Check(password string) error {
+	if utf8.RuneCountInString(password) < int(r) {
+		return fmt.Errorf("%w: must be at least %d characters long", ErrWeakPassword, int(r))
+	}
+
+	return nil
+}
+
+// CharacterClassesRule requires characters of the enabled classes.
+type CharacterClassesRule struct {
+	Upper   bool
+	Lower   bool
+	Digit   bool
+	Special bool
+}
+
+func (r CharacterClassesRule) Check(password string) error {
+	var upper, lower, digit, special bool
+	for _, ch := range password {
+		switch {
+		case unicode.IsUpper(ch):
+			upper = true
+		case unicode.IsLower(ch):
+			lower = true
+		case unicode.IsDigit(ch):
+			digit = true
+		default:
+			special = true
+		}
+	}
+
+	var missing []string
+	if r.Upper && !upper {
+		missing = append(missing, "an uppercase letter")
+	}
+	if r.Lower && !lower {
+		missing = append(missing, "a lowercase letter")
+	}
+	if r.Digit && !digit {
+		missing = append(missing, "a digit")
+	}
+	if r.Special && !special {
+		missing = append(missing, "a special character")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: must contain %s", ErrWeakPassword, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// BannedPasswordsRule rejects commonly used passwords, compared case-insensitively.
+type BannedPasswordsRule map[string]struct{}
+
+// LoadBannedPasswords reads the banned passwords file, one password per line.
+// Empty lines and lines starting with # are skipped.
+func LoadBannedPasswords(path string) (BannedPasswordsRule, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	rule := make(BannedPasswordsRule)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule[strings.ToLower(line)] = struct{}{}
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r BannedPasswordsRule) Check(password string) error {
+	if _, ok := r[strings.ToLower(password)]; ok {
+		return fmt.Errorf("%w: password is too common", ErrWeakPassword)
+	}
+
+	return nil
+}
+
+// PasswordManager validates new passwords against the policy and hashes them.
+type PasswordManager struct {
+	Hasher PasswordHasher
+
+	rules       []PasswordRule
+	historySize int
+
+	dummyOnce sync.Once
+	dummyHash string
+}
+
+// NewPasswordManager builds the password policy from the [password] config
+// section. Extra rules are checked after the configured ones.
+func NewPasswordManager(cfg *config.Config, logger *slog.Logger, extra ...PasswordRule) (*PasswordManager, error) {
+	pc := cfg.Password
+
+	m := &PasswordManager{
+		Hasher: PasswordHasher{
+			Algorithm:     pc.Algorithm,
+			BcryptCost:    pc.BcryptCost,
+			Argon2Time:    pc.Argon2Time,
+			Argon2Memory:  pc.Argon2Memory,
+			Argon2Threads: pc.Argon2Threads,
+		},
+		historySize: pc.HistorySize,
+	}
+
+	if m.Hasher.Algorithm == "" {
+		m.Hasher.Algorithm = HashBcrypt
+	}
+	if m.Hasher.BcryptCost == 0 {
+		m.Hasher.BcryptCost = bcrypt.DefaultCost
+	}
+	if m.Hasher.Argon2Time == 0 {
+		m.Hasher.Argon2Time = DefaultArgon2Time
+	}
+	if m.Hasher.Argon2Memory == 0 {
+		m.Hasher.Argon2Memory = DefaultArgon2Memory
+	}
+	if m.Hasher.Argon2Threads == 0 {
+		m.Hasher.Argon2Threads = DefaultArgon2Threads
+	}
+
+	minLength := pc.MinLength
+	if minLength == 0 {
+		minLength = DefaultMinPasswordLength
+	}
+
+	m.rules = append(m.rules, MinLengthRule(minLength), CharacterClassesRule{
+		Upper:   pc.RequireUpper,
+		Lower:   pc.RequireLower,
+		Digit:   pc.RequireDigit,
+		Special: pc.RequireSpecial,
+	})
+
+	if pc.BannedList != "" {
+		banned, err := LoadBannedPasswords(pc.BannedList)
+		if err != nil {
+			logger.Error("Error loading banned passwords", slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		m.rules = append(m.rules, banned)
+	}
+
+	m.rules = append(m.rules, extra...)
+
+	return m, nil
+}
+
+// Validate checks the password against every rule of the policy.
+func (m *PasswordManager) Validate(password string) error {
+	for _, rule := range m.rules {
+		if err := rule.Check(password); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyDummy spends the same time as a real verification. It is used when the
+// login email is unknown, so the response time does not reveal that.
+func (m *PasswordManager) VerifyDummy(password string) {
+	m.dummyOnce.Do(func() {
+		m.dummyHash, _ = m.Hasher.Hash("dummy-password")
+	})
+
+	_, _, _ = m.Hasher.Verify(m.dummyHash, password)
+}
+
+// hashNewPassword validates the password, checks that it is not one of the last
+// passwords of the employee and returns its hash. The employeeID is nil for
+// employees that are not created yet.
+func hashNewPassword(ctx context.Context, deps *Dependens, employeeID *uint64, password string) (string, error) {
+	if err := deps.Passwords.Validate(password); err != nil {
+		deps.Logger.Warn("Password rejected by policy", slog.String("reason", err.Error()))
+		return "", err
+	}
+
+	if employeeID != nil && deps.Passwords.historySize > 0 {
+		query := `SELECT password_hash FROM password_history WHERE employee_id = $1 ORDER BY created_at DESC LIMIT $2`
+		rows, err := deps.DB.Query(ctx, query, *employeeID, deps.Passwords.historySize)
+		if err != nil {
+			deps.Logger.Error("Error querying password history", slog.String("error", err.Error()))
+			return "", err
+		}
+		defer rows.Close()
+
+		hashes, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			deps.Logger.Error("Error collecting password history", slog.String("error", err.Error()))
+			return "", err
+		}
+
+		for _, hash := range hashes {
+			if match, _, verifyErr := deps.Passwords.Hasher.Verify(hash, password); verifyErr == nil && match {
+				deps.Logger.Warn("Password reused", slog.Any("employee_id", *employeeID))
+				return "", ErrPasswordReused
+			}
+		}
+	}
+
+	hash, err := deps.Passwords.Hasher.Hash(password)
+	if err != nil {
+		deps.Logger.Error("Error hashing password", slog.String("error", err.Error()))
+		return "", err
+	}
+
+	return hash, nil
+}
+
+// recordPasswordHistory stores the hash of the new password and forgets the ones
+// beyond history_size.
+func recordPasswordHistory(ctx context.Context, deps *Dependens, employeeID uint64, hash string) error {
+	if deps.Passwords.historySize <= 0 {
+		return nil
+	}
+
+	if _, err := deps.DB.Exec(ctx, `INSERT INTO password_history (employee_id, password_hash) VALUES ($1, $2)`, employeeID, hash); err != nil {
+		deps.Logger.Error("Error recording password history", slog.String("error", err.Error()))
+		return err
+	}
+
+	query := `DELETE FROM password_history WHERE employee_id = $1 AND id NOT IN (
+                  SELECT id FROM password_history WHERE employee_id = $1 ORDER BY created_at DESC LIMIT $2)`
+	if _, err := deps.DB.Exec(ctx, query, employeeID, deps.Passwords.historySize); err != nil {
+		deps.Logger.Error("Error trimming password history", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
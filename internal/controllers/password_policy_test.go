@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const passwordHistoryQuery = `SELECT password_hash FROM password_history WHERE employee_id = $1 ORDER BY created_at DESC LIMIT $2`
+
+func TestPasswordHasher_Verify(t *testing.T) {
+	bcryptHasher := PasswordHasher{Algorithm: HashBcrypt, BcryptCost: bcrypt.MinCost}
+	argonHasher := PasswordHasher{Algorithm: HashArgon2id, Argon2Time: 1, Argon2Memory: 1024, Argon2Threads: 1}
+
+	bcryptHash, err := bcryptHasher.Hash("secret-password")
+	require.NoError(t, err)
+
+	argonHash, err := argonHasher.Hash("secret-password")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(argonHash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	tests := []struct {
+		name        string
+		hasher      PasswordHasher
+		hash        string
+		password    string
+		match       bool
+		needsRehash bool
+	}{
+		{name: "bcrypt match", hasher: bcryptHasher, hash: bcryptHash, password: "secret-password", match: true},
+		{name: "bcrypt mismatch", hasher: bcryptHasher, hash: bcryptHash, password: "wrong-password"},
+		{name: "argon2id match", hasher: argonHasher, hash: argonHash, password: "secret-password", match: true},
+		{name: "argon2id mismatch", hasher: argonHasher, hash: argonHash, password: "wrong-password"},
+		{name: "bcrypt upgraded to argon2id", hasher: argonHasher, hash: bcryptHash, password: "secret-password", match: true, needsRehash: true},
+		{name: "argon2id downgraded to bcrypt", hasher: bcryptHasher, hash: argonHash, password: "secret-password", match: true, needsRehash: true},
+		{
+			name:        "bcrypt cost raised",
+			hasher:      PasswordHasher{Algorithm: HashBcrypt, BcryptCost: bcrypt.MinCost + 1},
+			hash:        bcryptHash,
+			password:    "secret-password",
+			match:       true,
+			needsRehash: true,
+		},
+		{
+			name:        "argon2id memory raised",
+			hasher:      PasswordHasher{Algorithm: HashArgon2id, Argon2Time: 1, Argon2Memory: 2048, Argon2Threads: 1},
+			hash:        argonHash,
+			password:    "secret-password",
+			match:       true,
+			needsRehash: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, needsRehash, err := tt.hasher.Verify(tt.hash, tt.password)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.match, match)
+			assert.Equal(t, tt.needsRehash, needsRehash)
+		})
+	}
+
+	_, _, err = argonHasher.Verify("$argon2id$broken", "secret-password")
+	assert.ErrorIs(t, err, ErrUnknownHashFormat)
+}
+
+func TestPasswordManager_Validate(t *testing.T) {
+	banned := filepath.Join(t.TempDir(), "banned.txt")
+	require.NoError(t, os.WriteFile(banned, []byte("# common passwords\nPassword123\n\nqwerty12345\n"), 0o600))
+
+	deps := CreateTestDependencies(&MockDB{}, &MockRedis{})
+	deps.Config.Password.MinLength = 10
+	deps.Config.Password.RequireUpper = true
+	deps.Config.Password.RequireDigit = true
+	deps.Config.Password.BannedList = banned
+
+	passwords, err := NewPasswordManager(deps.Config, deps.Logger)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  string
+	}{
+		{name: "valid password", password: "Correct-Horse-7"},
+		{name: "too short", password: "Short1A", wantErr: "must be at least 10 characters long"},
+		{name: "missing classes", password: "lowercaseonly", wantErr: "must contain an uppercase letter, a digit"},
+		{name: "banned password", password: "PASSWORD123", wantErr: "password is too common"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := passwords.Validate(tt.password)
+
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrWeakPassword)
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	_, err = LoadBannedPasswords(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestHashNewPassword_History(t *testing.T) {
+	mockDB := &MockDB{}
+	deps := CreateTestDependencies(mockDB, &MockRedis{})
+	deps.Passwords.historySize = 3
+
+	oldHash, err := deps.Passwords.Hasher.Hash("previous-password")
+	require.NoError(t, err)
+
+	id := uint64(1)
+	mockDB.On("Query", mock.Anything, passwordHistoryQuery, id, 3).
+		Return(NewMockRows([][]interface{}{{oldHash}}, nil, []pgconn.FieldDescription{{Name: "password_hash", DataTypeOID: 25}}), nil)
+
+	_, err = hashNewPassword(context.Background(), deps, &id, "previous-password")
+	assert.ErrorIs(t, err, ErrPasswordReused)
+	assert.ErrorIs(t, err, ErrWeakPassword)
+
+	hash, err := hashNewPassword(context.Background(), deps, &id, "brand-new-password")
+	require.NoError(t, err)
+
+	match, _, err := deps.Passwords.Hasher.Verify(hash, "brand-new-password")
+	assert.NoError(t, err)
+	assert.True(t, match)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestRecordPasswordHistory(t *testing.T) {
+	mockDB := &MockDB{}
+	deps := CreateTestDependencies(mockDB, &MockRedis{})
+
+	assert.NoError(t, recordPasswordHistory(context.Background(), deps, 1, "hash"), "history is disabled by default")
+
+	deps.Passwords.historySize = 2
+	mockDB.On("Exec", mock.Anything, `INSERT INTO password_history (employee_id, password_hash) VALUES ($1, $2)`, uint64(1), "hash").
+		Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+	mockDB.On("Exec", mock.Anything, mock.MatchedBy(func(query string) bool {
+		return strings.HasPrefix(query, "DELETE FROM password_history")
+	}), uint64(1), 2).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+	assert.NoError(t, recordPasswordHistory(context.Background(), deps, 1, "hash"))
+	mockDB.AssertExpectations(t)
+}
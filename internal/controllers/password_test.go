@@ -0,0 +1,272 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const updatePasswordQuery = `UPDATE employees SET password = $1, must_change_password = $2, updated_at = $3 WHERE id = $4`
+
+func TestAuthController_ChangePassword(t *testing.T) {
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.MinCost)
+
+	tests := []struct {
+		name        string
+		req         *entity.ChangePasswordRequest
+		setupMocks  func(*MockDB, *MockRedis)
+		expectedErr error
+	}{
+		{
+			name: "successful change",
+			req:  &entity.ChangePasswordRequest{OldPassword: "old-password", NewPassword: "new-password"},
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRow := NewMockRow([]interface{}{"test@example.com", string(hashedPassword), "employee"}, nil, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT email, password, role FROM employees WHERE id = $1", uint64(1)).Return(mockRow)
+				mockDB.On("Exec", mock.Anything, updatePasswordQuery, mock.AnythingOfType("string"), false, mock.Anything, uint64(1)).
+					Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+
+				mockRedis.On("SMembers", mock.Anything, "user_sessions:1").Return([]string{})
+				mockRedis.On("Del", mock.Anything, []string{"user_sessions:1"}).Return(nil)
+				mockRedis.On("Set", mock.Anything, mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("time.Duration")).Return(nil)
+				mockRedis.On("SAdd", mock.Anything, "user_sessions:1", mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "wrong old password",
+			req:  &entity.ChangePasswordRequest{OldPassword: "wrong-password", NewPassword: "new-password"},
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRow := NewMockRow([]interface{}{"test@example.com", string(hashedPassword), "employee"}, nil, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT email, password, role FROM employees WHERE id = $1", uint64(1)).Return(mockRow)
+			},
+			expectedErr: ErrInvalidPassword,
+		},
+		{
+			name:        "empty new password",
+			req:         &entity.ChangePasswordRequest{OldPassword: "old-password"},
+			setupMocks:  func(mockDB *MockDB, mockRedis *MockRedis) {},
+			expectedErr: ErrEmptyPassword,
+		},
+		{
+			name: "weak new password",
+			req:  &entity.ChangePasswordRequest{OldPassword: "old-password", NewPassword: "short"},
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRow := NewMockRow([]interface{}{"test@example.com", string(hashedPassword), "employee"}, nil, nil)
+				mockDB.On("QueryRow", mock.Anything, "SELECT email, password, role FROM employees WHERE id = $1", uint64(1)).Return(mockRow)
+			},
+			expectedErr: ErrWeakPassword,
+		},
+		{
+			name:        "same password",
+			req:         &entity.ChangePasswordRequest{OldPassword: "old-password", NewPassword: "old-password"},
+			setupMocks:  func(mockDB *MockDB, mockRedis *MockRedis) {},
+			expectedErr: ErrSamePassword,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			deps := CreateTestDependencies(mockDB, mockRedis)
+			controller := NewAuthController(deps)
+
+			tt.setupMocks(mockDB, mockRedis)
+
+			user := &entity.Claims{ID: 1, Role: entity.RoleEmployee, TokenID: "current", PasswordChangeRequired: true}
+			accessToken, refreshToken, err := controller.ChangePassword(user, tt.req)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, accessToken)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, refreshToken)
+
+				claims, parseErr := controller.parseToken(accessToken)
+				assert.NoError(t, parseErr)
+				assert.False(t, claims.PasswordChangeRequired)
+			}
+
+			mockDB.AssertExpectations(t)
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_CreatePasswordReset(t *testing.T) {
+	tests := []struct {
+		name        string
+		userRole    string
+		setupMocks  func(*MockDB, *MockRedis)
+		expectedErr error
+	}{
+		{
+			name:     "hr resets employee password",
+			userRole: entity.RoleHR,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockDB.On("QueryRow", mock.Anything, "SELECT role FROM employees WHERE id = $1", uint64(2)).
+					Return(NewMockRow([]interface{}{entity.RoleEmployee}, nil, nil))
+				mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+					return strings.HasPrefix(key, "password_reset:")
+				}), "2:1", DefaultPasswordResetTTL).Return(nil)
+			},
+		},
+		{
+			name:     "hr cannot reset admin password",
+			userRole: entity.RoleHR,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockDB.On("QueryRow", mock.Anything, "SELECT role FROM employees WHERE id = $1", uint64(2)).
+					Return(NewMockRow([]interface{}{entity.RoleAdmin}, nil, nil))
+			},
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name:     "employee not found",
+			userRole: entity.RoleAdmin,
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockDB.On("QueryRow", mock.Anything, "SELECT role FROM employees WHERE id = $1", uint64(2)).
+					Return(NewMockRow(nil, pgx.ErrNoRows, nil))
+			},
+			expectedErr: ErrEmployeeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			deps := CreateTestDependencies(mockDB, mockRedis)
+			controller := NewAuthController(deps)
+
+			tt.setupMocks(mockDB, mockRedis)
+
+			reset, err := controller.CreatePasswordReset(&entity.Claims{ID: 1, Role: tt.userRole}, 2)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, reset)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, reset.ResetToken, ResetTokenSize*2)
+				assert.False(t, reset.ExpiresAt.IsZero())
+			}
+
+			mockDB.AssertExpectations(t)
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_ResetPassword(t *testing.T) {
+	tests := []struct {
+		name        string
+		newPassword string
+		setupMocks  func(*MockDB, *MockRedis)
+		expectedErr error
+	}{
+		{
+			name:        "reset issued by hr requires a password change",
+			newPassword: "new-password",
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, resetTokenKey("reset-token")).Return(redis.NewStringResult("2:1", nil))
+				mockRedis.On("Del", mock.Anything, []string{resetTokenKey("reset-token")}).Return(int64(1))
+				mockDB.On("Exec", mock.Anything, updatePasswordQuery, mock.AnythingOfType("string"), true, mock.Anything, uint64(2)).
+					Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+				mockRedis.On("SMembers", mock.Anything, "user_sessions:2").Return([]string{})
+				mockRedis.On("Del", mock.Anything, []string{"user_sessions:2"}).Return(nil)
+			},
+		},
+		{
+			name:        "reset issued by the employee",
+			newPassword: "new-password",
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, resetTokenKey("reset-token")).Return(redis.NewStringResult("2:2", nil))
+				mockRedis.On("Del", mock.Anything, []string{resetTokenKey("reset-token")}).Return(int64(1))
+				mockDB.On("Exec", mock.Anything, updatePasswordQuery, mock.AnythingOfType("string"), false, mock.Anything, uint64(2)).
+					Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+				mockRedis.On("SMembers", mock.Anything, "user_sessions:2").Return([]string{})
+				mockRedis.On("Del", mock.Anything, []string{"user_sessions:2"}).Return(nil)
+			},
+		},
+		{
+			name:        "used or expired token",
+			newPassword: "new-password",
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, resetTokenKey("reset-token")).Return(redis.Nil)
+			},
+			expectedErr: ErrInvalidResetToken,
+		},
+		{
+			name:        "token consumed concurrently",
+			newPassword: "new-password",
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, resetTokenKey("reset-token")).Return(redis.NewStringResult("2:1", nil))
+				mockRedis.On("Del", mock.Anything, []string{resetTokenKey("reset-token")}).Return(nil)
+			},
+			expectedErr: ErrInvalidResetToken,
+		},
+		{
+			name:        "weak password keeps the token",
+			newPassword: "short",
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, resetTokenKey("reset-token")).Return(redis.NewStringResult("2:1", nil))
+			},
+			expectedErr: fmt.Errorf("%w: must be at least %d characters long", ErrWeakPassword, DefaultMinPasswordLength),
+		},
+		{
+			name:        "database error",
+			newPassword: "new-password",
+			setupMocks: func(mockDB *MockDB, mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, resetTokenKey("reset-token")).Return(redis.NewStringResult("2:1", nil))
+				mockRedis.On("Del", mock.Anything, []string{resetTokenKey("reset-token")}).Return(int64(1))
+				mockDB.On("Exec", mock.Anything, updatePasswordQuery, mock.AnythingOfType("string"), true, mock.Anything, uint64(2)).
+					Return(pgconn.CommandTag{}, errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			deps := CreateTestDependencies(mockDB, mockRedis)
+			controller := NewAuthController(deps)
+
+			tt.setupMocks(mockDB, mockRedis)
+
+			err := controller.ResetPassword(&entity.ResetPasswordRequest{ResetToken: "reset-token", NewPassword: tt.newPassword})
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockDB.AssertExpectations(t)
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGenerateTemporaryPassword(t *testing.T) {
+	deps := CreateTestDependencies(&MockDB{}, &MockRedis{})
+
+	first, err := generateTemporaryPassword(deps.Logger)
+	assert.NoError(t, err)
+	assert.Len(t, first, TemporaryPasswordLength)
+
+	second, err := generateTemporaryPassword(deps.Logger)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidPatch = errors.New("invalid patch")
+
+// patchFields checks the fields of a partial update against the patchable
+// ones and returns them without duplicates. The value of allowed tells whether
+// the field may be cleared.
+func patchFields(fields []string, allowed map[string]bool) ([]string, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: no fields to update", ErrInvalidPatch)
+	}
+
+	result := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if _, ok := allowed[field]; !ok {
+			return nil, fmt.Errorf("%w: field %s cannot be updated", ErrInvalidPatch, field)
+		}
+
+		if !slices.Contains(result, field) {
+			result = append(result, field)
+		}
+	}
+
+	return result, nil
+}
+
+// patchSet returns the SET clause assigning the fields, numbered from $1, and
+// its arguments.
+func patchSet(fields []string, value func(field string) any) (string, []any) {
+	assignments := make([]string, 0, len(fields))
+	args := make([]any, 0, len(fields))
+
+	for _, field := range fields {
+		args = append(args, value(field))
+		assignments = append(assignments, fmt.Sprintf("%s = $%d", field, len(args)))
+	}
+
+	return strings.Join(assignments, ", "), args
+}
+
+// isNilValue reports whether the field value is nil or a nil pointer.
+func isNilValue(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+
+	return v.Kind() == reflect.Pointer && v.IsNil()
+}
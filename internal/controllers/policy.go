@@ -0,0 +1,278 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/adamanr/employes_service/internal/entity"
+)
+
+// Operation is the name of an API operation checked by the access policy.
+type Operation string
+
+const (
+	OpAuthLogout        Operation = "AuthLogout"
+	OpGetSessions       Operation = "GetSessions"
+	OpRevokeSession     Operation = "RevokeSession"
+	OpRevokeAllSessions Operation = "RevokeAllSessions"
+	OpChangePassword    Operation = "ChangePassword"
+	OpResetPassword     Operation = "ResetPassword"
+	OpEnrollMFA         Operation = "EnrollMFA"
+	OpEnableMFA         Operation = "EnableMFA"
+	OpDisableMFA        Operation = "DisableMFA"
+	OpGetLockouts       Operation = "GetLockouts"
+	OpClearLockout      Operation = "ClearLockout"
+	OpGetAPIKeys        Operation = "GetAPIKeys"
+	OpCreateAPIKey      Operation = "CreateAPIKey"
+	OpUpdateAPIKey      Operation = "UpdateAPIKey"
+	OpDeleteAPIKey      Operation = "DeleteAPIKey"
+	OpImpersonate       Operation = "Impersonate"
+	OpGetAuditLog       Operation = "GetAuditLog"
+	OpGetEmployees      Operation = "GetEmployees"
+	OpGetEmployeeByID   Operation = "GetEmployeeByID"
+	OpSearchEmployees   Operation = "SearchEmployees"
+	OpCreateEmployee    Operation = "CreateEmployee"
+	OpUpdateEmployee    Operation = "UpdateEmployee"
+	OpDeleteEmployee    Operation = "DeleteEmployee"
+	OpRestoreEmployee   Operation = "RestoreEmployee"
+	OpPurgeEmployee     Operation = "PurgeEmployee"
+	OpRequestVacation   Operation = "RequestVacation"
+
+	OpGetVacationRequests    Operation = "GetVacationRequests"
+	OpApproveVacationRequest Operation = "ApproveVacationRequest"
+	OpRejectVacationRequest  Operation = "RejectVacationRequest"
+	OpCancelVacationRequest  Operation = "CancelVacationRequest"
+
+	OpGetLeaveBalance Operation = "GetLeaveBalance"
+	OpGetLeaveLedger  Operation = "GetLeaveLedger"
+	OpAdjustLeave     Operation = "AdjustLeave"
+
+	OpRegisterSickLeave Operation = "RegisterSickLeave"
+	OpGetSickLeaves     Operation = "GetSickLeaves"
+	OpCloseSickLeave    Operation = "CloseSickLeave"
+
+	OpGetDepartments    Operation = "GetDepartments"
+	OpGetDepartmentByID Operation = "GetDepartmentByID"
+	OpCreateDepartment  Operation = "CreateDepartment"
+	OpUpdateDepartment  Operation = "UpdateDepartment"
+	OpDeleteDepartment  Operation = "DeleteDepartment"
+
+	OpGetCalendars       Operation = "GetCalendars"
+	OpCreateCalendar     Operation = "CreateCalendar"
+	OpDeleteCalendar     Operation = "DeleteCalendar"
+	OpGetCalendarDays    Operation = "GetCalendarDays"
+	OpImportCalendarDays Operation = "ImportCalendarDays"
+	OpDeleteCalendarDay  Operation = "DeleteCalendarDay"
+	OpCountWorkingDays   Operation = "CountWorkingDays"
+
+	OpGetCalendarTokens        Operation = "GetCalendarTokens"
+	OpCreateCalendarToken      Operation = "CreateCalendarToken"
+	OpDeleteCalendarToken      Operation = "DeleteCalendarToken"
+	OpGetEmployeeAbsenceFeed   Operation = "GetEmployeeAbsenceFeed"
+	OpGetDepartmentAbsenceFeed Operation = "GetDepartmentAbsenceFeed"
+)
+
+// Scopes granted to API keys.
+const (
+	ScopeEmployeesRead    = "employees:read"
+	ScopeEmployeesWrite   = "employees:write"
+	ScopeDepartmentsRead  = "departments:read"
+	ScopeDepartmentsWrite = "departments:write"
+)
+
+var (
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrUnknownOperation = errors.New("unknown operation")
+
+	// ErrPasswordChangeRequired is returned for every operation but the password
+	// change until the user replaces the temporary password.
+	ErrPasswordChangeRequired = fmt.Errorf("%w: password change required", ErrPermissionDenied)
+)
+
+// Permission describes who is allowed to perform an operation.
+type Permission struct {
+	// Roles that may perform the operation on any record.
+	Roles []string
+	// Self allows any authenticated user to perform the operation on their own record.
+	Self bool
+	// Scope allows API keys with the scope to perform the operation on any record.
+	// Operations without a scope are not available to API keys.
+	Scope string
+	// NotImpersonated denies the operation to impersonation tokens.
+	NotImpersonated bool
+}
+
+var allRoles = []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager, entity.RoleEmployee}
+
+var allScopes = []string{ScopeEmployeesRead, ScopeEmployeesWrite, ScopeDepartmentsRead, ScopeDepartmentsWrite}
+
+// Policies maps every protected operation to its permission.
+var Policies = map[Operation]Permission{
+	OpAuthLogout:        {Roles: allRoles},
+	OpGetSessions:       {Roles: allRoles},
+	OpRevokeSession:     {Roles: allRoles, NotImpersonated: true},
+	OpRevokeAllSessions: {Roles: allRoles, NotImpersonated: true},
+	OpChangePassword:    {Roles: allRoles, NotImpersonated: true},
+	OpResetPassword:     {Roles: []string{entity.RoleAdmin, entity.RoleHR}, NotImpersonated: true},
+	OpEnrollMFA:         {Roles: allRoles, NotImpersonated: true},
+	OpEnableMFA:         {Roles: allRoles, NotImpersonated: true},
+	OpDisableMFA:        {Roles: allRoles, NotImpersonated: true},
+	OpGetLockouts:       {Roles: []string{entity.RoleAdmin}},
+	OpClearLockout:      {Roles: []string{entity.RoleAdmin}},
+	OpGetAPIKeys:        {Roles: []string{entity.RoleAdmin}},
+	OpCreateAPIKey:      {Roles: []string{entity.RoleAdmin}},
+	OpUpdateAPIKey:      {Roles: []string{entity.RoleAdmin}},
+	OpDeleteAPIKey:      {Roles: []string{entity.RoleAdmin}},
+	OpImpersonate:       {Roles: []string{entity.RoleAdmin}, NotImpersonated: true},
+	OpGetAuditLog:       {Roles: []string{entity.RoleAdmin}},
+	OpGetEmployees:      {Roles: []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager}, Scope: ScopeEmployeesRead},
+	OpGetEmployeeByID:   {Roles: []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager}, Self: true, Scope: ScopeEmployeesRead},
+	OpSearchEmployees:   {Roles: []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager}, Scope: ScopeEmployeesRead},
+	OpCreateEmployee:    {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeEmployeesWrite},
+	OpUpdateEmployee:    {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Self: true, Scope: ScopeEmployeesWrite},
+	OpDeleteEmployee:    {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeEmployeesWrite},
+	OpRestoreEmployee:   {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeEmployeesWrite},
+	OpPurgeEmployee:     {Roles: []string{entity.RoleAdmin}, NotImpersonated: true},
+	OpRequestVacation:   {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Self: true, Scope: ScopeEmployeesWrite},
+	// The vacation controller narrows the listing to the team of a manager and
+	// checks who may decide on a request at its current stage.
+	OpGetVacationRequests:    {Roles: allRoles, Scope: ScopeEmployeesRead},
+	OpApproveVacationRequest: {Roles: []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager}},
+	OpRejectVacationRequest:  {Roles: []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager}},
+	OpCancelVacationRequest:  {Roles: allRoles},
+
+	OpGetLeaveBalance: {Roles: []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager}, Self: true, Scope: ScopeEmployeesRead},
+	OpGetLeaveLedger:  {Roles: []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager}, Self: true, Scope: ScopeEmployeesRead},
+	OpAdjustLeave:     {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeEmployeesWrite},
+
+	// The sick leave controller narrows the listing like the vacation one and
+	// lets employees close their own leaves.
+	OpRegisterSickLeave: {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Self: true, Scope: ScopeEmployeesWrite},
+	OpGetSickLeaves:     {Roles: allRoles, Scope: ScopeEmployeesRead},
+	OpCloseSickLeave:    {Roles: allRoles, Scope: ScopeEmployeesWrite},
+
+	OpGetDepartments:    {Roles: allRoles, Scope: ScopeDepartmentsRead},
+	OpGetDepartmentByID: {Roles: allRoles, Scope: ScopeDepartmentsRead},
+	OpCreateDepartment:  {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeDepartmentsWrite},
+	OpUpdateDepartment:  {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeDepartmentsWrite},
+	OpDeleteDepartment:  {Roles: []string{entity.RoleAdmin}, Scope: ScopeDepartmentsWrite},
+
+	OpGetCalendars:       {Roles: allRoles, Scope: ScopeDepartmentsRead},
+	OpCreateCalendar:     {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeDepartmentsWrite},
+	OpDeleteCalendar:     {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeDepartmentsWrite},
+	OpGetCalendarDays:    {Roles: allRoles, Scope: ScopeDepartmentsRead},
+	OpImportCalendarDays: {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeDepartmentsWrite},
+	OpDeleteCalendarDay:  {Roles: []string{entity.RoleAdmin, entity.RoleHR}, Scope: ScopeDepartmentsWrite},
+	OpCountWorkingDays:   {Roles: []string{entity.RoleAdmin, entity.RoleHR, entity.RoleManager}, Self: true, Scope: ScopeEmployeesRead},
+
+	// Calendar tokens are managed by their owners. The feeds are read with a
+	// token instead of the Authorization header: the calendar feed controller
+	// checks the roles below and lets the others see their own feed, the one of
+	// their team and of the department they work in or head.
+	OpGetCalendarTokens:        {Roles: allRoles},
+	OpCreateCalendarToken:      {Roles: allRoles, NotImpersonated: true},
+	OpDeleteCalendarToken:      {Roles: allRoles, NotImpersonated: true},
+	OpGetEmployeeAbsenceFeed:   {Roles: []string{entity.RoleAdmin, entity.RoleHR}},
+	OpGetDepartmentAbsenceFeed: {Roles: []string{entity.RoleAdmin, entity.RoleHR}},
+}
+
+// HasRole reports whether the role is granted the operation on any record.
+func HasRole(role string, op Operation) bool {
+	perm, ok := Policies[op]
+	if !ok {
+		return false
+	}
+
+	return slices.Contains(perm.Roles, role)
+}
+
+// HasAccess reports whether the user is granted the operation on any record, by
+// the role for employees and by the scope for API keys.
+func HasAccess(user *entity.Claims, op Operation) bool {
+	if user.Type != TokenTypeAPIKey {
+		return HasRole(user.Role, op)
+	}
+
+	perm, ok := Policies[op]
+	if !ok {
+		return false
+	}
+
+	return perm.Scope != "" && slices.Contains(user.Scopes, perm.Scope)
+}
+
+// ManagesEmployee reports whether the user changes the employee record as admin
+// or hr rather than through the own profile. Only admins manage their own
+// record, so that hr cannot raise its own role.
+func ManagesEmployee(user *entity.Claims, employeeID uint64) bool {
+	if !HasAccess(user, OpUpdateEmployee) {
+		return false
+	}
+
+	return user.Type == TokenTypeAPIKey || user.Role == entity.RoleAdmin || user.ID != employeeID
+}
+
+// MayManageRole reports whether the user may change an employee with the
+// current role and give them the new one, empty if the role is kept. The admin
+// role is granted, taken and edited by admins only.
+func MayManageRole(user *entity.Claims, currentRole, newRole string) bool {
+	if user.Type != TokenTypeAPIKey && user.Role == entity.RoleAdmin {
+		return true
+	}
+
+	return currentRole != entity.RoleAdmin && newRole != entity.RoleAdmin
+}
+
+// Authorize checks that the user may perform the operation. The targetID is the
+// employee the operation is applied to and is used by self rules, nil if the
+// operation has no owner. Requests made with impersonation tokens are written
+// to the audit trail, whether allowed or not.
+func (c *AuthController) Authorize(user *entity.Claims, op Operation, targetID *uint64) error {
+	err := c.authorize(user, op, targetID)
+	if user.ActorID == 0 {
+		return err
+	}
+
+	return c.auditImpersonated(user, op, targetID, err)
+}
+
+func (c *AuthController) authorize(user *entity.Claims, op Operation, targetID *uint64) error {
+	perm, ok := Policies[op]
+	if !ok {
+		c.deps.Logger.Error("Operation has no access policy", slog.Any("operation", op))
+		return ErrUnknownOperation
+	}
+
+	if user.ActorID != 0 && perm.NotImpersonated {
+		c.deps.Logger.Warn("Operation denied while impersonating", slog.Any("operation", op), slog.Any("user_id", user.ID), slog.Any("actor_id", user.ActorID))
+		return ErrImpersonationRestricted
+	}
+
+	if user.PasswordChangeRequired && op != OpChangePassword {
+		c.deps.Logger.Warn("Password change required", slog.Any("operation", op), slog.Any("user_id", user.ID))
+		return ErrPasswordChangeRequired
+	}
+
+	if user.MFAEnrollmentRequired && op != OpEnrollMFA && op != OpEnableMFA && op != OpChangePassword {
+		c.deps.Logger.Warn("MFA enrollment required", slog.Any("operation", op), slog.Any("user_id", user.ID))
+		return ErrMFAEnrollmentRequired
+	}
+
+	if HasAccess(user, op) {
+		return nil
+	}
+
+	if user.Type != TokenTypeAPIKey && perm.Self && targetID != nil && *targetID == user.ID {
+		return nil
+	}
+
+	c.deps.Logger.Warn("Permission denied",
+		slog.Any("operation", op),
+		slog.Any("user_id", user.ID),
+		slog.String("role", user.Role),
+		slog.Any("api_key_id", user.APIKeyID),
+	)
+	return ErrPermissionDenied
+}
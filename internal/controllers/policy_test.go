@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthController_Authorize(t *testing.T) {
+	tests := []struct {
+		name        string
+		user        *entity.Claims
+		op          Operation
+		targetID    *uint64
+		expectedErr error
+	}{
+		{
+			name: "admin can delete department",
+			user: &entity.Claims{ID: 1, Role: entity.RoleAdmin},
+			op:   OpDeleteDepartment,
+		},
+		{
+			name:        "hr cannot delete department",
+			user:        &entity.Claims{ID: 2, Role: entity.RoleHR},
+			op:          OpDeleteDepartment,
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name:        "pending password change blocks admin",
+			user:        &entity.Claims{ID: 1, Role: entity.RoleAdmin, PasswordChangeRequired: true},
+			op:          OpGetEmployees,
+			expectedErr: ErrPasswordChangeRequired,
+		},
+		{
+			name: "pending password change allows change password",
+			user: &entity.Claims{ID: 3, Role: entity.RoleEmployee, PasswordChangeRequired: true},
+			op:   OpChangePassword,
+		},
+		{
+			name:        "pending mfa enrollment blocks hr",
+			user:        &entity.Claims{ID: 2, Role: entity.RoleHR, MFAEnrollmentRequired: true},
+			op:          OpGetEmployees,
+			expectedErr: ErrMFAEnrollmentRequired,
+		},
+		{
+			name: "pending mfa enrollment allows enable mfa",
+			user: &entity.Claims{ID: 2, Role: entity.RoleHR, MFAEnrollmentRequired: true},
+			op:   OpEnableMFA,
+		},
+		{
+			name: "hr can create employee",
+			user: &entity.Claims{ID: 2, Role: entity.RoleHR},
+			op:   OpCreateEmployee,
+		},
+		{
+			name:        "employee cannot create employee",
+			user:        &entity.Claims{ID: 3, Role: entity.RoleEmployee},
+			op:          OpCreateEmployee,
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name:        "employee cannot list employees",
+			user:        &entity.Claims{ID: 3, Role: entity.RoleEmployee},
+			op:          OpGetEmployees,
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name:     "employee can read own record",
+			user:     &entity.Claims{ID: 3, Role: entity.RoleEmployee},
+			op:       OpGetEmployeeByID,
+			targetID: Uint64Ptr(3),
+		},
+		{
+			name:        "employee cannot read other record",
+			user:        &entity.Claims{ID: 3, Role: entity.RoleEmployee},
+			op:          OpGetEmployeeByID,
+			targetID:    Uint64Ptr(4),
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name:     "employee can update own record",
+			user:     &entity.Claims{ID: 3, Role: entity.RoleEmployee},
+			op:       OpUpdateEmployee,
+			targetID: Uint64Ptr(3),
+		},
+		{
+			name:        "manager cannot update other record",
+			user:        &entity.Claims{ID: 5, Role: entity.RoleManager},
+			op:          OpUpdateEmployee,
+			targetID:    Uint64Ptr(3),
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name:        "self rule needs target",
+			user:        &entity.Claims{ID: 3, Role: entity.RoleEmployee},
+			op:          OpRequestVacation,
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name: "employee can read departments",
+			user: &entity.Claims{ID: 3, Role: entity.RoleEmployee},
+			op:   OpGetDepartments,
+		},
+		{
+			name:        "unknown role is denied",
+			user:        &entity.Claims{ID: 6, Role: "guest"},
+			op:          OpGetDepartments,
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name: "api key with scope can list employees",
+			user: &entity.Claims{Type: TokenTypeAPIKey, APIKeyID: 7, Scopes: []string{ScopeEmployeesRead}},
+			op:   OpGetEmployees,
+		},
+		{
+			name:        "api key without scope cannot update employee",
+			user:        &entity.Claims{Type: TokenTypeAPIKey, APIKeyID: 7, Scopes: []string{ScopeEmployeesRead}},
+			op:          OpUpdateEmployee,
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name:        "api key cannot manage api keys",
+			user:        &entity.Claims{Type: TokenTypeAPIKey, APIKeyID: 7, Scopes: allScopes},
+			op:          OpCreateAPIKey,
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			name:        "unknown operation is denied",
+			user:        &entity.Claims{ID: 1, Role: entity.RoleAdmin},
+			op:          Operation("Unknown"),
+			expectedErr: ErrUnknownOperation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := CreateTestDependencies(&MockDB{}, &MockRedis{})
+			controller := NewAuthController(deps)
+
+			err := controller.Authorize(tt.user, tt.op, tt.targetID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicies_CoverAllRoles(t *testing.T) {
+	for op, perm := range Policies {
+		for _, role := range perm.Roles {
+			assert.Contains(t, allRoles, role, "operation %s", op)
+		}
+	}
+
+	assert.True(t, HasRole(entity.RoleAdmin, OpUpdateEmployee))
+	assert.False(t, HasRole(entity.RoleEmployee, OpUpdateEmployee))
+	assert.False(t, HasRole(entity.RoleAdmin, Operation("Unknown")))
+
+	for op, perm := range Policies {
+		if perm.Scope != "" {
+			assert.Contains(t, allScopes, perm.Scope, "operation %s", op)
+		}
+	}
+}
+
+func TestManagesEmployee(t *testing.T) {
+	assert.True(t, ManagesEmployee(&entity.Claims{ID: 1, Role: entity.RoleAdmin}, 1))
+	assert.True(t, ManagesEmployee(&entity.Claims{ID: 2, Role: entity.RoleHR}, 3))
+	assert.False(t, ManagesEmployee(&entity.Claims{ID: 2, Role: entity.RoleHR}, 2), "hr edits itself through the own profile")
+	assert.False(t, ManagesEmployee(&entity.Claims{ID: 3, Role: entity.RoleEmployee}, 3))
+	assert.True(t, ManagesEmployee(&entity.Claims{Type: TokenTypeAPIKey, APIKeyID: 7, Scopes: []string{ScopeEmployeesWrite}}, 3))
+}
+
+func TestMayManageRole(t *testing.T) {
+	admin := &entity.Claims{ID: 1, Role: entity.RoleAdmin}
+	hr := &entity.Claims{ID: 2, Role: entity.RoleHR}
+	apiKey := &entity.Claims{Type: TokenTypeAPIKey, APIKeyID: 7, Scopes: allScopes}
+
+	assert.True(t, MayManageRole(admin, entity.RoleAdmin, entity.RoleEmployee))
+	assert.True(t, MayManageRole(admin, entity.RoleHR, entity.RoleAdmin))
+	assert.True(t, MayManageRole(hr, entity.RoleEmployee, entity.RoleManager))
+	assert.True(t, MayManageRole(hr, entity.RoleEmployee, ""))
+	assert.False(t, MayManageRole(hr, entity.RoleHR, entity.RoleAdmin), "hr promotes to admin")
+	assert.False(t, MayManageRole(hr, entity.RoleAdmin, ""), "hr edits admin")
+	assert.False(t, MayManageRole(hr, entity.RoleAdmin, entity.RoleEmployee), "hr demotes admin")
+	assert.False(t, MayManageRole(apiKey, entity.RoleEmployee, entity.RoleAdmin))
+	assert.False(t, MayManageRole(&entity.Claims{Type: TokenTypeAPIKey, Role: entity.RoleAdmin}, entity.RoleAdmin, ""))
+}
@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+
+	// minPhoneDigits is the shortest digit run of the query matched against phones.
+	minPhoneDigits = 3
+)
+
+var ErrEmptySearchQuery = errors.New("search query is empty")
+
+// SearchEmployees returns the employees matching the query by words, by
+// trigram similarity or by substring of their names, email, phone, position
+// and personal number, best matches first. With Translit the query is also
+// matched in the other script, so "Ivanov" finds "Иванов" and back. As in the
+// listing, terminated employees are found only when asked for by status.
+func (c *EmployeeController) SearchEmployees(params *entity.SearchEmployeesParams) ([]entity.Employee, error) {
+	q := normalizeSearchQuery(params.Q)
+	if q == "" {
+		return nil, ErrEmptySearchQuery
+	}
+
+	limit := DefaultSearchLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > MaxSearchLimit {
+			return nil, fmt.Errorf("%w: must be between 1 and %d", ErrInvalidPageSize, MaxSearchLimit)
+		}
+
+		limit = *params.Limit
+	}
+
+	terms := []string{q}
+	if params.Translit != nil && *params.Translit {
+		if t := transliterate(q); t != q {
+			terms = append(terms, t)
+		}
+	}
+
+	var matches, scores []string
+	args := []any{}
+
+	for _, term := range terms {
+		args = append(args, term, "%"+escapeLike(term)+"%")
+		termArg, likeArg := len(args)-1, len(args)
+
+		matches = append(matches, fmt.Sprintf(
+			"search_vector @@ plainto_tsquery('simple', $%d) OR $%d <%% search_text OR search_text LIKE $%d",
+			termArg, termArg, likeArg))
+		scores = append(scores, fmt.Sprintf(
+			"ts_rank(search_vector, plainto_tsquery('simple', $%d)) + word_similarity($%d, search_text)",
+			termArg, termArg))
+	}
+
+	if digits := onlyDigits(q); len(digits) >= minPhoneDigits {
+		args = append(args, "%"+digits+"%")
+		matches = append(matches, fmt.Sprintf("phone_digits LIKE $%d", len(args)))
+		scores = append(scores, fmt.Sprintf("(phone_digits LIKE $%d)::int", len(args)))
+	}
+
+	status := "status <> '" + entity.StatusFired + "'"
+	if params.Status != nil {
+		args = append(args, *params.Status)
+		status = fmt.Sprintf("status = $%d", len(args))
+	}
+
+	query := "SELECT " + employeeColumns + " FROM employees WHERE (" + strings.Join(matches, " OR ") + ") AND " + status +
+		" ORDER BY GREATEST(" + strings.Join(scores, ", ") + ") DESC, id" + fmt.Sprintf(" LIMIT %d", limit)
+
+	rows, err := c.deps.DB.Query(context.Background(), query, args...)
+	if err != nil {
+		c.deps.Logger.Error("Error searching employees", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	employees, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.Employee])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	for i := range employees {
+		employees[i].Password = nil
+	}
+
+	return employees, nil
+}
+
+// normalizeSearchQuery lowercases the query, collapses spaces and replaces ё
+// with е the same way the search columns are built.
+func normalizeSearchQuery(q string) string {
+	q = strings.Join(strings.Fields(strings.ToLower(q)), " ")
+
+	return strings.ReplaceAll(q, "ё", "е")
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func onlyDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+
+		return -1
+	}, s)
+}
+
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p",
+	'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch",
+	'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// latinToCyrillic is tried longest sequence first.
+var latinToCyrillic = []struct {
+	latin    string
+	cyrillic string
+}{
+	{"shch", "щ"},
+	{"zh", "ж"}, {"kh", "х"}, {"ts", "ц"}, {"ch", "ч"}, {"sh", "ш"},
+	{"yu", "ю"}, {"ya", "я"}, {"yo", "е"}, {"iy", "ий"}, {"yy", "ый"},
+	{"a", "а"}, {"b", "б"}, {"c", "к"}, {"d", "д"}, {"e", "е"}, {"f", "ф"},
+	{"g", "г"}, {"h", "х"}, {"i", "и"}, {"j", "й"}, {"k", "к"}, {"l", "л"},
+	{"m", "м"}, {"n", "н"}, {"o", "о"}, {"p", "п"}, {"q", "к"}, {"r", "р"},
+	{"s", "с"}, {"t", "т"}, {"u", "у"}, {"v", "в"}, {"w", "в"}, {"x", "кс"},
+	{"y", "ы"}, {"z", "з"},
+}
+
+// transliterate converts a lowercase query written in Cyrillic to Latin and
+// any other query to Cyrillic. The result is approximate, which is enough for
+// the trigram match.
+func transliterate(q string) string {
+	var b strings.Builder
+
+	if strings.IndexFunc(q, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) >= 0 {
+		for _, r := range q {
+			if latin, ok := cyrillicToLatin[r]; ok {
+				b.WriteString(latin)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+
+		return b.String()
+	}
+
+	for i := 0; i < len(q); {
+		matched := false
+
+		for _, pair := range latinToCyrillic {
+			if strings.HasPrefix(q[i:], pair.latin) {
+				b.WriteString(pair.cyrillic)
+				i += len(pair.latin)
+				matched = true
+
+				break
+			}
+		}
+
+		if !matched {
+			b.WriteByte(q[i])
+			i++
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmployeeController_SearchEmployees(t *testing.T) {
+	hired := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("ranked by words, trigrams and substring", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything,
+			queryContains(
+				"WHERE (search_vector @@ plainto_tsquery('simple', $1) OR $1 <% search_text OR search_text LIKE $2) AND status <> 'fired'",
+				"ORDER BY GREATEST(ts_rank(search_vector, plainto_tsquery('simple', $1)) + word_similarity($1, search_text)) DESC, id LIMIT 20",
+			),
+			"петр семенов", "%петр семенов%",
+		).Return(NewMockRows([][]interface{}{employeeListRow(1, "Семёнов", hired)}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		employees, err := controller.SearchEmployees(&entity.SearchEmployeesParams{Q: "  Пётр   Семёнов "})
+		require.NoError(t, err)
+		require.Len(t, employees, 1)
+		assert.Nil(t, employees[0].Password)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("transliteration", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything,
+			queryContains("OR search_vector @@ plainto_tsquery('simple', $3) OR $3 <% search_text OR search_text LIKE $4"),
+			"ivanov", "%ivanov%", "иванов", "%иванов%",
+		).Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil).Once()
+
+		_, err := controller.SearchEmployees(&entity.SearchEmployeesParams{Q: "Ivanov", Translit: BoolPtr(true)})
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("phone fragment", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, queryContains("OR phone_digits LIKE $3", "(phone_digits LIKE $3)::int) DESC"),
+			"912-34", "%912-34%", "%91234%",
+		).Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil).Once()
+
+		_, err := controller.SearchEmployees(&entity.SearchEmployeesParams{Q: "912-34"})
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("like wildcards are escaped", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, mock.Anything, "100%_", `%100\%\_%`, "%100%").
+			Return(NewMockRows(nil, nil, EmployeeFieldDescriptions), nil).Once()
+
+		_, err := controller.SearchEmployees(&entity.SearchEmployeesParams{Q: "100%_"})
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("fired employee by status", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewEmployeeController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		fired := employeeListRow(7, "Petrov", hired)
+		fired[6] = entity.StatusFired
+		mockDB.On("Query", mock.Anything, queryContains("search_text LIKE $2) AND status = $3 ORDER BY"),
+			"petrov", "%petrov%", entity.StatusFired,
+		).Return(NewMockRows([][]interface{}{fired}, nil, EmployeeFieldDescriptions), nil).Once()
+
+		employees, err := controller.SearchEmployees(&entity.SearchEmployeesParams{Q: "Petrov", Status: StringPtr(entity.StatusFired)})
+		require.NoError(t, err)
+		require.Len(t, employees, 1)
+		assert.Equal(t, entity.StatusFired, employees[0].Status)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		controller := NewEmployeeController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.SearchEmployees(&entity.SearchEmployeesParams{Q: "   "})
+		assert.ErrorIs(t, err, ErrEmptySearchQuery)
+	})
+
+	t.Run("limit over max", func(t *testing.T) {
+		controller := NewEmployeeController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		_, err := controller.SearchEmployees(&entity.SearchEmployeesParams{Q: "ivan", Limit: IntPtr(MaxSearchLimit + 1)})
+		assert.ErrorIs(t, err, ErrInvalidPageSize)
+	})
+}
+
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{in: "иванов", expected: "ivanov"},
+		{in: "щукина", expected: "shchukina"},
+		{in: "юрьев", expected: "yurev"},
+		{in: "zhukov", expected: "жуков"},
+		{in: "dmitriy", expected: "дмитрий"},
+		{in: "yakovleva", expected: "яковлева"},
+		{in: "12345", expected: "12345"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.expected, transliterate(tt.in))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionStore keeps the sessions of every employee in Redis. A session is stored
+// under session:<token_id> and its id is added to the user_sessions:<employee_id> set.
+type SessionStore struct {
+	deps *Dependens
+}
+
+func NewSessionStore(deps *Dependens) *SessionStore {
+	return &SessionStore{
+		deps: deps,
+	}
+}
+
+// Save stores the session with its current tokens and registers it for the employee.
+func (s *SessionStore) Save(ctx context.Context, session *entity.Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		s.deps.Logger.Error("Error marshaling session", slog.String("error", err.Error()))
+		return err
+	}
+
+	if err = s.deps.Redis.Set(ctx, "session:"+session.ID, data, s.deps.Config.Redis.RefreshTokenTTL).Err(); err != nil {
+		s.deps.Logger.Error("Error setting session", slog.String("error", err.Error()))
+		return err
+	}
+
+	if err = s.deps.Redis.SAdd(ctx, userSessionsKey(session.UserID), session.ID).Err(); err != nil {
+		s.deps.Logger.Error("Error registering session", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// Get returns the session by id, redis.Nil if it expired or was revoked.
+func (s *SessionStore) Get(ctx context.Context, id string) (*entity.Session, error) {
+	data, err := s.deps.Redis.Get(ctx, "session:"+id).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var session entity.Session
+	if err = json.Unmarshal(data, &session); err != nil {
+		s.deps.Logger.Error("Error unmarshaling session", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// List returns the active sessions of the employee and forgets the expired ones.
+func (s *SessionStore) List(ctx context.Context, userID uint64) ([]entity.Session, error) {
+	ids, err := s.deps.Redis.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		s.deps.Logger.Error("Error getting user sessions", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	sessions := make([]entity.Session, 0, len(ids))
+	for _, id := range ids {
+		session, getErr := s.Get(ctx, id)
+		if getErr != nil {
+			if errors.Is(getErr, redis.Nil) {
+				s.deps.Redis.SRem(ctx, userSessionsKey(userID), id)
+				continue
+			}
+
+			return nil, getErr
+		}
+
+		sessions = append(sessions, *session)
+	}
+
+	return sessions, nil
+}
+
+// Revoke deletes the tokens of the session and the session itself.
+func (s *SessionStore) Revoke(ctx context.Context, id string) error {
+	session, err := s.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil
+		}
+
+		return err
+	}
+
+	if err = s.deps.Redis.Del(ctx,
+		"access_token:"+session.AccessToken,
+		"refresh_token:"+session.RefreshToken,
+		"session:"+id,
+	).Err(); err != nil {
+		s.deps.Logger.Error("Error revoking session", slog.String("error", err.Error()))
+		return err
+	}
+
+	if err = s.deps.Redis.SRem(ctx, userSessionsKey(session.UserID), id).Err(); err != nil {
+		s.deps.Logger.Error("Error unregistering session", slog.String("error", err.Error()))
+		return err
+	}
+
+	s.deps.Logger.Info("Session revoked", slog.Any("user_id", session.UserID), slog.String("session_id", id))
+
+	return nil
+}
+
+// RevokeAll revokes every session of the employee.
+func (s *SessionStore) RevokeAll(ctx context.Context, userID uint64) error {
+	ids, err := s.deps.Redis.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		s.deps.Logger.Error("Error getting user sessions", slog.String("error", err.Error()))
+		return err
+	}
+
+	for _, id := range ids {
+		if err = s.Revoke(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return s.deps.Redis.Del(ctx, userSessionsKey(userID)).Err()
+}
+
+func userSessionsKey(userID uint64) string {
+	return "user_sessions:" + strconv.FormatUint(userID, 10)
+}
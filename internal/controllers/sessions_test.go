@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func sessionCmd(session entity.Session) *redis.StringCmd {
+	data, _ := json.Marshal(session)
+	cmd := redis.NewStringCmd(context.Background())
+	cmd.SetVal(string(data))
+	return cmd
+}
+
+func missingCmd() *redis.StringCmd {
+	cmd := redis.NewStringCmd(context.Background())
+	cmd.SetErr(redis.Nil)
+	return cmd
+}
+
+func TestAuthController_AuthLogout(t *testing.T) {
+	mockDB := &MockDB{}
+	mockRedis := &MockRedis{}
+	deps := CreateTestDependencies(mockDB, mockRedis)
+	controller := NewAuthController(deps)
+
+	mockRedis.On("Get", mock.Anything, "session:current").Return(sessionCmd(entity.Session{
+		ID:           "current",
+		UserID:       1,
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+	}))
+	mockRedis.On("Del", mock.Anything, []string{"access_token:access", "refresh_token:refresh", "session:current"}).Return(nil)
+	mockRedis.On("SRem", mock.Anything, "user_sessions:1", []interface{}{"current"}).Return(nil)
+
+	err := controller.AuthLogout(&entity.Claims{ID: 1, TokenID: "current"})
+
+	assert.NoError(t, err)
+	mockRedis.AssertExpectations(t)
+}
+
+func TestAuthController_ListSessions(t *testing.T) {
+	mockDB := &MockDB{}
+	mockRedis := &MockRedis{}
+	deps := CreateTestDependencies(mockDB, mockRedis)
+	controller := NewAuthController(deps)
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockRedis.On("SMembers", mock.Anything, "user_sessions:1").Return([]string{"current", "other", "expired"})
+	mockRedis.On("Get", mock.Anything, "session:current").Return(sessionCmd(entity.Session{ID: "current", UserID: 1, CreatedAt: createdAt}))
+	mockRedis.On("Get", mock.Anything, "session:other").Return(sessionCmd(entity.Session{ID: "other", UserID: 1, CreatedAt: createdAt}))
+	mockRedis.On("Get", mock.Anything, "session:expired").Return(missingCmd())
+	mockRedis.On("SRem", mock.Anything, "user_sessions:1", []interface{}{"expired"}).Return(nil)
+
+	sessions, err := controller.ListSessions(&entity.Claims{ID: 1, TokenID: "current"})
+
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, "current", sessions[0].ID)
+	assert.True(t, sessions[0].Current)
+	assert.Equal(t, createdAt, sessions[0].CreatedAt)
+	assert.Equal(t, "other", sessions[1].ID)
+	assert.False(t, sessions[1].Current)
+	mockRedis.AssertExpectations(t)
+}
+
+func TestAuthController_RevokeSession(t *testing.T) {
+	tests := []struct {
+		name        string
+		sessionID   string
+		setupMocks  func(*MockRedis)
+		expectedErr error
+	}{
+		{
+			name:      "own session",
+			sessionID: "other",
+			setupMocks: func(mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, "session:other").Return(sessionCmd(entity.Session{
+					ID:           "other",
+					UserID:       1,
+					AccessToken:  "access",
+					RefreshToken: "refresh",
+				}))
+				mockRedis.On("Del", mock.Anything, []string{"access_token:access", "refresh_token:refresh", "session:other"}).Return(nil)
+				mockRedis.On("SRem", mock.Anything, "user_sessions:1", []interface{}{"other"}).Return(nil)
+			},
+		},
+		{
+			name:      "session of another user",
+			sessionID: "foreign",
+			setupMocks: func(mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, "session:foreign").Return(sessionCmd(entity.Session{ID: "foreign", UserID: 2}))
+			},
+			expectedErr: ErrSessionNotFound,
+		},
+		{
+			name:      "unknown session",
+			sessionID: "unknown",
+			setupMocks: func(mockRedis *MockRedis) {
+				mockRedis.On("Get", mock.Anything, "session:unknown").Return(missingCmd())
+			},
+			expectedErr: ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			mockRedis := &MockRedis{}
+			deps := CreateTestDependencies(mockDB, mockRedis)
+			controller := NewAuthController(deps)
+
+			tt.setupMocks(mockRedis)
+
+			err := controller.RevokeSession(&entity.Claims{ID: 1, TokenID: "current"}, tt.sessionID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRedis.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_RevokeAllSessions(t *testing.T) {
+	mockDB := &MockDB{}
+	mockRedis := &MockRedis{}
+	deps := CreateTestDependencies(mockDB, mockRedis)
+	controller := NewAuthController(deps)
+
+	mockRedis.On("SMembers", mock.Anything, "user_sessions:1").Return([]string{"first", "second"})
+	for _, id := range []string{"first", "second"} {
+		mockRedis.On("Get", mock.Anything, "session:"+id).Return(sessionCmd(entity.Session{
+			ID:           id,
+			UserID:       1,
+			AccessToken:  id + "-access",
+			RefreshToken: id + "-refresh",
+		}))
+		mockRedis.On("Del", mock.Anything, []string{"access_token:" + id + "-access", "refresh_token:" + id + "-refresh", "session:" + id}).Return(nil)
+		mockRedis.On("SRem", mock.Anything, "user_sessions:1", []interface{}{id}).Return(nil)
+	}
+	mockRedis.On("Del", mock.Anything, []string{"user_sessions:1"}).Return(nil)
+
+	err := controller.RevokeAllSessions(&entity.Claims{ID: 1, TokenID: "first"})
+
+	assert.NoError(t, err)
+	mockRedis.AssertExpectations(t)
+}
@@ -0,0 +1,365 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SickStatusCheckInterval is how often the status of employees is brought in
+// line with their sick leaves, as leaves start and end with the date.
+const SickStatusCheckInterval = 10 * time.Minute
+
+// sickLeaveColumns are the columns of sick_leaves mapped to entity.SickLeave.
+const sickLeaveColumns = `id, employee_id, start_date, end_date,
+	(COALESCE(end_date, GREATEST(CURRENT_DATE, start_date)) - start_date + 1)::BIGINT AS days,
+	certificate_number, comment, created_by, closed_by, created_at, updated_at`
+
+const (
+	pgUniqueViolation = "23505"
+
+	// sickCertificateIndex keeps a medical certificate from being registered twice.
+	sickCertificateIndex = "idx_sick_leaves_certificate"
+
+	maxCertificateNumberLength = 64
+)
+
+var (
+	ErrInvalidSickLeave      = errors.New("invalid sick leave")
+	ErrSickLeaveNotFound     = errors.New("sick leave not found")
+	ErrSickLeaveOverlap      = errors.New("sick leave overlaps another sick leave")
+	ErrSickLeaveClosed       = errors.New("sick leave is already closed")
+	ErrSickCertificateExists = errors.New("medical certificate is already registered")
+	ErrNotSickLeaveEmployee  = fmt.Errorf("%w: sick leave of another employee", ErrPermissionDenied)
+)
+
+// SickLeaveController registers sick leaves. While an employee has a leave
+// covering today their status is sick, and the sick days of the employee are
+// counted from the leaves.
+type SickLeaveController struct {
+	deps *Dependens
+}
+
+func NewSickLeaveController(deps *Dependens) *SickLeaveController {
+	return &SickLeaveController{
+		deps: deps,
+	}
+}
+
+// Run syncs the status of all employees every SickStatusCheckInterval until
+// the context is done.
+func (c *SickLeaveController) Run(ctx context.Context) {
+	ticker := time.NewTicker(SickStatusCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		_ = c.SyncStatuses(ctx, nil)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncStatuses switches active employees with a sick leave covering today to
+// sick, and sick employees without one back to active. Fired and suspended
+// employees keep their status. With employeeID only that employee is synced.
+func (c *SickLeaveController) SyncStatuses(ctx context.Context, employeeID *uint64) error {
+	query := `UPDATE employees SET status = CASE WHEN status = $1 THEN $2 ELSE $1 END, updated_at = $4, version = version + 1
+              WHERE status IN ($1, $2) AND ($5::bigint IS NULL OR id = $5)
+                AND (status = $2) <> EXISTS (SELECT 1 FROM sick_leaves
+                                             WHERE employee_id = employees.id AND start_date <= $3 AND (end_date IS NULL OR end_date >= $3))`
+
+	tag, err := c.deps.DB.Exec(ctx, query, entity.StatusActive, entity.StatusSick, dateOnly(time.Now()), time.Now(), employeeID)
+	if err != nil {
+		c.deps.Logger.Error("Error syncing sick status", slog.String("error", err.Error()))
+		return err
+	}
+
+	if tag.RowsAffected() > 0 {
+		c.deps.Logger.Info("Sick status synced", slog.Int64("employees", tag.RowsAffected()))
+	}
+
+	return nil
+}
+
+// RegisterSickLeave records a sick leave of the employee. Without an end date
+// the leave is open until it is closed. Leaves may be registered after the
+// fact, but not ahead of time.
+func (c *SickLeaveController) RegisterSickLeave(user *entity.Claims, employeeID uint64, form entity.SickLeaveForm) (*entity.SickLeave, error) {
+	startDate := dateOnly(form.StartDate)
+
+	var endDate *time.Time
+	if form.EndDate != nil {
+		date := dateOnly(*form.EndDate)
+		endDate = &date
+	}
+
+	if err := validateSickLeave(startDate, endDate, form.CertificateNumber); err != nil {
+		c.deps.Logger.Warn("Invalid sick leave", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if startDate.After(dateOnly(time.Now())) {
+		c.deps.Logger.Warn("Invalid sick leave", slog.String("error", "start_date is in the future"))
+		return nil, fmt.Errorf("%w: start_date is in the future", ErrInvalidSickLeave)
+	}
+
+	ctx := context.Background()
+
+	var exists, overlaps bool
+
+	query := `SELECT EXISTS (SELECT 1 FROM employees WHERE id = $1 AND status <> $2),
+                     EXISTS (SELECT 1 FROM sick_leaves
+                             WHERE employee_id = $1 AND start_date <= COALESCE($4::date, 'infinity') AND (end_date IS NULL OR end_date >= $3))`
+
+	if err := c.deps.DB.QueryRow(ctx, query, employeeID, entity.StatusFired, startDate, endDate).Scan(&exists, &overlaps); err != nil {
+		c.deps.Logger.Error("Error checking sick leave overlap", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrEmployeeNotFound
+	}
+
+	if overlaps {
+		return nil, ErrSickLeaveOverlap
+	}
+
+	now := time.Now()
+	query = `INSERT INTO sick_leaves (employee_id, start_date, end_date, certificate_number, comment, created_by, created_at, updated_at)
+             VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+             RETURNING ` + sickLeaveColumns
+
+	rows, err := c.deps.DB.Query(ctx, query, employeeID, startDate, endDate, trimmedCertificate(form.CertificateNumber),
+		form.Comment, actorID(user), now)
+	if err != nil {
+		return nil, c.sickLeaveError(err)
+	}
+	defer rows.Close()
+
+	leave, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.SickLeave])
+	if err != nil {
+		return nil, c.sickLeaveError(err)
+	}
+
+	c.deps.Logger.Info("Sick leave registered",
+		slog.Any("id", leave.ID), slog.Any("employee_id", employeeID), slog.Any("user_id", user.ID))
+
+	c.syncEmployee(ctx, employeeID)
+
+	return &leave, nil
+}
+
+// GetSickLeaves returns the sick leaves visible to the user, latest first:
+// admin, hr and API keys see all of them, managers see their own and those of
+// their team, other employees only their own. The period filter keeps the
+// leaves that overlap it.
+func (c *SickLeaveController) GetSickLeaves(user *entity.Claims, params *entity.GetSickLeavesParams) ([]entity.SickLeave, error) {
+	query := "SELECT " + sickLeaveColumns + " FROM sick_leaves WHERE 1=1"
+	args := []any{}
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		query += " AND " + strings.ReplaceAll(condition, "$n", fmt.Sprintf("$%d", len(args)))
+	}
+
+	if params != nil {
+		if params.From != nil && params.To != nil && params.To.Before(*params.From) {
+			return nil, fmt.Errorf("%w: to is before from", ErrInvalidSickLeave)
+		}
+
+		if params.From != nil {
+			add("(end_date IS NULL OR end_date >= $n)", dateOnly(*params.From))
+		}
+
+		if params.To != nil {
+			add("start_date <= $n", dateOnly(*params.To))
+		}
+
+		if params.DepartmentID != nil {
+			add("employee_id IN (SELECT id FROM employees WHERE department_id = $n)", *params.DepartmentID)
+		}
+
+		if params.EmployeeID != nil {
+			add("employee_id = $n", *params.EmployeeID)
+		}
+
+		if params.Open != nil {
+			if *params.Open {
+				query += " AND end_date IS NULL"
+			} else {
+				query += " AND end_date IS NOT NULL"
+			}
+		}
+	}
+
+	switch {
+	case user.Type == TokenTypeAPIKey || user.Role == entity.RoleAdmin || user.Role == entity.RoleHR:
+	case user.Role == entity.RoleManager:
+		add("(employee_id = $n OR employee_id IN (SELECT id FROM employees WHERE manager_id = $n))", user.ID)
+	default:
+		add("employee_id = $n", user.ID)
+	}
+
+	query += " ORDER BY start_date DESC, id DESC"
+
+	rows, err := c.deps.DB.Query(context.Background(), query, args...)
+	if err != nil {
+		c.deps.Logger.Error("Error querying sick leaves", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	leaves, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.SickLeave])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return leaves, nil
+}
+
+// CloseSickLeave sets the last day of an open sick leave, on behalf of the
+// employee or of those who may register sick leaves of anyone. The certificate
+// number may be given on closing if it was not known before.
+func (c *SickLeaveController) CloseSickLeave(user *entity.Claims, id uint64, closing entity.SickLeaveClose) (*entity.SickLeave, error) {
+	leave, err := c.getSickLeave(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if leave.EmployeeID != user.ID && !HasAccess(user, OpRegisterSickLeave) {
+		c.deps.Logger.Warn("Sick leave of another employee", slog.Any("id", id), slog.Any("user_id", user.ID))
+		return nil, ErrNotSickLeaveEmployee
+	}
+
+	if leave.EndDate != nil {
+		return nil, ErrSickLeaveClosed
+	}
+
+	endDate := dateOnly(closing.EndDate)
+	if endDate.IsZero() {
+		return nil, fmt.Errorf("%w: end_date is required", ErrInvalidSickLeave)
+	}
+
+	if err = validateSickLeave(leave.StartDate, &endDate, closing.CertificateNumber); err != nil {
+		c.deps.Logger.Warn("Invalid sick leave", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	ctx := context.Background()
+	query := `UPDATE sick_leaves SET end_date = $1, certificate_number = COALESCE($2, certificate_number), closed_by = $3, updated_at = $4
+              WHERE id = $5 AND end_date IS NULL
+              RETURNING ` + sickLeaveColumns
+
+	rows, err := c.deps.DB.Query(ctx, query, endDate, trimmedCertificate(closing.CertificateNumber), actorID(user), time.Now(), id)
+	if err != nil {
+		return nil, c.sickLeaveError(err)
+	}
+	defer rows.Close()
+
+	closed, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.SickLeave])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSickLeaveClosed
+		}
+
+		return nil, c.sickLeaveError(err)
+	}
+
+	c.deps.Logger.Info("Sick leave closed", slog.Any("id", id), slog.Any("employee_id", closed.EmployeeID), slog.Any("user_id", user.ID))
+
+	c.syncEmployee(ctx, closed.EmployeeID)
+
+	return &closed, nil
+}
+
+func (c *SickLeaveController) getSickLeave(id uint64) (*entity.SickLeave, error) {
+	rows, err := c.deps.DB.Query(context.Background(), "SELECT "+sickLeaveColumns+" FROM sick_leaves WHERE id = $1", id)
+	if err != nil {
+		c.deps.Logger.Error("Error querying sick leave", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	leave, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.SickLeave])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSickLeaveNotFound
+		}
+
+		c.deps.Logger.Error("Error collecting row", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &leave, nil
+}
+
+// syncEmployee brings the status of the employee in line with the leave just
+// changed. The leave is already stored, so a failure is only logged and left
+// to the next run of Run.
+func (c *SickLeaveController) syncEmployee(ctx context.Context, employeeID uint64) {
+	if err := c.SyncStatuses(ctx, &employeeID); err != nil {
+		c.deps.Logger.Warn("Sick status left to the next sync", slog.Any("employee_id", employeeID))
+	}
+}
+
+func (c *SickLeaveController) sickLeaveError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == sickCertificateIndex {
+		c.deps.Logger.Warn("Medical certificate is already registered")
+		return ErrSickCertificateExists
+	}
+
+	c.deps.Logger.Error("Error saving sick leave", slog.String("error", err.Error()))
+
+	return err
+}
+
+func validateSickLeave(startDate time.Time, endDate *time.Time, certificateNumber *string) error {
+	if startDate.IsZero() {
+		return fmt.Errorf("%w: start_date is required", ErrInvalidSickLeave)
+	}
+
+	if endDate != nil && endDate.Before(startDate) {
+		return fmt.Errorf("%w: end_date is before start_date", ErrInvalidSickLeave)
+	}
+
+	if certificateNumber != nil {
+		number := strings.TrimSpace(*certificateNumber)
+		if number == "" || len(number) > maxCertificateNumberLength {
+			return fmt.Errorf("%w: certificate_number must be 1 to %d characters", ErrInvalidSickLeave, maxCertificateNumberLength)
+		}
+	}
+
+	return nil
+}
+
+func trimmedCertificate(certificateNumber *string) *string {
+	if certificateNumber == nil {
+		return nil
+	}
+
+	number := strings.TrimSpace(*certificateNumber)
+
+	return &number
+}
+
+// actorID returns the employee who made the change, nil for API keys.
+func actorID(user *entity.Claims) *uint64 {
+	if user.Type == TokenTypeAPIKey {
+		return nil
+	}
+
+	return &user.ID
+}
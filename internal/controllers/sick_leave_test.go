@@ -0,0 +1,249 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var SickLeaveFieldDescriptions = []pgconn.FieldDescription{
+	{Name: "id", DataTypeOID: 20},                 // int8 (uint64)
+	{Name: "employee_id", DataTypeOID: 20},        // int8 (uint64)
+	{Name: "start_date", DataTypeOID: 1082},       // date
+	{Name: "end_date", DataTypeOID: 1082},         // date (nullable)
+	{Name: "days", DataTypeOID: 20},               // int8 (uint64)
+	{Name: "certificate_number", DataTypeOID: 25}, // text (string, nullable)
+	{Name: "comment", DataTypeOID: 25},            // text (string, nullable)
+	{Name: "created_by", DataTypeOID: 20},         // int8 (uint64, nullable)
+	{Name: "closed_by", DataTypeOID: 20},          // int8 (uint64, nullable)
+	{Name: "created_at", DataTypeOID: 1114},       // timestamp
+	{Name: "updated_at", DataTypeOID: 1114},       // timestamp
+}
+
+func sickLeaveRow(id, employeeID uint64, start time.Time, end *time.Time) []interface{} {
+	now := time.Now()
+
+	return []interface{}{id, employeeID, start, end, uint64(3), nil, nil, Uint64Ptr(employeeID), nil, now, now}
+}
+
+func sickLeaveRows(rows ...[]interface{}) *MockRows {
+	return NewMockRows(rows, nil, SickLeaveFieldDescriptions)
+}
+
+const sickStatusQuery = "UPDATE employees SET status = CASE WHEN status = $1 THEN $2 ELSE $1 END"
+
+func TestSickLeaveController_RegisterSickLeave(t *testing.T) {
+	today := dateOnly(time.Now())
+	start := today.AddDate(0, 0, -2)
+	employee := &entity.Claims{ID: 3, Role: entity.RoleEmployee}
+	checkQuery := queryContains("SELECT EXISTS (SELECT 1 FROM employees", "FROM sick_leaves")
+
+	t.Run("open leave makes the employee sick", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		number := " 910000000001 "
+		mockDB.On("QueryRow", mock.Anything, checkQuery, uint64(3), entity.StatusFired, start, (*time.Time)(nil)).
+			Return(NewMockRow([]interface{}{true, false}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, queryContains("INSERT INTO sick_leaves"),
+			uint64(3), start, (*time.Time)(nil), StringPtr("910000000001"), (*string)(nil), Uint64Ptr(3), mock.Anything,
+		).Return(sickLeaveRows(sickLeaveRow(1, 3, start, nil)), nil).Once()
+		mockDB.On("Exec", mock.Anything, queryContains(sickStatusQuery, "($5::bigint IS NULL OR id = $5)"),
+			entity.StatusActive, entity.StatusSick, today, mock.Anything, Uint64Ptr(3),
+		).Return(NewMockCommandTag(1), nil).Once()
+
+		leave, err := controller.RegisterSickLeave(employee, 3, entity.SickLeaveForm{StartDate: start, CertificateNumber: &number})
+		require.NoError(t, err)
+		assert.Nil(t, leave.EndDate)
+		assert.Equal(t, uint64(3), leave.Days)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("overlapping leave", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		end := start.AddDate(0, 0, 1)
+		mockDB.On("QueryRow", mock.Anything, checkQuery, uint64(3), entity.StatusFired, start, &end).
+			Return(NewMockRow([]interface{}{true, true}, nil, nil)).Once()
+
+		_, err := controller.RegisterSickLeave(employee, 3, entity.SickLeaveForm{StartDate: start, EndDate: &end})
+		assert.ErrorIs(t, err, ErrSickLeaveOverlap)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, checkQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{false, false}, nil, nil)).Once()
+
+		_, err := controller.RegisterSickLeave(employee, 3, entity.SickLeaveForm{StartDate: start})
+		assert.ErrorIs(t, err, ErrEmployeeNotFound)
+	})
+
+	t.Run("certificate already registered", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		uniqueErr := &pgconn.PgError{Code: pgUniqueViolation, ConstraintName: sickCertificateIndex}
+		mockDB.On("QueryRow", mock.Anything, checkQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{true, false}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, queryContains("INSERT INTO sick_leaves"), mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		).Return(NewMockRows(nil, nil, SickLeaveFieldDescriptions), uniqueErr).Once()
+
+		_, err := controller.RegisterSickLeave(employee, 3, entity.SickLeaveForm{StartDate: start, CertificateNumber: StringPtr("910000000001")})
+		assert.ErrorIs(t, err, ErrSickCertificateExists)
+	})
+
+	invalid := []struct {
+		name string
+		form entity.SickLeaveForm
+	}{
+		{name: "missing start date", form: entity.SickLeaveForm{}},
+		{name: "end before start", form: entity.SickLeaveForm{StartDate: start, EndDate: TimePtr(start.AddDate(0, 0, -1))}},
+		{name: "start in the future", form: entity.SickLeaveForm{StartDate: today.AddDate(0, 0, 1)}},
+		{name: "blank certificate", form: entity.SickLeaveForm{StartDate: start, CertificateNumber: StringPtr("  ")}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := NewSickLeaveController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+			_, err := controller.RegisterSickLeave(employee, 3, tt.form)
+			assert.ErrorIs(t, err, ErrInvalidSickLeave)
+		})
+	}
+}
+
+func TestSickLeaveController_GetSickLeaves(t *testing.T) {
+	start := dateOnly(time.Now()).AddDate(0, 0, -2)
+
+	t.Run("hr filters by period and department", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		from := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+		open := true
+
+		mockDB.On("Query", mock.Anything, queryContains("(end_date IS NULL OR end_date >= $1) AND start_date <= $2",
+			"department_id = $3", "AND end_date IS NULL ORDER BY"),
+			time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), to, uint64(4),
+		).Return(sickLeaveRows(sickLeaveRow(1, 3, start, nil)), nil).Once()
+
+		leaves, err := controller.GetSickLeaves(&entity.Claims{ID: 5, Role: entity.RoleHR},
+			&entity.GetSickLeavesParams{From: &from, To: &to, DepartmentID: Uint64Ptr(4), Open: &open})
+		require.NoError(t, err)
+		assert.Len(t, leaves, 1)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("employee sees own leaves", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, queryContains("WHERE 1=1 AND employee_id = $1 ORDER BY"), uint64(3)).
+			Return(sickLeaveRows(), nil).Once()
+
+		leaves, err := controller.GetSickLeaves(&entity.Claims{ID: 3, Role: entity.RoleEmployee}, nil)
+		require.NoError(t, err)
+		assert.Empty(t, leaves)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("period ends before it starts", func(t *testing.T) {
+		controller := NewSickLeaveController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		from := time.Now()
+		to := from.AddDate(0, 0, -1)
+		_, err := controller.GetSickLeaves(&entity.Claims{ID: 1, Role: entity.RoleAdmin}, &entity.GetSickLeavesParams{From: &from, To: &to})
+		assert.ErrorIs(t, err, ErrInvalidSickLeave)
+	})
+}
+
+func TestSickLeaveController_CloseSickLeave(t *testing.T) {
+	const selectQuery = "SELECT " + sickLeaveColumns + " FROM sick_leaves WHERE id = $1"
+
+	today := dateOnly(time.Now())
+	start := today.AddDate(0, 0, -5)
+	end := today.AddDate(0, 0, -1)
+	employee := &entity.Claims{ID: 3, Role: entity.RoleEmployee}
+
+	t.Run("employee closes own leave and is active again", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).Return(sickLeaveRows(sickLeaveRow(1, 3, start, nil)), nil).Once()
+		mockDB.On("Query", mock.Anything, queryContains("UPDATE sick_leaves SET end_date = $1", "WHERE id = $5 AND end_date IS NULL"),
+			end, (*string)(nil), Uint64Ptr(3), mock.Anything, uint64(1),
+		).Return(sickLeaveRows(sickLeaveRow(1, 3, start, &end)), nil).Once()
+		mockDB.On("Exec", mock.Anything, queryContains(sickStatusQuery), entity.StatusActive, entity.StatusSick, today, mock.Anything, Uint64Ptr(3)).
+			Return(NewMockCommandTag(1), nil).Once()
+
+		leave, err := controller.CloseSickLeave(employee, 1, entity.SickLeaveClose{EndDate: end})
+		require.NoError(t, err)
+		assert.Equal(t, &end, leave.EndDate)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("leave of another employee", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).Return(sickLeaveRows(sickLeaveRow(1, 4, start, nil)), nil).Once()
+
+		_, err := controller.CloseSickLeave(employee, 1, entity.SickLeaveClose{EndDate: end})
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("leave already closed", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).Return(sickLeaveRows(sickLeaveRow(1, 3, start, &end)), nil).Once()
+
+		_, err := controller.CloseSickLeave(&entity.Claims{ID: 5, Role: entity.RoleHR}, 1, entity.SickLeaveClose{EndDate: end})
+		assert.ErrorIs(t, err, ErrSickLeaveClosed)
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).Return(sickLeaveRows(sickLeaveRow(1, 3, start, nil)), nil).Once()
+
+		_, err := controller.CloseSickLeave(employee, 1, entity.SickLeaveClose{EndDate: start.AddDate(0, 0, -1)})
+		assert.ErrorIs(t, err, ErrInvalidSickLeave)
+	})
+
+	t.Run("leave not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).Return(sickLeaveRows(), nil).Once()
+
+		_, err := controller.CloseSickLeave(employee, 1, entity.SickLeaveClose{EndDate: end})
+		assert.ErrorIs(t, err, ErrSickLeaveNotFound)
+	})
+}
+
+func TestSickLeaveController_SyncStatuses(t *testing.T) {
+	mockDB := &MockDB{}
+	controller := NewSickLeaveController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+	mockDB.On("Exec", mock.Anything, queryContains(sickStatusQuery, "(status = $2) <> EXISTS"),
+		entity.StatusActive, entity.StatusSick, dateOnly(time.Now()), mock.Anything, (*uint64)(nil),
+	).Return(NewMockCommandTag(2), nil).Once()
+
+	require.NoError(t, controller.SyncStatuses(context.Background(), nil))
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrStaffingConflict = errors.New("not enough staff on duty")
+
+// StaffingConflictError is returned when a vacation would leave fewer employees
+// at work than the minimum of their department. It wraps ErrStaffingConflict
+// and tells who is out on which days.
+type StaffingConflictError struct {
+	Conflict entity.StaffingConflict
+}
+
+func (e *StaffingConflictError) Error() string {
+	return fmt.Sprintf("%s on %d days", ErrStaffingConflict, len(e.Conflict.Days))
+}
+
+func (e *StaffingConflictError) Unwrap() error {
+	return ErrStaffingConflict
+}
+
+// checkStaffing checks that on every working day from start to end enough
+// colleagues of the employee stay at work while the employee is on vacation.
+// Approved vacations and sick leaves are absences, an open sick leave lasts up
+// to today. The department requires the larger of min_on_duty and
+// min_on_duty_percent of its staff; without both there is nothing to check.
+func checkStaffing(deps *Dependens, employeeID uint64, start, end time.Time) error {
+	ctx := context.Background()
+
+	var (
+		conflict    entity.StaffingConflict
+		minOnDuty   *uint64
+		minPercent  *uint64
+		workingDays []time.Time
+	)
+
+	// The calendar of the employee is looked up once for the whole period.
+	query := `SELECT d.id, d.min_on_duty, d.min_on_duty_percent,
+                     (SELECT COUNT(*) FROM employees WHERE department_id = d.id AND status NOT IN ($2, $3)),
+                     ARRAY(SELECT day::date FROM generate_series($4::date, $5::date, INTERVAL '1 day') AS day
+                           WHERE working_days(c.id, day::date, day::date) > 0)
+              FROM employees e JOIN departments d ON d.id = e.department_id
+              CROSS JOIN LATERAL (SELECT employee_calendar(e.id) AS id) c
+              WHERE e.id = $1`
+
+	err := deps.DB.QueryRow(ctx, query, employeeID, entity.StatusFired, entity.StatusSuspended, start, end).
+		Scan(&conflict.DepartmentID, &minOnDuty, &minPercent, &conflict.Headcount, &workingDays)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		deps.Logger.Error("Error getting department staffing", slog.String("error", err.Error()))
+		return err
+	}
+
+	if minOnDuty != nil {
+		conflict.MinOnDuty = *minOnDuty
+	}
+
+	// The share is rounded up: 50% of 3 employees is 2.
+	if minPercent != nil {
+		conflict.MinOnDuty = max(conflict.MinOnDuty, (*minPercent*conflict.Headcount+99)/100)
+	}
+
+	if conflict.MinOnDuty == 0 || len(workingDays) == 0 {
+		return nil
+	}
+
+	query = `SELECT e.id AS employee_id, e.first_name, e.last_name, a.reason, a.start_date, a.end_date
+             FROM (SELECT employee_id, type AS reason, start_date, end_date FROM vacation_requests
+                   WHERE status = $4 AND start_date <= $3 AND end_date >= $2
+                   UNION ALL
+                   SELECT employee_id, $5, start_date, COALESCE(end_date, CURRENT_DATE) FROM sick_leaves
+                   WHERE start_date <= $3 AND COALESCE(end_date, CURRENT_DATE) >= $2) a
+             JOIN employees e ON e.id = a.employee_id
+             WHERE e.department_id = $1 AND e.id <> $6 AND e.status NOT IN ($7, $8)
+             ORDER BY a.start_date, e.id`
+
+	rows, err := deps.DB.Query(ctx, query, conflict.DepartmentID, start, end, entity.VacationStatusApproved, entity.StatusSick,
+		employeeID, entity.StatusFired, entity.StatusSuspended)
+	if err != nil {
+		deps.Logger.Error("Error querying department absences", slog.String("error", err.Error()))
+		return err
+	}
+	defer rows.Close()
+
+	absences, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.StaffingAbsence])
+	if err != nil {
+		deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return err
+	}
+
+	for _, day := range workingDays {
+		day = dateOnly(day)
+		absent := []entity.StaffingAbsence{}
+		out := map[uint64]bool{employeeID: true}
+
+		for _, absence := range absences {
+			if !absence.StartDate.After(day) && !absence.EndDate.Before(day) {
+				absent = append(absent, absence)
+				out[absence.EmployeeID] = true
+			}
+		}
+
+		var onDuty uint64
+		if conflict.Headcount > uint64(len(out)) {
+			onDuty = conflict.Headcount - uint64(len(out))
+		}
+
+		if onDuty < conflict.MinOnDuty {
+			conflict.Days = append(conflict.Days, entity.StaffingConflictDay{Date: day, OnDuty: onDuty, Absent: absent})
+		}
+	}
+
+	if len(conflict.Days) == 0 {
+		return nil
+	}
+
+	deps.Logger.Warn("Not enough staff on duty", slog.Any("employee_id", employeeID), slog.Any("department_id", conflict.DepartmentID),
+		slog.Any("min_on_duty", conflict.MinOnDuty), slog.Int("days", len(conflict.Days)))
+
+	return &StaffingConflictError{Conflict: conflict}
+}
@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var StaffingAbsenceFieldDescriptions = []pgconn.FieldDescription{
+	{Name: "employee_id", DataTypeOID: 20},  // int8 (uint64)
+	{Name: "first_name", DataTypeOID: 25},   // text (string)
+	{Name: "last_name", DataTypeOID: 25},    // text (string)
+	{Name: "reason", DataTypeOID: 25},       // text (string)
+	{Name: "start_date", DataTypeOID: 1082}, // date
+	{Name: "end_date", DataTypeOID: 1082},   // date
+}
+
+var (
+	staffingRuleQuery     = queryContains("SELECT d.id, d.min_on_duty, d.min_on_duty_percent", "working_days(c.id, day::date, day::date)")
+	staffingAbsencesQuery = queryContains("FROM vacation_requests", "FROM sick_leaves", "e.id <> $6")
+)
+
+// expectNoStaffingMinimum mocks the staffing check of an employee outside of any department.
+func expectNoStaffingMinimum(mockDB *MockDB, employeeID uint64) {
+	mockDB.On("QueryRow", mock.Anything, staffingRuleQuery, employeeID, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(NewMockRow(nil, pgx.ErrNoRows, nil)).Once()
+}
+
+func TestCheckStaffing(t *testing.T) {
+	start := dateOnly(time.Now().AddDate(0, 1, 0))
+	end := start.AddDate(0, 0, 1)
+	days := []time.Time{start, end}
+
+	absences := [][]interface{}{
+		{uint64(5), "Ivan", "Petrov", entity.VacationTypeAnnual, start.AddDate(0, 0, -3), end},
+		{uint64(6), "Anna", "Sidorova", entity.StatusSick, start, start},
+	}
+
+	t.Run("department left below the minimum", func(t *testing.T) {
+		mockDB := &MockDB{}
+		deps := CreateTestDependencies(mockDB, &MockRedis{})
+
+		mockDB.On("QueryRow", mock.Anything, staffingRuleQuery, uint64(3), entity.StatusFired, entity.StatusSuspended, start, end).
+			Return(NewMockRow([]interface{}{uint64(7), Uint64Ptr(2), nil, uint64(4), days}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, staffingAbsencesQuery, uint64(7), start, end, entity.VacationStatusApproved, entity.StatusSick,
+			uint64(3), entity.StatusFired, entity.StatusSuspended,
+		).Return(NewMockRows(absences, nil, StaffingAbsenceFieldDescriptions), nil).Once()
+
+		err := checkStaffing(deps, 3, start, end)
+		require.ErrorIs(t, err, ErrStaffingConflict)
+
+		var conflict *StaffingConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, uint64(7), conflict.Conflict.DepartmentID)
+		assert.Equal(t, uint64(2), conflict.Conflict.MinOnDuty)
+		require.Len(t, conflict.Conflict.Days, 1)
+		assert.Equal(t, start, conflict.Conflict.Days[0].Date)
+		assert.Equal(t, uint64(1), conflict.Conflict.Days[0].OnDuty)
+		assert.Len(t, conflict.Conflict.Days[0].Absent, 2)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("percentage is rounded up", func(t *testing.T) {
+		mockDB := &MockDB{}
+		deps := CreateTestDependencies(mockDB, &MockRedis{})
+
+		mockDB.On("QueryRow", mock.Anything, staffingRuleQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{uint64(7), Uint64Ptr(1), Uint64Ptr(70), uint64(5), days}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, staffingAbsencesQuery, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		).Return(NewMockRows(absences[:1], nil, StaffingAbsenceFieldDescriptions), nil).Once()
+
+		err := checkStaffing(deps, 3, start, end)
+
+		var conflict *StaffingConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, uint64(4), conflict.Conflict.MinOnDuty)
+		assert.Len(t, conflict.Conflict.Days, 2)
+	})
+
+	t.Run("enough staff on duty", func(t *testing.T) {
+		mockDB := &MockDB{}
+		deps := CreateTestDependencies(mockDB, &MockRedis{})
+
+		mockDB.On("QueryRow", mock.Anything, staffingRuleQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{uint64(7), Uint64Ptr(2), nil, uint64(5), days}, nil, nil)).Once()
+		mockDB.On("Query", mock.Anything, staffingAbsencesQuery, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		).Return(NewMockRows(absences, nil, StaffingAbsenceFieldDescriptions), nil).Once()
+
+		assert.NoError(t, checkStaffing(deps, 3, start, end))
+	})
+
+	t.Run("department without a minimum", func(t *testing.T) {
+		mockDB := &MockDB{}
+		deps := CreateTestDependencies(mockDB, &MockRedis{})
+
+		mockDB.On("QueryRow", mock.Anything, staffingRuleQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{uint64(7), nil, nil, uint64(5), days}, nil, nil)).Once()
+
+		assert.NoError(t, checkStaffing(deps, 3, start, end))
+		mockDB.AssertExpectations(t)
+	})
+}
+
+func TestVacationController_ApproveVacationRequest_Staffing(t *testing.T) {
+	start := dateOnly(time.Now().AddDate(0, 1, 0))
+	mockDB := &MockDB{}
+	controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+	mockDB.On("Query", mock.Anything, "SELECT "+vacationRequestColumns+" FROM vacation_requests WHERE id = $1", uint64(1)).
+		Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingHR, start, Uint64Ptr(2))), nil).Once()
+	mockDB.On("QueryRow", mock.Anything, staffingRuleQuery, uint64(3), mock.Anything, mock.Anything, start, start.AddDate(0, 0, 4)).
+		Return(NewMockRow([]interface{}{uint64(7), Uint64Ptr(1), nil, uint64(2), []time.Time{start}}, nil, nil)).Once()
+	mockDB.On("Query", mock.Anything, staffingAbsencesQuery, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(NewMockRows([][]interface{}{{uint64(5), "Ivan", "Petrov", entity.VacationTypeAnnual, start, start}}, nil,
+		StaffingAbsenceFieldDescriptions), nil).Once()
+
+	_, err := controller.ApproveVacationRequest(&entity.Claims{ID: 5, Role: entity.RoleHR}, 1)
+	assert.ErrorIs(t, err, ErrStaffingConflict)
+	mockDB.AssertExpectations(t)
+}
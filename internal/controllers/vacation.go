@@ -0,0 +1,437 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// vacationRequestColumns are the columns of vacation_requests mapped to entity.VacationRequest.
+const vacationRequestColumns = `id, employee_id, type, start_date, end_date, days, status, comment,
+	manager_id, manager_decided_by, hr_decided_by, reject_reason, created_at, updated_at`
+
+const pgCheckViolation = "23514"
+
+// MaxVacationDays is the longest vacation period in calendar days. Longer
+// absences are filed as several requests.
+const MaxVacationDays = 366
+
+var (
+	ErrInvalidVacationRequest      = errors.New("invalid vacation request")
+	ErrVacationRequestNotFound     = errors.New("vacation request not found")
+	ErrVacationOverlap             = errors.New("vacation overlaps another request")
+	ErrInsufficientVacationBalance = errors.New("not enough vacation days")
+	ErrVacationRequestNotPending   = errors.New("vacation request is not pending")
+	ErrVacationStarted             = errors.New("vacation has already started")
+
+	// ErrNotVacationApprover is returned when the user may not decide on the
+	// request at its current stage, or the request is their own.
+	ErrNotVacationApprover = fmt.Errorf("%w: not an approver of the vacation request", ErrPermissionDenied)
+)
+
+var vacationTypes = []string{entity.VacationTypeAnnual, entity.VacationTypeUnpaid, entity.VacationTypeStudy}
+
+var vacationStatuses = []string{
+	entity.VacationStatusPendingManager,
+	entity.VacationStatusPendingHR,
+	entity.VacationStatusApproved,
+	entity.VacationStatusRejected,
+	entity.VacationStatusCancelled,
+}
+
+// VacationController manages vacation requests. A request goes to the manager
+// of the employee and then to hr; the vacation balance is debited only when hr
+// approves it and is given back when an approved request is cancelled.
+type VacationController struct {
+	deps *Dependens
+}
+
+func NewVacationController(deps *Dependens) *VacationController {
+	return &VacationController{
+		deps: deps,
+	}
+}
+
+// SubmitVacationRequest files a request of the employee. It goes to the manager
+// of the employee, or straight to hr if the employee has none. Annual leave has
+// to fit into the balance left after the other pending annual requests.
+func (c *VacationController) SubmitVacationRequest(employeeID uint64, form entity.VacationRequestForm) (*entity.VacationRequest, error) {
+	startDate, endDate := dateOnly(form.StartDate), dateOnly(form.EndDate)
+
+	if err := validateVacationForm(form.Type, startDate, endDate); err != nil {
+		c.deps.Logger.Warn("Invalid vacation request", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	var managerID *uint64
+
+	var (
+		available float64
+		days      uint64
+	)
+
+	// The vacation takes the working days of the period by the calendar of the employee.
+	query := `SELECT manager_id,
+                     COALESCE((SELECT SUM(days) FROM leave_ledger WHERE employee_id = employees.id AND leave_type = $2), 0) -
+                     COALESCE((SELECT SUM(days) FROM vacation_requests
+                               WHERE employee_id = employees.id AND type = $2 AND status IN ($3, $4)), 0),
+                     working_days(employee_calendar(id), $6, $7)
+              FROM employees WHERE id = $1 AND status <> $5`
+
+	err := c.deps.DB.QueryRow(ctx, query, employeeID, entity.LeaveTypeAnnual,
+		entity.VacationStatusPendingManager, entity.VacationStatusPendingHR, entity.StatusFired, startDate, endDate).
+		Scan(&managerID, &available, &days)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEmployeeNotFound
+		}
+
+		c.deps.Logger.Error("Error getting vacation balance", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if days == 0 {
+		c.deps.Logger.Warn("Invalid vacation request", slog.String("error", "no working days in period"))
+		return nil, fmt.Errorf("%w: period has no working days", ErrInvalidVacationRequest)
+	}
+
+	if form.Type == entity.VacationTypeAnnual && float64(days) > available {
+		c.deps.Logger.Warn("Not enough vacation days", slog.Any("employee_id", employeeID), slog.Any("days", days), slog.Any("available", available))
+		return nil, ErrInsufficientVacationBalance
+	}
+
+	var overlaps bool
+
+	query = `SELECT EXISTS (SELECT 1 FROM vacation_requests
+              WHERE employee_id = $1 AND status IN ($2, $3, $4) AND start_date <= $6 AND end_date >= $5)`
+
+	if err = c.deps.DB.QueryRow(ctx, query, employeeID, entity.VacationStatusPendingManager, entity.VacationStatusPendingHR,
+		entity.VacationStatusApproved, startDate, endDate).Scan(&overlaps); err != nil {
+		c.deps.Logger.Error("Error checking vacation overlap", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if overlaps {
+		return nil, ErrVacationOverlap
+	}
+
+	if err = checkStaffing(c.deps, employeeID, startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	status := entity.VacationStatusPendingManager
+	if managerID == nil || *managerID == employeeID {
+		status = entity.VacationStatusPendingHR
+	}
+
+	now := time.Now()
+	query = `INSERT INTO vacation_requests (employee_id, type, start_date, end_date, days, status, comment, manager_id, created_at, updated_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+              RETURNING ` + vacationRequestColumns
+
+	rows, err := c.deps.DB.Query(ctx, query, employeeID, form.Type, startDate, endDate, days, status, form.Comment, managerID, now)
+	if err != nil {
+		c.deps.Logger.Error("Error inserting vacation request", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	request, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.VacationRequest])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting row", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	c.deps.Logger.Info("Vacation request submitted",
+		slog.Any("id", request.ID), slog.Any("employee_id", employeeID), slog.String("status", status))
+
+	return &request, nil
+}
+
+// GetVacationRequests returns the requests visible to the user, newest first:
+// admin, hr and API keys see all of them, managers see their own and those of
+// their team, other employees only their own.
+func (c *VacationController) GetVacationRequests(user *entity.Claims, params *entity.GetVacationRequestsParams) ([]entity.VacationRequest, error) {
+	query := "SELECT " + vacationRequestColumns + " FROM vacation_requests WHERE 1=1"
+	args := []any{}
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		query += " AND " + strings.ReplaceAll(condition, "$n", fmt.Sprintf("$%d", len(args)))
+	}
+
+	if params != nil {
+		if params.Status != nil {
+			if !slices.Contains(vacationStatuses, *params.Status) {
+				return nil, fmt.Errorf("%w: unknown status %s", ErrInvalidVacationRequest, *params.Status)
+			}
+
+			add("status = $n", *params.Status)
+		}
+
+		if params.EmployeeID != nil {
+			add("employee_id = $n", *params.EmployeeID)
+		}
+	}
+
+	switch {
+	case user.Type == TokenTypeAPIKey || user.Role == entity.RoleAdmin || user.Role == entity.RoleHR:
+	case user.Role == entity.RoleManager:
+		add("(employee_id = $n OR employee_id IN (SELECT id FROM employees WHERE manager_id = $n))", user.ID)
+	default:
+		add("employee_id = $n", user.ID)
+	}
+
+	query += " ORDER BY created_at DESC, id DESC"
+
+	rows, err := c.deps.DB.Query(context.Background(), query, args...)
+	if err != nil {
+		c.deps.Logger.Error("Error querying vacation requests", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests, err := pgx.CollectRows(rows, pgx.RowToStructByName[entity.VacationRequest])
+	if err != nil {
+		c.deps.Logger.Error("Error collecting rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// ApproveVacationRequest approves the request at its current stage: the
+// manager of the employee passes it on to hr, and hr approval debits the
+// balance for annual leave. Admin may decide at either stage.
+func (c *VacationController) ApproveVacationRequest(user *entity.Claims, id uint64) (*entity.VacationRequest, error) {
+	request, err := c.pendingRequest(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Colleagues may have gone on vacation or fallen ill since the request was filed.
+	if err = checkStaffing(c.deps, request.EmployeeID, request.StartDate, request.EndDate); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if request.Status == entity.VacationStatusPendingManager {
+		query := `UPDATE vacation_requests SET status = $1, manager_decided_by = $2, updated_at = $3
+                  WHERE id = $4 AND status = $5
+                  RETURNING ` + vacationRequestColumns
+
+		return c.decide(query, id, entity.VacationStatusPendingHR, user.ID, now, id, request.Status)
+	}
+
+	query := `WITH request AS (
+                  UPDATE vacation_requests SET status = $1, hr_decided_by = $2, updated_at = $3
+                  WHERE id = $4 AND status = $5
+                  RETURNING ` + vacationRequestColumns + `
+              ), usage AS (
+                  INSERT INTO leave_ledger (employee_id, leave_type, kind, days, effective_date, vacation_request_id, created_by, created_at)
+                  SELECT employee_id, type, $7, -days, $3::date, id, $2, $3 FROM request WHERE type = $6
+              )
+              SELECT ` + vacationRequestColumns + ` FROM request`
+
+	return c.decide(query, id, entity.VacationStatusApproved, user.ID, now, id, request.Status, entity.VacationTypeAnnual, entity.LeaveEntryUsage)
+}
+
+// RejectVacationRequest rejects the request at its current stage with an
+// optional reason.
+func (c *VacationController) RejectVacationRequest(user *entity.Claims, id uint64, decision entity.VacationDecision) (*entity.VacationRequest, error) {
+	request, err := c.pendingRequest(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	decidedBy := "hr_decided_by"
+	if request.Status == entity.VacationStatusPendingManager {
+		decidedBy = "manager_decided_by"
+	}
+
+	query := `UPDATE vacation_requests SET status = $1, ` + decidedBy + ` = $2, reject_reason = $3, updated_at = $4
+              WHERE id = $5 AND status = $6
+              RETURNING ` + vacationRequestColumns
+
+	return c.decide(query, id, entity.VacationStatusRejected, user.ID, decision.Reason, time.Now(), id, request.Status)
+}
+
+// CancelVacationRequest withdraws the request on behalf of the employee, or of
+// admin and hr. An approved request can be cancelled until the vacation starts
+// and its annual days are returned to the balance.
+func (c *VacationController) CancelVacationRequest(user *entity.Claims, id uint64) (*entity.VacationRequest, error) {
+	request, err := c.getVacationRequest(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.EmployeeID != user.ID && user.Role != entity.RoleAdmin && user.Role != entity.RoleHR {
+		c.deps.Logger.Warn("Vacation request of another employee", slog.Any("id", id), slog.Any("user_id", user.ID))
+		return nil, ErrNotVacationApprover
+	}
+
+	switch request.Status {
+	case entity.VacationStatusPendingManager, entity.VacationStatusPendingHR:
+		query := `UPDATE vacation_requests SET status = $1, updated_at = $2
+                  WHERE id = $3 AND status = $4
+                  RETURNING ` + vacationRequestColumns
+
+		return c.decide(query, id, entity.VacationStatusCancelled, time.Now(), id, request.Status)
+	case entity.VacationStatusApproved:
+		if !request.StartDate.After(dateOnly(time.Now())) {
+			return nil, ErrVacationStarted
+		}
+
+		query := `WITH request AS (
+                      UPDATE vacation_requests SET status = $1, updated_at = $2
+                      WHERE id = $3 AND status = $4
+                      RETURNING ` + vacationRequestColumns + `
+                  ), refund AS (
+                      INSERT INTO leave_ledger (employee_id, leave_type, kind, days, effective_date, vacation_request_id, created_by, created_at)
+                      SELECT employee_id, type, $6, days, $2::date, id, $7, $2 FROM request WHERE type = $5
+                  )
+                  SELECT ` + vacationRequestColumns + ` FROM request`
+
+		return c.decide(query, id, entity.VacationStatusCancelled, time.Now(), id, request.Status, entity.VacationTypeAnnual,
+			entity.LeaveEntryUsage, user.ID)
+	default:
+		return nil, ErrVacationRequestNotPending
+	}
+}
+
+// pendingRequest returns the request if the user may decide on it at its
+// current stage: the manager of the employee or admin while it waits for the
+// manager, hr or admin while it waits for hr. Nobody decides on their own
+// request.
+func (c *VacationController) pendingRequest(user *entity.Claims, id uint64) (*entity.VacationRequest, error) {
+	request, err := c.getVacationRequest(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var allowed bool
+
+	switch request.Status {
+	case entity.VacationStatusPendingManager:
+		var managerID *uint64
+		if err = c.deps.DB.QueryRow(context.Background(), "SELECT manager_id FROM employees WHERE id = $1", request.EmployeeID).
+			Scan(&managerID); err != nil {
+			c.deps.Logger.Error("Error getting employee manager", slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		allowed = user.Role == entity.RoleAdmin || (managerID != nil && *managerID == user.ID)
+	case entity.VacationStatusPendingHR:
+		allowed = user.Role == entity.RoleAdmin || user.Role == entity.RoleHR
+	default:
+		return nil, ErrVacationRequestNotPending
+	}
+
+	if !allowed || request.EmployeeID == user.ID {
+		c.deps.Logger.Warn("Not an approver of the vacation request",
+			slog.Any("id", id), slog.Any("user_id", user.ID), slog.String("status", request.Status))
+		return nil, ErrNotVacationApprover
+	}
+
+	return request, nil
+}
+
+func (c *VacationController) getVacationRequest(id uint64) (*entity.VacationRequest, error) {
+	rows, err := c.deps.DB.Query(context.Background(), "SELECT "+vacationRequestColumns+" FROM vacation_requests WHERE id = $1", id)
+	if err != nil {
+		c.deps.Logger.Error("Error querying vacation request", slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	request, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.VacationRequest])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrVacationRequestNotFound
+		}
+
+		c.deps.Logger.Error("Error collecting row", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// decide runs the status change of the request. The change is conditioned on
+// the status the decision was made at, so a request decided on concurrently
+// reports ErrVacationRequestNotPending.
+func (c *VacationController) decide(query string, id uint64, status string, args ...any) (*entity.VacationRequest, error) {
+	rows, err := c.deps.DB.Query(context.Background(), query, append([]any{status}, args...)...)
+	if err != nil {
+		return nil, c.decisionError(id, err)
+	}
+	defer rows.Close()
+
+	request, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.VacationRequest])
+	if err != nil {
+		return nil, c.decisionError(id, err)
+	}
+
+	c.deps.Logger.Info("Vacation request decided", slog.Any("id", id), slog.String("status", request.Status))
+
+	return &request, nil
+}
+
+// decisionError maps the error of a status change. The balance check fails
+// the whole statement, so the request keeps its status.
+func (c *VacationController) decisionError(id uint64, err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return ErrVacationRequestNotPending
+	case isLeaveBalanceViolation(err):
+		c.deps.Logger.Warn("Not enough vacation days", slog.Any("id", id))
+		return ErrInsufficientVacationBalance
+	}
+
+	c.deps.Logger.Error("Error updating vacation request", slog.String("error", err.Error()))
+
+	return err
+}
+
+func validateVacationForm(vacationType string, startDate, endDate time.Time) error {
+	if !slices.Contains(vacationTypes, vacationType) {
+		return fmt.Errorf("%w: type must be one of %s", ErrInvalidVacationRequest, strings.Join(vacationTypes, ", "))
+	}
+
+	if startDate.IsZero() || endDate.IsZero() {
+		return fmt.Errorf("%w: start_date and end_date are required", ErrInvalidVacationRequest)
+	}
+
+	if endDate.Before(startDate) {
+		return fmt.Errorf("%w: end_date is before start_date", ErrInvalidVacationRequest)
+	}
+
+	if endDate.Sub(startDate).Hours()/24 >= MaxVacationDays {
+		return fmt.Errorf("%w: period is longer than %d days", ErrInvalidVacationRequest, MaxVacationDays)
+	}
+
+	if startDate.Before(dateOnly(time.Now())) {
+		return fmt.Errorf("%w: start_date is in the past", ErrInvalidVacationRequest)
+	}
+
+	return nil
+}
+
+// dateOnly drops the time of day, vacations are counted in whole days.
+func dateOnly(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
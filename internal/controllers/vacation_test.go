@@ -0,0 +1,455 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adamanr/employes_service/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var VacationRequestFieldDescriptions = []pgconn.FieldDescription{
+	{Name: "id", DataTypeOID: 20},                 // int8 (uint64)
+	{Name: "employee_id", DataTypeOID: 20},        // int8 (uint64)
+	{Name: "type", DataTypeOID: 25},               // text (string)
+	{Name: "start_date", DataTypeOID: 1082},       // date
+	{Name: "end_date", DataTypeOID: 1082},         // date
+	{Name: "days", DataTypeOID: 20},               // int8 (uint64)
+	{Name: "status", DataTypeOID: 25},             // text (string)
+	{Name: "comment", DataTypeOID: 25},            // text (string, nullable)
+	{Name: "manager_id", DataTypeOID: 20},         // int8 (uint64, nullable)
+	{Name: "manager_decided_by", DataTypeOID: 20}, // int8 (uint64, nullable)
+	{Name: "hr_decided_by", DataTypeOID: 20},      // int8 (uint64, nullable)
+	{Name: "reject_reason", DataTypeOID: 25},      // text (string, nullable)
+	{Name: "created_at", DataTypeOID: 1114},       // timestamp
+	{Name: "updated_at", DataTypeOID: 1114},       // timestamp
+}
+
+func vacationRequestRow(id, employeeID uint64, status string, start time.Time, managerID *uint64) []interface{} {
+	now := time.Now()
+
+	return []interface{}{
+		id, employeeID, entity.VacationTypeAnnual, start, start.AddDate(0, 0, 4), uint64(5), status,
+		nil, managerID, nil, nil, nil, now, now,
+	}
+}
+
+func vacationRequestRows(rows ...[]interface{}) *MockRows {
+	return NewMockRows(rows, nil, VacationRequestFieldDescriptions)
+}
+
+func TestVacationController_SubmitVacationRequest(t *testing.T) {
+	start := dateOnly(time.Now().AddDate(0, 1, 0))
+	form := entity.VacationRequestForm{
+		Type:      entity.VacationTypeAnnual,
+		StartDate: start,
+		EndDate:   start.AddDate(0, 0, 4),
+	}
+
+	balanceQuery := queryContains("SELECT manager_id", "FROM leave_ledger WHERE employee_id = employees.id")
+	overlapQuery := queryContains("SELECT EXISTS (SELECT 1 FROM vacation_requests")
+
+	t.Run("request goes to the manager", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, balanceQuery, uint64(3), entity.VacationTypeAnnual,
+			entity.VacationStatusPendingManager, entity.VacationStatusPendingHR, entity.StatusFired, start, form.EndDate,
+		).Return(NewMockRow([]interface{}{Uint64Ptr(2), float64(28), uint64(5)}, nil, nil)).Once()
+		mockDB.On("QueryRow", mock.Anything, overlapQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, start, form.EndDate).
+			Return(NewMockRow([]interface{}{false}, nil, nil)).Once()
+		expectNoStaffingMinimum(mockDB, 3)
+		mockDB.On("Query", mock.Anything, queryContains("INSERT INTO vacation_requests"),
+			uint64(3), entity.VacationTypeAnnual, start, form.EndDate, uint64(5), entity.VacationStatusPendingManager,
+			mock.Anything, Uint64Ptr(2), mock.Anything,
+		).Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingManager, start, Uint64Ptr(2))), nil).Once()
+
+		request, err := controller.SubmitVacationRequest(3, form)
+		require.NoError(t, err)
+		assert.Equal(t, entity.VacationStatusPendingManager, request.Status)
+		assert.Equal(t, uint64(5), request.Days)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("request without manager goes to hr", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, balanceQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{nil, float64(28), uint64(5)}, nil, nil)).Once()
+		mockDB.On("QueryRow", mock.Anything, overlapQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, start, form.EndDate).
+			Return(NewMockRow([]interface{}{false}, nil, nil)).Once()
+		expectNoStaffingMinimum(mockDB, 3)
+		mockDB.On("Query", mock.Anything, queryContains("INSERT INTO vacation_requests"),
+			uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything, entity.VacationStatusPendingHR,
+			mock.Anything, mock.Anything, mock.Anything,
+		).Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingHR, start, nil)), nil).Once()
+
+		request, err := controller.SubmitVacationRequest(3, form)
+		require.NoError(t, err)
+		assert.Equal(t, entity.VacationStatusPendingHR, request.Status)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("not enough vacation days", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, balanceQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{Uint64Ptr(2), float64(4), uint64(5)}, nil, nil)).Once()
+
+		request, err := controller.SubmitVacationRequest(3, form)
+		assert.ErrorIs(t, err, ErrInsufficientVacationBalance)
+		assert.Nil(t, request)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("holidays are not vacation days", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, balanceQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{Uint64Ptr(2), float64(4), uint64(4)}, nil, nil)).Once()
+		mockDB.On("QueryRow", mock.Anything, overlapQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, start, form.EndDate).
+			Return(NewMockRow([]interface{}{false}, nil, nil)).Once()
+		expectNoStaffingMinimum(mockDB, 3)
+		mockDB.On("Query", mock.Anything, queryContains("INSERT INTO vacation_requests"),
+			uint64(3), mock.Anything, mock.Anything, mock.Anything, uint64(4), mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything,
+		).Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingManager, start, Uint64Ptr(2))), nil).Once()
+
+		_, err := controller.SubmitVacationRequest(3, form)
+		require.NoError(t, err)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("period without working days", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, balanceQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{Uint64Ptr(2), float64(28), uint64(0)}, nil, nil)).Once()
+
+		_, err := controller.SubmitVacationRequest(3, form)
+		assert.ErrorIs(t, err, ErrInvalidVacationRequest)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("unpaid leave does not need the balance", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		unpaid := form
+		unpaid.Type = entity.VacationTypeUnpaid
+
+		mockDB.On("QueryRow", mock.Anything, balanceQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow([]interface{}{Uint64Ptr(2), float64(0), uint64(5)}, nil, nil)).Once()
+		mockDB.On("QueryRow", mock.Anything, overlapQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, start, form.EndDate).
+			Return(NewMockRow([]interface{}{true}, nil, nil)).Once()
+
+		_, err := controller.SubmitVacationRequest(3, unpaid)
+		assert.ErrorIs(t, err, ErrVacationOverlap)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("QueryRow", mock.Anything, balanceQuery, uint64(3), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(NewMockRow(nil, pgx.ErrNoRows, nil)).Once()
+
+		_, err := controller.SubmitVacationRequest(3, form)
+		assert.ErrorIs(t, err, ErrEmployeeNotFound)
+	})
+
+	invalid := []struct {
+		name string
+		form entity.VacationRequestForm
+	}{
+		{name: "unknown type", form: entity.VacationRequestForm{Type: "sabbatical", StartDate: start, EndDate: start}},
+		{name: "missing dates", form: entity.VacationRequestForm{Type: entity.VacationTypeAnnual}},
+		{name: "end before start", form: entity.VacationRequestForm{Type: entity.VacationTypeAnnual, StartDate: start, EndDate: start.AddDate(0, 0, -1)}},
+		{name: "start in the past", form: entity.VacationRequestForm{Type: entity.VacationTypeAnnual, StartDate: start.AddDate(0, -2, 0), EndDate: start}},
+		{name: "period too long", form: entity.VacationRequestForm{Type: entity.VacationTypeUnpaid, StartDate: start, EndDate: start.AddDate(0, 0, MaxVacationDays)}},
+		{name: "ends in year 9999", form: entity.VacationRequestForm{Type: entity.VacationTypeStudy, StartDate: start, EndDate: time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := NewVacationController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+			_, err := controller.SubmitVacationRequest(3, tt.form)
+			assert.ErrorIs(t, err, ErrInvalidVacationRequest)
+		})
+	}
+}
+
+func TestVacationController_GetVacationRequests(t *testing.T) {
+	start := dateOnly(time.Now().AddDate(0, 1, 0))
+
+	tests := []struct {
+		name  string
+		user  *entity.Claims
+		query interface{}
+		args  []interface{}
+	}{
+		{
+			name:  "hr sees all requests",
+			user:  &entity.Claims{ID: 5, Role: entity.RoleHR},
+			query: queryContains("WHERE 1=1 ORDER BY"),
+		},
+		{
+			name:  "manager sees the team",
+			user:  &entity.Claims{ID: 2, Role: entity.RoleManager},
+			query: queryContains("(employee_id = $1 OR employee_id IN (SELECT id FROM employees WHERE manager_id = $1))"),
+			args:  []interface{}{uint64(2)},
+		},
+		{
+			name:  "employee sees own requests",
+			user:  &entity.Claims{ID: 3, Role: entity.RoleEmployee},
+			query: queryContains("AND employee_id = $1 ORDER BY"),
+			args:  []interface{}{uint64(3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+			mockDB.On("Query", append([]interface{}{mock.Anything, tt.query}, tt.args...)...).
+				Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingManager, start, Uint64Ptr(2))), nil).Once()
+
+			requests, err := controller.GetVacationRequests(tt.user, nil)
+			require.NoError(t, err)
+			assert.Len(t, requests, 1)
+			mockDB.AssertExpectations(t)
+		})
+	}
+
+	t.Run("status filter", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		status := entity.VacationStatusPendingManager
+		mockDB.On("Query", mock.Anything, queryContains("AND status = $1", "AND (employee_id = $2 OR"), status, uint64(2)).
+			Return(vacationRequestRows(), nil).Once()
+
+		requests, err := controller.GetVacationRequests(&entity.Claims{ID: 2, Role: entity.RoleManager},
+			&entity.GetVacationRequestsParams{Status: &status})
+		require.NoError(t, err)
+		assert.Empty(t, requests)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		controller := NewVacationController(CreateTestDependencies(&MockDB{}, &MockRedis{}))
+
+		status := "approved_maybe"
+		_, err := controller.GetVacationRequests(&entity.Claims{ID: 1, Role: entity.RoleAdmin},
+			&entity.GetVacationRequestsParams{Status: &status})
+		assert.ErrorIs(t, err, ErrInvalidVacationRequest)
+	})
+}
+
+func TestVacationController_ApproveVacationRequest(t *testing.T) {
+	const selectQuery = "SELECT " + vacationRequestColumns + " FROM vacation_requests WHERE id = $1"
+	const managerQuery = "SELECT manager_id FROM employees WHERE id = $1"
+
+	start := dateOnly(time.Now().AddDate(0, 1, 0))
+
+	t.Run("manager passes the request to hr", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+			Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingManager, start, Uint64Ptr(2))), nil).Once()
+		mockDB.On("QueryRow", mock.Anything, managerQuery, uint64(3)).Return(NewMockRow([]interface{}{Uint64Ptr(2)}, nil, nil)).Once()
+		expectNoStaffingMinimum(mockDB, 3)
+		mockDB.On("Query", mock.Anything, queryContains("SET status = $1, manager_decided_by = $2"),
+			entity.VacationStatusPendingHR, uint64(2), mock.Anything, uint64(1), entity.VacationStatusPendingManager,
+		).Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingHR, start, Uint64Ptr(2))), nil).Once()
+
+		request, err := controller.ApproveVacationRequest(&entity.Claims{ID: 2, Role: entity.RoleManager}, 1)
+		require.NoError(t, err)
+		assert.Equal(t, entity.VacationStatusPendingHR, request.Status)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("hr approves and debits the balance", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+			Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingHR, start, Uint64Ptr(2))), nil).Once()
+		expectNoStaffingMinimum(mockDB, 3)
+		mockDB.On("Query", mock.Anything, queryContains("SET status = $1, hr_decided_by = $2", "INSERT INTO leave_ledger", "-days"),
+			entity.VacationStatusApproved, uint64(5), mock.Anything, uint64(1), entity.VacationStatusPendingHR, entity.VacationTypeAnnual,
+			entity.LeaveEntryUsage,
+		).Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusApproved, start, Uint64Ptr(2))), nil).Once()
+
+		request, err := controller.ApproveVacationRequest(&entity.Claims{ID: 5, Role: entity.RoleHR}, 1)
+		require.NoError(t, err)
+		assert.Equal(t, entity.VacationStatusApproved, request.Status)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("balance used up meanwhile", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		checkErr := &pgconn.PgError{Code: pgCheckViolation, ConstraintName: leaveBalanceConstraint}
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+			Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingHR, start, Uint64Ptr(2))), nil).Once()
+		expectNoStaffingMinimum(mockDB, 3)
+		mockDB.On("Query", mock.Anything, queryContains("hr_decided_by"), mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		).Return(NewMockRows(nil, checkErr, VacationRequestFieldDescriptions), nil).Once()
+
+		_, err := controller.ApproveVacationRequest(&entity.Claims{ID: 5, Role: entity.RoleHR}, 1)
+		assert.ErrorIs(t, err, ErrInsufficientVacationBalance)
+	})
+
+	denied := []struct {
+		name    string
+		user    *entity.Claims
+		status  string
+		manager *uint64
+	}{
+		{name: "manager of another team", user: &entity.Claims{ID: 7, Role: entity.RoleManager}, status: entity.VacationStatusPendingManager, manager: Uint64Ptr(2)},
+		{name: "hr at the manager stage", user: &entity.Claims{ID: 5, Role: entity.RoleHR}, status: entity.VacationStatusPendingManager, manager: Uint64Ptr(2)},
+		{name: "manager at the hr stage", user: &entity.Claims{ID: 2, Role: entity.RoleManager}, status: entity.VacationStatusPendingHR},
+		{name: "own request", user: &entity.Claims{ID: 3, Role: entity.RoleHR}, status: entity.VacationStatusPendingHR},
+	}
+
+	for _, tt := range denied {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &MockDB{}
+			controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+			mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+				Return(vacationRequestRows(vacationRequestRow(1, 3, tt.status, start, tt.manager)), nil).Once()
+			mockDB.On("QueryRow", mock.Anything, managerQuery, uint64(3)).Return(NewMockRow([]interface{}{tt.manager}, nil, nil)).Maybe()
+
+			_, err := controller.ApproveVacationRequest(tt.user, 1)
+			assert.ErrorIs(t, err, ErrNotVacationApprover)
+			assert.ErrorIs(t, err, ErrPermissionDenied)
+		})
+	}
+
+	t.Run("request already decided", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+			Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusApproved, start, nil)), nil).Once()
+
+		_, err := controller.ApproveVacationRequest(&entity.Claims{ID: 1, Role: entity.RoleAdmin}, 1)
+		assert.ErrorIs(t, err, ErrVacationRequestNotPending)
+	})
+
+	t.Run("request not found", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).Return(vacationRequestRows(), nil).Once()
+
+		_, err := controller.ApproveVacationRequest(&entity.Claims{ID: 1, Role: entity.RoleAdmin}, 1)
+		assert.ErrorIs(t, err, ErrVacationRequestNotFound)
+	})
+}
+
+func TestVacationController_RejectVacationRequest(t *testing.T) {
+	start := dateOnly(time.Now().AddDate(0, 1, 0))
+	reason := "Сдача проекта"
+
+	mockDB := &MockDB{}
+	controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+	rejected := vacationRequestRow(1, 3, entity.VacationStatusRejected, start, Uint64Ptr(2))
+	rejected[11] = &reason
+
+	mockDB.On("Query", mock.Anything, "SELECT "+vacationRequestColumns+" FROM vacation_requests WHERE id = $1", uint64(1)).
+		Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingManager, start, Uint64Ptr(2))), nil).Once()
+	mockDB.On("QueryRow", mock.Anything, "SELECT manager_id FROM employees WHERE id = $1", uint64(3)).
+		Return(NewMockRow([]interface{}{Uint64Ptr(2)}, nil, nil)).Once()
+	mockDB.On("Query", mock.Anything, queryContains("manager_decided_by = $2, reject_reason = $3"),
+		entity.VacationStatusRejected, uint64(2), &reason, mock.Anything, uint64(1), entity.VacationStatusPendingManager,
+	).Return(vacationRequestRows(rejected), nil).Once()
+
+	request, err := controller.RejectVacationRequest(&entity.Claims{ID: 2, Role: entity.RoleManager}, 1, entity.VacationDecision{Reason: &reason})
+	require.NoError(t, err)
+	assert.Equal(t, entity.VacationStatusRejected, request.Status)
+	assert.Equal(t, &reason, request.RejectReason)
+	mockDB.AssertExpectations(t)
+}
+
+func TestVacationController_CancelVacationRequest(t *testing.T) {
+	const selectQuery = "SELECT " + vacationRequestColumns + " FROM vacation_requests WHERE id = $1"
+
+	future := dateOnly(time.Now().AddDate(0, 1, 0))
+	employee := &entity.Claims{ID: 3, Role: entity.RoleEmployee}
+
+	t.Run("pending request is withdrawn", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+			Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusPendingHR, future, nil)), nil).Once()
+		mockDB.On("Query", mock.Anything, mock.MatchedBy(func(query string) bool {
+			return strings.Contains(query, "UPDATE vacation_requests SET status = $1, updated_at = $2") && !strings.Contains(query, "refund")
+		}), entity.VacationStatusCancelled, mock.Anything, uint64(1), entity.VacationStatusPendingHR,
+		).Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusCancelled, future, nil)), nil).Once()
+
+		request, err := controller.CancelVacationRequest(employee, 1)
+		require.NoError(t, err)
+		assert.Equal(t, entity.VacationStatusCancelled, request.Status)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("approved request refunds the balance", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+			Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusApproved, future, nil)), nil).Once()
+		mockDB.On("Query", mock.Anything, queryContains("refund AS", "INSERT INTO leave_ledger"),
+			entity.VacationStatusCancelled, mock.Anything, uint64(1), entity.VacationStatusApproved, entity.VacationTypeAnnual,
+			entity.LeaveEntryUsage, uint64(3),
+		).Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusCancelled, future, nil)), nil).Once()
+
+		request, err := controller.CancelVacationRequest(employee, 1)
+		require.NoError(t, err)
+		assert.Equal(t, entity.VacationStatusCancelled, request.Status)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("vacation already started", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		started := dateOnly(time.Now())
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+			Return(vacationRequestRows(vacationRequestRow(1, 3, entity.VacationStatusApproved, started, nil)), nil).Once()
+
+		_, err := controller.CancelVacationRequest(employee, 1)
+		assert.ErrorIs(t, err, ErrVacationStarted)
+	})
+
+	t.Run("request of another employee", func(t *testing.T) {
+		mockDB := &MockDB{}
+		controller := NewVacationController(CreateTestDependencies(mockDB, &MockRedis{}))
+
+		mockDB.On("Query", mock.Anything, selectQuery, uint64(1)).
+			Return(vacationRequestRows(vacationRequestRow(1, 4, entity.VacationStatusPendingHR, future, nil)), nil).Once()
+
+		_, err := controller.CancelVacationRequest(employee, 1)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrVersionRequired = errors.New("version of the record is required")
+	ErrVersionMismatch = errors.New("record was modified by another request")
+)
+
+// requireVersion checks that the caller sent the version it read the record at.
+func requireVersion(expectedVersion uint64) error {
+	if expectedVersion == 0 {
+		return ErrVersionRequired
+	}
+
+	return nil
+}
+
+// versionConflict tells why a write conditioned on the version matched no
+// row: the record is missing, in which case notFound is returned, or it was
+// changed after the caller read it.
+func versionConflict(deps *Dependens, table string, id, expectedVersion uint64, notFound error) error {
+	var current uint64
+
+	err := deps.DB.QueryRow(context.Background(), "SELECT version FROM "+table+" WHERE id = $1", id).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return notFound
+		}
+
+		deps.Logger.Error("Error getting record version", slog.String("table", table), slog.String("error", err.Error()))
+		return err
+	}
+
+	deps.Logger.Warn("Stale record version",
+		slog.String("table", table),
+		slog.Any("id", id),
+		slog.Any("expected_version", expectedVersion),
+		slog.Any("current_version", current))
+
+	return ErrVersionMismatch
+}
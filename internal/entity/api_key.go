@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// APIKey is a machine credential of another service. The secret itself is never
+// stored, only its hash; Prefix identifies the key in listings and logs.
+type APIKey struct {
+	ID         uint64     `json:"id"`
+	Name       string     `json:"name"`
+	Owner      string     `json:"owner"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedBy  *uint64    `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// APIKeyForm is the input for creating or updating an API key.
+type APIKeyForm struct {
+	Name      string     `json:"name"`
+	Owner     string     `json:"owner"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreatedAPIKey is returned once on creation, Key is not shown again.
+type CreatedAPIKey struct {
+	APIKey
+
+	Key string `json:"key"`
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// AuditEntry is a record of the audit trail. ActorID is the employee who
+// actually acted, EmployeeID the one the actor was impersonating.
+type AuditEntry struct {
+	ID         uint64    `json:"id"`
+	ActorID    uint64    `json:"actor_id"`
+	EmployeeID *uint64   `json:"employee_id"`
+	Action     string    `json:"action"`
+	TargetID   *uint64   `json:"target_id"`
+	Allowed    bool      `json:"allowed"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type GetAuditLogParams struct {
+	ActorID    *uint64 `json:"actor_id,omitempty"`
+	EmployeeID *uint64 `json:"employee_id,omitempty"`
+}
+
+// ImpersonationToken is a short-lived access token of another employee. It
+// cannot be refreshed.
+type ImpersonationToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
@@ -0,0 +1,64 @@
+package entity
+
+import "time"
+
+const (
+	// CalendarDayHoliday is a day off on a weekday: a public holiday or a day
+	// off transferred from a weekend.
+	CalendarDayHoliday = "holiday"
+	// CalendarDayWorking is a working Saturday or Sunday transferred from a weekday.
+	CalendarDayWorking = "working"
+)
+
+const (
+	CalendarFormatICS = "ics"
+	CalendarFormatCSV = "csv"
+)
+
+// Calendar is a production calendar of an office. A department uses its own
+// calendar or the one of the nearest parent department that has one.
+type Calendar struct {
+	ID          uint64    `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CalendarForm is the input for creating a calendar.
+type CalendarForm struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// CalendarDay is a day that differs from the usual Monday to Friday week.
+type CalendarDay struct {
+	CalendarID uint64    `json:"calendar_id"`
+	Date       time.Time `json:"date"`
+	Kind       string    `json:"kind"`
+	Name       *string   `json:"name"`
+}
+
+// CalendarImport is the result of importing days into a calendar.
+type CalendarImport struct {
+	CalendarID uint64 `json:"calendar_id"`
+	Imported   int64  `json:"imported"`
+}
+
+// WorkingDays are the days of a period, both dates included, counted by the
+// calendar of the employee. CalendarID is nil when the employee has no
+// calendar and only weekends are days off.
+type WorkingDays struct {
+	EmployeeID   uint64    `json:"employee_id"`
+	CalendarID   *uint64   `json:"calendar_id"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+	CalendarDays uint64    `json:"calendar_days"`
+	WorkingDays  uint64    `json:"working_days"`
+	Holidays     uint64    `json:"holidays"`
+}
+
+type GetCalendarDaysParams struct {
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+const (
+	AbsenceVacation  = "vacation"
+	AbsenceSickLeave = "sick_leave"
+	// AbsenceOther is a sick leave shown to those who may not see sick leaves of the employee.
+	AbsenceOther = "absence"
+)
+
+// CalendarToken lets calendar clients, which cannot send the Authorization
+// header, subscribe to absence feeds on behalf of an employee. The token
+// itself is never stored, only its hash; Prefix identifies it in listings.
+type CalendarToken struct {
+	ID         uint64     `json:"id"`
+	EmployeeID uint64     `json:"employee_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CalendarTokenForm is the input for creating a calendar token.
+type CalendarTokenForm struct {
+	Name string `json:"name"`
+}
+
+// CreatedCalendarToken is returned once on creation, Token is not shown again.
+type CreatedCalendarToken struct {
+	CalendarToken
+
+	Token string `json:"token"`
+}
+
+// Absence is an approved vacation or a sick leave shown in a calendar feed.
+// EndDate of an open sick leave is today.
+type Absence struct {
+	Kind       string `json:"kind"`
+	ID         uint64 `json:"id"`
+	EmployeeID uint64 `json:"employee_id"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	// Reason is the vacation type, empty for a sick leave.
+	Reason    string    `json:"reason"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CalendarFeedParams struct {
+	// Birthdays and Anniversaries add yearly events for the birthdays and
+	// the work anniversaries of the employees.
+	Birthdays     bool `json:"birthdays,omitempty"`
+	Anniversaries bool `json:"anniversaries,omitempty"`
+}
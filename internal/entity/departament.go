@@ -3,11 +3,27 @@ package entity
 import "time"
 
 type Department struct {
-	ID          uint64    `json:"id,omitempty"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	ParentID    *uint64   `json:"parent_id"`
-	HeadID      *uint64   `json:"head_id"`
-	CreatedAt   time.Time `json:"created_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+	ID          uint64  `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	ParentID    *uint64 `json:"parent_id"`
+	HeadID      *uint64 `json:"head_id"`
+	// CalendarID is the production calendar of the department, without it the
+	// calendar of the parent department is used.
+	CalendarID *uint64 `json:"calendar_id"`
+	// MinOnDuty and MinOnDutyPercent are the least number and share of the
+	// staff that must stay at work when vacations are requested and approved.
+	MinOnDuty        *uint64   `json:"min_on_duty"`
+	MinOnDutyPercent *uint64   `json:"min_on_duty_percent"`
+	CreatedAt        time.Time `json:"created_at,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at,omitempty"`
+	// Version is increased by every change of the department and is sent as the ETag.
+	Version uint64 `json:"version"`
+}
+
+// DepartmentPatch is a partial update of a department: only Fields, named as
+// in JSON, are changed to their values in Department.
+type DepartmentPatch struct {
+	Fields     []string
+	Department Department
 }
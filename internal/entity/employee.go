@@ -6,6 +6,21 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	RoleAdmin    = "admin"
+	RoleHR       = "hr"
+	RoleManager  = "manager"
+	RoleEmployee = "employee"
+)
+
+const (
+	StatusActive    = "active"
+	StatusFired     = "fired"
+	StatusSuspended = "suspended"
+	// StatusSick is set while the employee has an open sick leave.
+	StatusSick = "sick"
+)
+
 type Employee struct {
 	Address        *string    `json:"address"`
 	Birthday       *time.Time `json:"birthday"`
@@ -25,26 +40,121 @@ type Employee struct {
 	Phone          *string    `json:"phone"`
 	Position       *string    `json:"position"`
 	Role           string     `json:"role"`
-	SickDays       *uint64    `json:"sick_days,omitempty"`
-	Status         string     `json:"status"`
-	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
-	VacationDays   *uint64    `json:"vacation_days,omitempty"`
+	// SickDays are the days of sick leave in the current year and VacationDays
+	// the whole days of the leave balance. Both are derived and ignored on writes.
+	SickDays     *uint64    `json:"sick_days,omitempty"`
+	Status       string     `json:"status"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	VacationDays *uint64    `json:"vacation_days,omitempty"`
+	// Version is increased by every change of the employee and is sent as the ETag.
+	Version uint64 `json:"version"`
+}
+
+// EmployeePatch is a partial update of an employee: only Fields, named as in
+// JSON, are changed to their values in Employee. A nil value clears the field.
+type EmployeePatch struct {
+	Fields   []string
+	Employee Employee
 }
 
 type LoginRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+
+	// ClientIP is filled by the transport and used to limit failed attempts.
+	ClientIP string `json:"-"`
 }
 
-type VacationRequest struct {
-	Days uint64 `json:"days"`
+// Lockout is a temporary block of logins after failed attempts, either for an
+// email or for a client IP.
+type Lockout struct {
+	Scope     string    `json:"scope"`
+	Value     string    `json:"value"`
+	Failures  int64     `json:"failures"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
+// LoginResponse contains either the token pair or, when the account has two-factor
+// authentication enabled, the MFA token to exchange through POST /auth/mfa/verify.
 type LoginResponse struct {
-	AccessToken  string `json:"access_token"`
+	AccessToken  string `json:"access_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	MFAToken     string `json:"mfa_token,omitempty"`
+}
+
+// MFAEnrollment is the pending TOTP secret of the user and its otpauth URI for QR codes.
+type MFAEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code"`
+}
+
+type MFAVerifyRequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+
+	// ClientIP is filled by the transport and used to limit failed attempts.
+	ClientIP string `json:"-"`
+}
+
+// MFAEnabled is returned once after enabling TOTP. The recovery codes are not
+// stored in plain text and cannot be shown again.
+type MFAEnabled struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+	AccessToken   string   `json:"access_token"`
+	RefreshToken  string   `json:"refresh_token"`
+}
+
+type RefreshRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password"`
+	NewPassword string `json:"new_password"`
+}
+
+type ResetPasswordRequest struct {
+	ResetToken  string `json:"reset_token"`
+	NewPassword string `json:"new_password"`
+}
+
+// PasswordReset is a single-use token issued by admin or hr that lets the
+// employee set a new password without knowing the old one.
+type PasswordReset struct {
+	ResetToken string    `json:"reset_token"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// Session is one login of an employee, identified by the TokenID claim shared by
+// its tokens. Every refresh rotates the token pair but keeps the session.
+type Session struct {
+	ID           string    `json:"id"`
+	UserID       uint64    `json:"user_id"`
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	CreatedAt    time.Time `json:"created_at"`
+	RefreshedAt  time.Time `json:"refreshed_at"`
+
+	// PasswordChangeRequired is copied to the tokens of the session.
+	PasswordChangeRequired bool `json:"password_change_required"`
+	// MFAEnrollmentRequired is copied to the tokens of the session.
+	MFAEnrollmentRequired bool `json:"mfa_enrollment_required"`
+	// ActorID is the admin who opened the session by impersonation.
+	ActorID uint64 `json:"actor_id,omitempty"`
+}
+
+// SessionInfo is the public view of a session.
+type SessionInfo struct {
+	ID          string    `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	RefreshedAt time.Time `json:"refreshed_at"`
+	Current     bool      `json:"current"`
+}
+
 type Claims struct {
 	jwt.RegisteredClaims
 
@@ -52,10 +162,53 @@ type Claims struct {
 	Email   string `json:"email"`
 	Role    string `json:"role"`
 	TokenID string `json:"token_id"`
+	Type    string `json:"type"`
+
+	// PasswordChangeRequired restricts the token to the change password operation.
+	PasswordChangeRequired bool `json:"pwd_change_required,omitempty"`
+	// MFAEnrollmentRequired restricts the token to the TOTP enrollment operations.
+	MFAEnrollmentRequired bool `json:"mfa_enrollment_required,omitempty"`
+
+	// APIKeyID and Scopes are set instead of ID and Role when the caller
+	// authenticated with an API key.
+	APIKeyID uint64   `json:"api_key_id,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+
+	// ActorID is the admin who impersonates the employee ID. It is set only on
+	// impersonation tokens.
+	ActorID uint64 `json:"actor_id,omitempty"`
 }
 
 type GetEmployeesParams struct {
 	Role         *string `json:"role,omitempty"`
 	DepartmentID *uint64 `json:"department_id,omitempty"`
 	Status       *string `json:"status,omitempty"`
+
+	ManagerID     *uint64    `json:"manager_id,omitempty"`
+	IsActive      *bool      `json:"is_active,omitempty"`
+	HiredFrom     *time.Time `json:"hired_from,omitempty"`
+	HiredTo       *time.Time `json:"hired_to,omitempty"`
+	BirthdayMonth *int       `json:"birthday_month,omitempty"`
+	Position      *string    `json:"position,omitempty"`
+
+	Limit        *int    `json:"limit,omitempty"`
+	PageToken    *string `json:"page_token,omitempty"`
+	SortBy       *string `json:"sort_by,omitempty"`
+	SortOrder    *string `json:"sort_order,omitempty"`
+	IncludeTotal *bool   `json:"include_total,omitempty"`
+}
+
+type SearchEmployeesParams struct {
+	Q        string  `json:"q"`
+	Limit    *int    `json:"limit,omitempty"`
+	Translit *bool   `json:"translit,omitempty"`
+	Status   *string `json:"status,omitempty"`
+}
+
+// EmployeePage is one page of the employee listing. NextPageToken is empty on
+// the last page, TotalCount is set only when requested.
+type EmployeePage struct {
+	Employees     []Employee `json:"employees"`
+	NextPageToken string     `json:"next_page_token,omitempty"`
+	TotalCount    *int64     `json:"total_count,omitempty"`
 }
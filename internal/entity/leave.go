@@ -0,0 +1,72 @@
+package entity
+
+import "time"
+
+const (
+	// LeaveTypeAnnual is the paid annual leave, used by annual vacation requests.
+	LeaveTypeAnnual = "annual"
+)
+
+const (
+	LeaveEntryAccrual    = "accrual"
+	LeaveEntryUsage      = "usage"
+	LeaveEntryAdjustment = "adjustment"
+	LeaveEntryExpiry     = "expiry"
+)
+
+// LeaveEntry is a record of the leave ledger. Days are signed: accruals add to
+// the balance, usage and expiry take from it. Entries are never changed, a
+// mistake is corrected with an adjustment.
+type LeaveEntry struct {
+	ID                uint64    `json:"id"`
+	EmployeeID        uint64    `json:"employee_id"`
+	LeaveType         string    `json:"leave_type"`
+	Kind              string    `json:"kind"`
+	Days              float64   `json:"days"`
+	EffectiveDate     time.Time `json:"effective_date"`
+	Period            *int64    `json:"period"`
+	VacationRequestID *uint64   `json:"vacation_request_id"`
+	Comment           *string   `json:"comment"`
+	CreatedBy         *uint64   `json:"created_by"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// LeaveHistoryEntry is a ledger entry with the balance of its leave type after
+// the entry.
+type LeaveHistoryEntry struct {
+	LeaveEntry
+	Balance float64 `json:"balance"`
+}
+
+// LeaveBalance is the balance of one leave type derived from the ledger, with
+// the totals of every kind of entry. Used and Expired are positive, Used is net
+// of the days returned by cancelled vacations.
+type LeaveBalance struct {
+	LeaveType string  `json:"leave_type"`
+	Balance   float64 `json:"balance"`
+	Accrued   float64 `json:"accrued"`
+	Used      float64 `json:"used"`
+	Adjusted  float64 `json:"adjusted"`
+	Expired   float64 `json:"expired"`
+}
+
+// LeaveBalances are the balances of an employee on a date.
+type LeaveBalances struct {
+	EmployeeID uint64         `json:"employee_id"`
+	AsOf       time.Time      `json:"as_of"`
+	Balances   []LeaveBalance `json:"balances"`
+}
+
+// LeaveAdjustment is the input for correcting a balance by hand.
+type LeaveAdjustment struct {
+	LeaveType     string     `json:"leave_type"`
+	Days          float64    `json:"days"`
+	EffectiveDate *time.Time `json:"effective_date"`
+	Comment       string     `json:"comment"`
+}
+
+type GetLeaveLedgerParams struct {
+	LeaveType *string    `json:"leave_type,omitempty"`
+	From      *time.Time `json:"from,omitempty"`
+	To        *time.Time `json:"to,omitempty"`
+}
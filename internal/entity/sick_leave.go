@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+// SickLeave is a sick leave of an employee. An open leave has no EndDate yet;
+// Days are calendar days including both dates, for an open leave up to today.
+type SickLeave struct {
+	ID                uint64     `json:"id"`
+	EmployeeID        uint64     `json:"employee_id"`
+	StartDate         time.Time  `json:"start_date"`
+	EndDate           *time.Time `json:"end_date"`
+	Days              uint64     `json:"days"`
+	CertificateNumber *string    `json:"certificate_number"`
+	Comment           *string    `json:"comment"`
+	CreatedBy         *uint64    `json:"created_by"`
+	ClosedBy          *uint64    `json:"closed_by"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// SickLeaveForm is the input for registering a sick leave. Without EndDate the
+// leave stays open until it is closed.
+type SickLeaveForm struct {
+	StartDate         time.Time  `json:"start_date"`
+	EndDate           *time.Time `json:"end_date"`
+	CertificateNumber *string    `json:"certificate_number"`
+	Comment           *string    `json:"comment"`
+}
+
+// SickLeaveClose is the input for closing an open sick leave.
+type SickLeaveClose struct {
+	EndDate           time.Time `json:"end_date"`
+	CertificateNumber *string   `json:"certificate_number"`
+}
+
+type GetSickLeavesParams struct {
+	From         *time.Time `json:"from,omitempty"`
+	To           *time.Time `json:"to,omitempty"`
+	DepartmentID *uint64    `json:"department_id,omitempty"`
+	EmployeeID   *uint64    `json:"employee_id,omitempty"`
+	Open         *bool      `json:"open,omitempty"`
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// StaffingConflict reports the working days of a vacation on which fewer
+// employees of the department than its minimum would stay at work.
+type StaffingConflict struct {
+	DepartmentID uint64 `json:"department_id"`
+	// Headcount is the staff of the department, without fired and suspended employees.
+	Headcount uint64 `json:"headcount"`
+	// MinOnDuty is the least number of employees at work required by the department.
+	MinOnDuty uint64                `json:"min_on_duty"`
+	Days      []StaffingConflictDay `json:"days"`
+}
+
+// StaffingConflictDay is a day of the conflict with the colleagues who are out.
+type StaffingConflictDay struct {
+	Date time.Time `json:"date"`
+	// OnDuty is the number of employees at work if the vacation is granted.
+	OnDuty uint64            `json:"on_duty"`
+	Absent []StaffingAbsence `json:"absent"`
+}
+
+// StaffingAbsence is an approved vacation or a sick leave of a colleague.
+type StaffingAbsence struct {
+	EmployeeID uint64 `json:"employee_id"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	// Reason is the vacation type, or sick for a sick leave.
+	Reason    string    `json:"reason"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+const (
+	// VacationTypeAnnual is the paid annual leave, debited from the vacation balance.
+	VacationTypeAnnual = "annual"
+	VacationTypeUnpaid = "unpaid"
+	VacationTypeStudy  = "study"
+)
+
+const (
+	VacationStatusPendingManager = "pending_manager"
+	VacationStatusPendingHR      = "pending_hr"
+	VacationStatusApproved       = "approved"
+	VacationStatusRejected       = "rejected"
+	VacationStatusCancelled      = "cancelled"
+)
+
+// VacationRequest is a request of an employee for a leave. It is approved by
+// the manager of the employee and then by hr; Days are calendar days including
+// both dates.
+type VacationRequest struct {
+	ID               uint64    `json:"id"`
+	EmployeeID       uint64    `json:"employee_id"`
+	Type             string    `json:"type"`
+	StartDate        time.Time `json:"start_date"`
+	EndDate          time.Time `json:"end_date"`
+	Days             uint64    `json:"days"`
+	Status           string    `json:"status"`
+	Comment          *string   `json:"comment"`
+	ManagerID        *uint64   `json:"manager_id"`
+	ManagerDecidedBy *uint64   `json:"manager_decided_by"`
+	HRDecidedBy      *uint64   `json:"hr_decided_by"`
+	RejectReason     *string   `json:"reject_reason"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// VacationRequestForm is the input for submitting a vacation request.
+type VacationRequestForm struct {
+	Type      string    `json:"type"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Comment   *string   `json:"comment"`
+}
+
+// VacationDecision is the input for rejecting a vacation request.
+type VacationDecision struct {
+	Reason *string `json:"reason"`
+}
+
+type GetVacationRequestsParams struct {
+	Status     *string `json:"status,omitempty"`
+	EmployeeID *uint64 `json:"employee_id,omitempty"`
+}
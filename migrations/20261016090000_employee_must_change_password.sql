@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Флаг обязательной смены пароля (временный пароль при создании сотрудника)
+ALTER TABLE employees
+ADD COLUMN must_change_password BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE employees
+DROP COLUMN IF EXISTS must_change_password;
+-- +goose StatementEnd
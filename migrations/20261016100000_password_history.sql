@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE password_history (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL,
+    password_hash VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_password_history_employee_id FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE
+);
+
+-- Создание индекса для выборки последних паролей сотрудника
+CREATE INDEX idx_password_history_employee_id ON password_history(employee_id, created_at DESC);
+
+-- Текущие пароли сотрудников становятся первой записью истории
+INSERT INTO password_history (employee_id, password_hash)
+SELECT id, password FROM employees;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_password_history_employee_id;
+DROP TABLE IF EXISTS password_history;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE employee_mfa (
+    employee_id INTEGER PRIMARY KEY,
+    secret VARCHAR NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    -- SHA-256 хеши неиспользованных кодов восстановления
+    recovery_codes TEXT[] NOT NULL DEFAULT '{}',
+    -- Последний использованный шаг TOTP, защищает от повторного использования кода
+    last_used_step BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    enabled_at TIMESTAMP,
+    CONSTRAINT fk_employee_mfa_employee_id FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS employee_mfa;
+-- +goose StatementEnd
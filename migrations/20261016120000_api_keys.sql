@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE api_keys (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    -- Сервис или команда, отвечающая за ключ
+    owner VARCHAR NOT NULL,
+    -- Начало ключа для отображения в списках и логах
+    prefix VARCHAR NOT NULL,
+    -- SHA-256 хеш ключа, сам ключ не хранится
+    key_hash VARCHAR NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    created_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_api_keys_created_by FOREIGN KEY (created_by) REFERENCES employees(id) ON DELETE SET NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Идентификатор сотрудника в корпоративном IdP (claim sub), связывается при первом входе через OIDC
+ALTER TABLE employees ADD COLUMN oidc_subject VARCHAR UNIQUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE employees DROP COLUMN IF EXISTS oidc_subject;
+-- +goose StatementEnd
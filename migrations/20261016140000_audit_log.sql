@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    -- Сотрудник, который на самом деле выполнил действие (admin при имперсонации)
+    actor_id INTEGER NOT NULL,
+    -- Сотрудник, от имени которого выполнено действие
+    employee_id INTEGER,
+    action VARCHAR NOT NULL,
+    -- Запись, к которой применялась операция
+    target_id INTEGER,
+    -- FALSE, если операция была запрещена
+    allowed BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_audit_log_actor ON audit_log (actor_id, created_at);
+CREATE INDEX idx_audit_log_employee ON audit_log (employee_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_log;
+-- +goose StatementEnd
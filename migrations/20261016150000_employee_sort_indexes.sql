@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Индексы для постраничного списка сотрудников: сортировка по полю с id для стабильного порядка
+CREATE INDEX idx_employees_last_name_id ON employees(last_name, id);
+CREATE INDEX idx_employees_first_name_id ON employees(first_name, id);
+CREATE INDEX idx_employees_hire_date_id ON employees(hire_date, id);
+CREATE INDEX idx_employees_created_at_id ON employees(created_at, id);
+CREATE INDEX idx_employees_updated_at_id ON employees(updated_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_employees_last_name_id;
+DROP INDEX IF EXISTS idx_employees_first_name_id;
+DROP INDEX IF EXISTS idx_employees_hire_date_id;
+DROP INDEX IF EXISTS idx_employees_created_at_id;
+DROP INDEX IF EXISTS idx_employees_updated_at_id;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Полнотекстовый индекс по ФИО, email, телефону, должности и табельному номеру.
+-- Конфигурация simple не стемит слова, поэтому одинаково работает для кириллических и латинских имен
+ALTER TABLE employees ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('simple', translate(lower(
+        coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(middle_name, '') || ' ' ||
+        coalesce(email, '') || ' ' || coalesce(phone, '') || ' ' || coalesce(position, '') || ' ' ||
+        coalesce(personal_number, '')
+    ), 'ё', 'е'))
+) STORED;
+
+-- Те же поля одной строкой в нижнем регистре (ё заменена на е) для поиска по триграммам и подстроке
+ALTER TABLE employees ADD COLUMN search_text TEXT GENERATED ALWAYS AS (
+    translate(lower(
+        coalesce(first_name, '') || ' ' || coalesce(last_name, '') || ' ' || coalesce(middle_name, '') || ' ' ||
+        coalesce(email, '') || ' ' || coalesce(phone, '') || ' ' || coalesce(position, '') || ' ' ||
+        coalesce(personal_number, '')
+    ), 'ё', 'е')
+) STORED;
+
+-- Только цифры телефона, чтобы фрагмент номера находился независимо от форматирования
+ALTER TABLE employees ADD COLUMN phone_digits TEXT GENERATED ALWAYS AS (
+    regexp_replace(coalesce(phone, ''), '\D', '', 'g')
+) STORED;
+
+CREATE INDEX idx_employees_search_vector ON employees USING GIN (search_vector);
+CREATE INDEX idx_employees_search_text_trgm ON employees USING GIN (search_text gin_trgm_ops);
+CREATE INDEX idx_employees_phone_digits_trgm ON employees USING GIN (phone_digits gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_employees_search_vector;
+DROP INDEX IF EXISTS idx_employees_search_text_trgm;
+DROP INDEX IF EXISTS idx_employees_phone_digits_trgm;
+ALTER TABLE employees DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE employees DROP COLUMN IF EXISTS search_text;
+ALTER TABLE employees DROP COLUMN IF EXISTS phone_digits;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Версия записи для оптимистичных блокировок: увеличивается при каждом изменении,
+-- клиент передает прочитанную версию в If-Match, и устаревшая запись не перезаписывается
+ALTER TABLE employees ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE departments ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE employees DROP COLUMN IF EXISTS version;
+ALTER TABLE departments DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE vacation_requests (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL,
+    -- annual списывает дни с баланса vacation_days, unpaid и study — нет
+    type VARCHAR NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    -- Календарные дни отпуска, включая start_date и end_date
+    days INTEGER NOT NULL,
+    -- pending_manager -> pending_hr -> approved, либо rejected или cancelled
+    status VARCHAR NOT NULL,
+    comment TEXT,
+    -- Руководитель сотрудника на момент подачи заявки
+    manager_id INTEGER,
+    manager_decided_by INTEGER,
+    hr_decided_by INTEGER,
+    reject_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT vacation_requests_dates_check CHECK (end_date >= start_date),
+    CONSTRAINT fk_vacation_requests_employee_id FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    CONSTRAINT fk_vacation_requests_manager_id FOREIGN KEY (manager_id) REFERENCES employees(id) ON DELETE SET NULL,
+    CONSTRAINT fk_vacation_requests_manager_decided_by FOREIGN KEY (manager_decided_by) REFERENCES employees(id) ON DELETE SET NULL,
+    CONSTRAINT fk_vacation_requests_hr_decided_by FOREIGN KEY (hr_decided_by) REFERENCES employees(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_vacation_requests_employee ON vacation_requests (employee_id, start_date);
+CREATE INDEX idx_vacation_requests_status ON vacation_requests (status);
+
+-- Баланс отпуска списывается при утверждении заявки и не может уйти в минус
+ALTER TABLE employees ADD CONSTRAINT employees_vacation_days_check CHECK (vacation_days >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS employees_vacation_days_check;
+DROP TABLE IF EXISTS vacation_requests;
+-- +goose StatementEnd
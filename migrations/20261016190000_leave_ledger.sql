@@ -0,0 +1,101 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE leave_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL,
+    -- annual — ежегодный отпуск, sick — больничные дни
+    leave_type VARCHAR NOT NULL,
+    -- accrual — начисление, usage — списание по заявке или его возврат при отмене,
+    -- adjustment — ручная корректировка hr, expiry — сгорание сверх лимита переноса
+    kind VARCHAR NOT NULL,
+    -- Дни со знаком: начисление положительное, списание и сгорание отрицательные
+    days NUMERIC(7, 2) NOT NULL,
+    -- С этой даты запись учитывается в балансе
+    effective_date DATE NOT NULL,
+    -- Год, за который сделано начисление или сгорание
+    period INTEGER,
+    vacation_request_id BIGINT,
+    comment TEXT,
+    -- Сотрудник, сделавший корректировку; для автоматических записей пусто
+    created_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT leave_ledger_kind_check CHECK (kind IN ('accrual', 'usage', 'adjustment', 'expiry')),
+    CONSTRAINT leave_ledger_days_check CHECK (
+        (kind = 'accrual' AND days >= 0) OR (kind = 'expiry' AND days < 0) OR (kind IN ('usage', 'adjustment') AND days <> 0)),
+    CONSTRAINT fk_leave_ledger_employee_id FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    CONSTRAINT fk_leave_ledger_vacation_request_id FOREIGN KEY (vacation_request_id) REFERENCES vacation_requests(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_leave_ledger_employee ON leave_ledger (employee_id, leave_type, effective_date);
+-- Начисление и сгорание делаются один раз за год, повторный запуск начисления их не дублирует
+CREATE UNIQUE INDEX idx_leave_ledger_period ON leave_ledger (employee_id, leave_type, kind, period) WHERE kind IN ('accrual', 'expiry');
+
+-- Журнал только дополняется. Записи удаляются лишь вместе с сотрудником
+CREATE FUNCTION leave_ledger_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND NOT EXISTS (SELECT 1 FROM employees WHERE id = OLD.employee_id) THEN
+        RETURN OLD;
+    END IF;
+
+    RAISE EXCEPTION 'leave_ledger is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER leave_ledger_append_only BEFORE UPDATE OR DELETE ON leave_ledger
+    FOR EACH ROW EXECUTE FUNCTION leave_ledger_append_only();
+
+-- Списание и корректировка не могут увести баланс в минус. Строка сотрудника
+-- блокируется, чтобы одновременные списания не прошли проверку оба
+CREATE FUNCTION leave_ledger_check_balance() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.days >= 0 OR NEW.kind = 'expiry' THEN
+        RETURN NEW;
+    END IF;
+
+    PERFORM 1 FROM employees WHERE id = NEW.employee_id FOR UPDATE;
+
+    IF (SELECT COALESCE(SUM(days), 0) FROM leave_ledger
+        WHERE employee_id = NEW.employee_id AND leave_type = NEW.leave_type) + NEW.days < 0 THEN
+        RAISE EXCEPTION 'not enough % days', NEW.leave_type
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'leave_ledger_balance_check';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER leave_ledger_check_balance BEFORE INSERT ON leave_ledger
+    FOR EACH ROW EXECUTE FUNCTION leave_ledger_check_balance();
+
+-- Баланс сотрудника на дату — сумма записей журнала, действующих на эту дату
+CREATE FUNCTION leave_balance(employee BIGINT, type VARCHAR, as_of DATE DEFAULT CURRENT_DATE) RETURNS NUMERIC AS $$
+    SELECT COALESCE(SUM(days), 0) FROM leave_ledger
+    WHERE employee_id = employee AND leave_type = type AND effective_date <= as_of;
+$$ LANGUAGE sql STABLE;
+
+-- Остатки переносятся в журнал как начисление за текущий год, чтобы оно не
+-- было начислено повторно
+INSERT INTO leave_ledger (employee_id, leave_type, kind, days, effective_date, period, comment)
+SELECT id, 'annual', 'accrual', GREATEST(vacation_days, 0), CURRENT_DATE, EXTRACT(YEAR FROM CURRENT_DATE), 'Остаток на момент перехода на журнал'
+FROM employees;
+
+INSERT INTO leave_ledger (employee_id, leave_type, kind, days, effective_date, period, comment)
+SELECT id, 'sick', 'accrual', GREATEST(sick_days, 0), CURRENT_DATE, EXTRACT(YEAR FROM CURRENT_DATE), 'Остаток на момент перехода на журнал'
+FROM employees;
+
+ALTER TABLE employees DROP COLUMN vacation_days, DROP COLUMN sick_days;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE employees ADD COLUMN vacation_days INTEGER NOT NULL DEFAULT 28, ADD COLUMN sick_days INTEGER NOT NULL DEFAULT 0;
+
+UPDATE employees SET vacation_days = GREATEST(FLOOR(leave_balance(id, 'annual')), 0), sick_days = GREATEST(FLOOR(leave_balance(id, 'sick')), 0);
+
+ALTER TABLE employees ADD CONSTRAINT employees_vacation_days_check CHECK (vacation_days >= 0);
+
+DROP TABLE IF EXISTS leave_ledger;
+DROP FUNCTION IF EXISTS leave_balance(BIGINT, VARCHAR, DATE);
+DROP FUNCTION IF EXISTS leave_ledger_check_balance();
+DROP FUNCTION IF EXISTS leave_ledger_append_only();
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sick_leaves (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL,
+    start_date DATE NOT NULL,
+    -- Последний день больничного; пусто, пока больничный открыт
+    end_date DATE,
+    -- Номер листка нетрудоспособности, может появиться при закрытии
+    certificate_number VARCHAR,
+    comment TEXT,
+    created_by INTEGER,
+    closed_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT sick_leaves_dates_check CHECK (end_date IS NULL OR end_date >= start_date),
+    CONSTRAINT fk_sick_leaves_employee_id FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    CONSTRAINT fk_sick_leaves_created_by FOREIGN KEY (created_by) REFERENCES employees(id) ON DELETE SET NULL,
+    CONSTRAINT fk_sick_leaves_closed_by FOREIGN KEY (closed_by) REFERENCES employees(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_sick_leaves_employee ON sick_leaves (employee_id, start_date);
+CREATE INDEX idx_sick_leaves_dates ON sick_leaves (start_date, end_date);
+-- Один листок нетрудоспособности не регистрируется дважды
+CREATE UNIQUE INDEX idx_sick_leaves_certificate ON sick_leaves (certificate_number) WHERE certificate_number IS NOT NULL;
+
+-- Дни больничных сотрудника в периоде; открытый больничный считается по сегодняшний день
+CREATE FUNCTION sick_leave_days(employee BIGINT, from_date DATE, to_date DATE) RETURNS BIGINT AS $$
+    SELECT COALESCE(SUM(LEAST(COALESCE(end_date, CURRENT_DATE), to_date) - GREATEST(start_date, from_date) + 1), 0)::BIGINT
+    FROM sick_leaves
+    WHERE employee_id = employee AND start_date <= to_date AND COALESCE(end_date, CURRENT_DATE) >= from_date;
+$$ LANGUAGE sql STABLE;
+
+-- Больничные больше не баланс журнала отпусков: sick_days считается по больничным.
+-- Записи sick в журнале — перенесенный прежний счетчик; они остаются историей
+-- и не входят в балансы, новые записи делаются только для annual
+COMMENT ON COLUMN leave_ledger.leave_type IS 'annual — ежегодный отпуск; sick — прежний счетчик больничных дней, только история';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+COMMENT ON COLUMN leave_ledger.leave_type IS NULL;
+
+UPDATE employees SET status = 'active' WHERE status = 'sick';
+
+DROP FUNCTION IF EXISTS sick_leave_days(BIGINT, DATE, DATE);
+DROP TABLE IF EXISTS sick_leaves;
+-- +goose StatementEnd
@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE calendars (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_calendar_name UNIQUE (name)
+);
+
+-- Дни, отличающиеся от обычной недели: праздники и перенесенные выходные (holiday)
+-- и рабочие субботы и воскресенья по переносу (working)
+CREATE TABLE calendar_days (
+    calendar_id BIGINT NOT NULL,
+    date DATE NOT NULL,
+    kind VARCHAR NOT NULL,
+    name VARCHAR,
+    PRIMARY KEY (calendar_id, date),
+    CONSTRAINT calendar_days_kind_check CHECK (kind IN ('holiday', 'working')),
+    CONSTRAINT fk_calendar_days_calendar_id FOREIGN KEY (calendar_id) REFERENCES calendars(id) ON DELETE CASCADE
+);
+
+-- Департамент без календаря пользуется календарем ближайшего вышестоящего
+ALTER TABLE departments ADD COLUMN calendar_id BIGINT,
+    ADD CONSTRAINT fk_departments_calendar_id FOREIGN KEY (calendar_id) REFERENCES calendars(id) ON DELETE SET NULL;
+
+-- Календарь сотрудника: календарь его департамента или ближайшего вышестоящего.
+-- Глубина ограничена на случай цикла в parent_id
+CREATE FUNCTION employee_calendar(employee BIGINT) RETURNS BIGINT AS $$
+    WITH RECURSIVE chain AS (
+        SELECT d.parent_id, d.calendar_id, 1 AS depth
+        FROM employees e JOIN departments d ON d.id = e.department_id
+        WHERE e.id = employee
+        UNION ALL
+        SELECT d.parent_id, d.calendar_id, chain.depth + 1
+        FROM departments d JOIN chain ON d.id = chain.parent_id
+        WHERE chain.calendar_id IS NULL AND chain.depth < 100
+    )
+    SELECT calendar_id FROM chain WHERE calendar_id IS NOT NULL ORDER BY depth LIMIT 1;
+$$ LANGUAGE sql STABLE;
+
+-- Рабочие дни периода включительно: будни, кроме праздников, и рабочие выходные.
+-- Без календаря исключаются только суббота и воскресенье
+CREATE FUNCTION working_days(calendar BIGINT, from_date DATE, to_date DATE) RETURNS BIGINT AS $$
+    SELECT COUNT(*) FROM generate_series(from_date, to_date, INTERVAL '1 day') AS d (day)
+    LEFT JOIN calendar_days cd ON cd.calendar_id = calendar AND cd.date = d.day::date
+    WHERE COALESCE(cd.kind = 'working', EXTRACT(ISODOW FROM d.day) < 6);
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS working_days(BIGINT, DATE, DATE);
+DROP FUNCTION IF EXISTS employee_calendar(BIGINT);
+ALTER TABLE departments DROP COLUMN IF EXISTS calendar_id;
+DROP TABLE IF EXISTS calendar_days;
+DROP TABLE IF EXISTS calendars;
+-- +goose StatementEnd
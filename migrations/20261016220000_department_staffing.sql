@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Минимум сотрудников на работе в департаменте: число и доля от штата в процентах.
+-- Отпуск, после которого на работе остается меньше, не подается и не согласуется
+ALTER TABLE departments ADD COLUMN min_on_duty INTEGER,
+    ADD COLUMN min_on_duty_percent INTEGER,
+    ADD CONSTRAINT departments_min_on_duty_check CHECK (min_on_duty >= 0),
+    ADD CONSTRAINT departments_min_on_duty_percent_check CHECK (min_on_duty_percent BETWEEN 0 AND 100);
+
+CREATE INDEX idx_vacation_requests_dates ON vacation_requests (start_date, end_date) WHERE status = 'approved';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacation_requests_dates;
+ALTER TABLE departments DROP COLUMN IF EXISTS min_on_duty_percent;
+ALTER TABLE departments DROP COLUMN IF EXISTS min_on_duty;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Токены подписки на календарь отсутствий. Календарные клиенты не передают заголовок
+-- Authorization, поэтому токен передается в ссылке и отзывается сотрудником
+CREATE TABLE calendar_feed_tokens (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL,
+    name VARCHAR NOT NULL,
+    -- Начало токена для отображения в списках и логах
+    prefix VARCHAR NOT NULL,
+    -- SHA-256 хеш токена, сам токен не хранится
+    token_hash VARCHAR NOT NULL UNIQUE,
+    last_used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_calendar_feed_tokens_employee_id FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_calendar_feed_tokens_employee ON calendar_feed_tokens (employee_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS calendar_feed_tokens;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- С переходом на производственные календари в заявке считаются рабочие дни.
+-- Заявки, поданные раньше, остаются в календарных днях
+COMMENT ON COLUMN vacation_requests.days IS 'Рабочие дни отпуска по производственному календарю сотрудника, включая start_date и end_date; у заявок, поданных до перехода на рабочие дни, — календарные';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+COMMENT ON COLUMN vacation_requests.days IS 'Календарные дни отпуска, включая start_date и end_date';
+-- +goose StatementEnd